@@ -0,0 +1,192 @@
+package morph
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nspcc-dev/neo-go/pkg/encoding/bigint"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// configValue is a parsed network configuration value ready to be
+// passed to the netmap contract.
+type configValue struct {
+	key string
+	// value is either int64 or string.
+	value interface{}
+	// raw is a representation of the value in the contract storage.
+	raw []byte
+}
+
+// parseConfigValue parses `key=value` pair. Values of the known keys are
+// validated, unknown keys are rejected unless force is set.
+func parseConfigValue(kv string, force bool) (configValue, error) {
+	k, v := kv, ""
+	if i := strings.IndexByte(kv, '='); i >= 0 {
+		k, v = kv[:i], kv[i+1:]
+	}
+
+	k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+	if k == "" || v == "" {
+		return configValue{}, fmt.Errorf("invalid parameter format, expected key=value: %s", kv)
+	}
+
+	res := configValue{key: k}
+
+	switch k {
+	case netmapEpochKey, netmapMaxObjectSizeKey, netmapEigenTrustIterationsKey:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n <= 0 {
+			return configValue{}, fmt.Errorf("%s must be a positive integer: %s", k, v)
+		}
+		res.value = n
+	case netmapAuditFeeKey, netmapBasicIncomeRateKey,
+		netmapContainerFeeKey, netmapContainerAliasFeeKey,
+		netmapInnerRingCandidateFeeKey, netmapWithdrawFeeKey,
+		netmapMaintenanceDurationKey:
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n < 0 {
+			return configValue{}, fmt.Errorf("%s must be a non-negative integer: %s", k, v)
+		}
+		res.value = n
+	case netmapEigenTrustAlphaKey:
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil || f < 0 || f > 1 {
+			return configValue{}, fmt.Errorf("%s must be a number in [0, 1] range: %s", k, v)
+		}
+		res.value = v
+	default:
+		if !force {
+			return configValue{}, fmt.Errorf("unknown config key (use --%s to set it anyway): %s", forceConfigSetFlag, k)
+		}
+		res.value = v
+	}
+
+	switch x := res.value.(type) {
+	case int64:
+		res.raw = bigint.ToBytes(big.NewInt(x))
+	case string:
+		res.raw = []byte(x)
+	}
+
+	return res, nil
+}
+
+func setConfigCmd(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return errors.New("no parameters to set")
+	}
+
+	force, _ := cmd.Flags().GetBool(forceConfigSetFlag)
+	dryRun, _ := cmd.Flags().GetBool(dryRunConfigSetFlag)
+
+	values := make([]configValue, 0, len(args))
+	for i := range args {
+		v, err := parseConfigValue(args[i], force)
+		if err != nil {
+			return err
+		}
+
+		for j := range values {
+			if values[j].key == v.key {
+				return fmt.Errorf("duplicated config key: %s", v.key)
+			}
+		}
+
+		values = append(values, v)
+	}
+
+	c, err := getN3Client(viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("can't create N3 client: %w", err)
+	}
+
+	cs, err := c.GetContractStateByID(1)
+	if err != nil {
+		return fmt.Errorf("can't get NNS contract info: %w", err)
+	}
+
+	nmHash, err := nnsResolveHash(c, cs.Hash, netmapContract+".neofs")
+	if err != nil {
+		return fmt.Errorf("can't get netmap contract hash: %w", err)
+	}
+
+	cfg, err := fetchNetworkConfig(c, nmHash)
+	if err != nil {
+		return err
+	}
+
+	changed := printConfigDiff(cmd, cfg, values)
+	if len(changed) == 0 {
+		cmd.Println("Nothing to update.")
+		return nil
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	wCtx, err := newInitializeContext(cmd, viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("can't initialize context: %w", err)
+	}
+
+	bw := io.NewBufBinWriter()
+	for _, v := range changed {
+		emit.AppCall(bw.BinWriter, nmHash, "setConfig", callflag.All,
+			[]byte{}, []byte(v.key), v.value)
+	}
+
+	if err := wCtx.sendCommitteeTx(bw.Bytes(), -1); err != nil {
+		return err
+	}
+
+	return wCtx.awaitTx()
+}
+
+// printConfigDiff prints changes of the network configuration and returns
+// values which differ from the current ones.
+func printConfigDiff(cmd *cobra.Command, cfg []configParam, values []configValue) []configValue {
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	changed := make([]configValue, 0, len(values))
+
+loop:
+	for _, v := range values {
+		newValue := formatConfigValue(v.key, v.raw)
+
+		for _, p := range cfg {
+			if p.key != v.key {
+				continue
+			}
+
+			oldValue := formatConfigValue(p.key, p.value)
+			if oldValue == newValue {
+				_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t%s\t(unchanged)\n", v.key, oldValue)))
+				continue loop
+			}
+
+			_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t%s\t-> %s\n", v.key, oldValue, newValue)))
+			changed = append(changed, v)
+			continue loop
+		}
+
+		_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t<none>\t-> %s\n", v.key, newValue)))
+		changed = append(changed, v)
+	}
+
+	_ = tw.Flush()
+	cmd.Print(buf.String())
+
+	return changed
+}
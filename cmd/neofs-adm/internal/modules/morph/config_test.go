@@ -0,0 +1,52 @@
+package morph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConfigValue(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		v, err := parseConfigValue("MaxObjectSize=1024", false)
+		require.NoError(t, err)
+		require.Equal(t, netmapMaxObjectSizeKey, v.key)
+		require.Equal(t, int64(1024), v.value)
+		require.Equal(t, "1024 (int)", formatConfigValue(v.key, v.raw))
+
+		v, err = parseConfigValue("AuditFee=0", false)
+		require.NoError(t, err)
+		require.Equal(t, int64(0), v.value)
+
+		v, err = parseConfigValue("EigenTrustAlpha=0.25", false)
+		require.NoError(t, err)
+		require.Equal(t, "0.25", v.value)
+		require.Equal(t, []byte("0.25"), v.raw)
+	})
+	t.Run("invalid format", func(t *testing.T) {
+		for _, kv := range []string{"MaxObjectSize", "MaxObjectSize=", "=10"} {
+			_, err := parseConfigValue(kv, false)
+			require.Error(t, err, kv)
+		}
+	})
+	t.Run("invalid value", func(t *testing.T) {
+		for _, kv := range []string{
+			"EpochDuration=0",
+			"MaxObjectSize=-1",
+			"AuditFee=-1",
+			"ContainerFee=abc",
+			"EigenTrustAlpha=1.5",
+		} {
+			_, err := parseConfigValue(kv, false)
+			require.Error(t, err, kv)
+		}
+	})
+	t.Run("unknown key", func(t *testing.T) {
+		_, err := parseConfigValue("SomeKey=value", false)
+		require.Error(t, err)
+
+		v, err := parseConfigValue("SomeKey=value", true)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), v.raw)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	nns "github.com/nspcc-dev/neo-go/examples/nft-nd-nns"
 	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
 	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/rpc/client"
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract"
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
 	"github.com/nspcc-dev/neo-go/pkg/util"
@@ -160,55 +161,84 @@ func dumpNetworkConfig(cmd *cobra.Command, _ []string) error {
 		return fmt.Errorf("can't get netmap contract hash: %w", err)
 	}
 
+	cfg, err := fetchNetworkConfig(c, nmHash)
+	if err != nil {
+		return err
+	}
+
+	buf := bytes.NewBuffer(nil)
+	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+
+	for _, p := range cfg {
+		_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t%s\n", p.key, formatConfigValue(p.key, p.value))))
+	}
+
+	_ = tw.Flush()
+	cmd.Print(buf.String())
+
+	return nil
+}
+
+type configParam struct {
+	key   string
+	value []byte
+}
+
+// fetchNetworkConfig returns all network configuration parameters stored
+// in the netmap contract in the contract order.
+func fetchNetworkConfig(c *client.Client, nmHash util.Uint160) ([]configParam, error) {
 	res, err := c.InvokeFunction(nmHash, "listConfig",
 		[]smartcontract.Parameter{}, []transaction.Signer{{}})
 	if err != nil || res.State != vm.HaltState.String() || len(res.Stack) == 0 {
-		return errors.New("can't fetch list of network config keys from the netmap contract")
+		return nil, errors.New("can't fetch list of network config keys from the netmap contract")
 	}
 
 	arr, ok := res.Stack[0].Value().([]stackitem.Item)
 	if !ok {
-		return errors.New("invalid ListConfig response from netmap contract")
+		return nil, errors.New("invalid ListConfig response from netmap contract")
 	}
 
-	buf := bytes.NewBuffer(nil)
-	tw := tabwriter.NewWriter(buf, 0, 2, 2, ' ', 0)
+	cfg := make([]configParam, 0, len(arr))
 
 	for _, param := range arr {
 		tuple, ok := param.Value().([]stackitem.Item)
 		if !ok || len(tuple) != 2 {
-			return errors.New("invalid ListConfig response from netmap contract")
+			return nil, errors.New("invalid ListConfig response from netmap contract")
 		}
 
 		k, err := tuple[0].TryBytes()
 		if err != nil {
-			return errors.New("invalid config key from netmap contract")
+			return nil, errors.New("invalid config key from netmap contract")
 		}
 
 		v, err := tuple[1].TryBytes()
 		if err != nil {
-			return errors.New("invalid config value from netmap contract")
+			return nil, errors.New("invalid config value from netmap contract")
 		}
 
-		switch string(k) {
-		case netmapAuditFeeKey, netmapBasicIncomeRateKey,
-			netmapContainerFeeKey, netmapContainerAliasFeeKey,
-			netmapEigenTrustIterationsKey,
-			netmapEpochKey, netmapInnerRingCandidateFeeKey,
-			netmapMaxObjectSizeKey, netmapWithdrawFeeKey:
-			nbuf := make([]byte, 8)
-			copy(nbuf[:], v)
-			n := binary.LittleEndian.Uint64(nbuf)
-			_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t%d (int)\n", k, n)))
-		case netmapEigenTrustAlphaKey:
-			_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t%s (str)\n", k, v)))
-		default:
-			_, _ = tw.Write([]byte(fmt.Sprintf("%s:\t%s (hex)\n", k, hex.EncodeToString(v))))
-		}
+		cfg = append(cfg, configParam{key: string(k), value: v})
 	}
 
-	_ = tw.Flush()
-	cmd.Print(buf.String())
+	return cfg, nil
+}
 
-	return nil
+// formatConfigValue returns human-readable representation of the
+// network configuration value along with its type.
+func formatConfigValue(k string, v []byte) string {
+	switch k {
+	case netmapAuditFeeKey, netmapBasicIncomeRateKey,
+		netmapContainerFeeKey, netmapContainerAliasFeeKey,
+		netmapEigenTrustIterationsKey,
+		netmapEpochKey, netmapInnerRingCandidateFeeKey,
+		netmapMaxObjectSizeKey, netmapWithdrawFeeKey,
+		netmapMaintenanceDurationKey:
+		nbuf := make([]byte, 8)
+		copy(nbuf[:], v)
+		n := binary.LittleEndian.Uint64(nbuf)
+		return fmt.Sprintf("%d (int)", n)
+	case netmapEigenTrustAlphaKey:
+		return fmt.Sprintf("%s (str)", v)
+	default:
+		return fmt.Sprintf("%s (hex)", hex.EncodeToString(v))
+	}
 }
@@ -44,6 +44,12 @@ type initializeContext struct {
 	Command      *cobra.Command
 	ContractPath string
 	Natives      map[string]util.Uint160
+	// ExportPath is a path to save the transactions to instead of sending them.
+	ExportPath string
+	// ValidBlocks is a number of blocks the exported transactions are valid for.
+	ValidBlocks uint32
+	// exported is a number of exported transactions.
+	exported int
 }
 
 func initializeSideChainCmd(cmd *cobra.Command, args []string) error {
@@ -99,8 +105,14 @@ func initializeSideChainCmd(cmd *cobra.Command, args []string) error {
 }
 
 func newInitializeContext(cmd *cobra.Command, v *viper.Viper) (*initializeContext, error) {
+	// flags are absent in the commands without export support
+	exportPath, _ := cmd.Flags().GetString(txExportFlag)
+	validBlocks, _ := cmd.Flags().GetUint32(txValidBlocksFlag)
+
 	walletDir := config.ResolveHomePath(viper.GetString(alphabetWalletsFlag))
-	wallets, err := openAlphabetWallets(walletDir)
+
+	// transactions are exported unsigned, so private keys are not needed
+	wallets, err := openAlphabetWallets(walletDir, exportPath == "")
 	if err != nil {
 		return nil, err
 	}
@@ -169,6 +181,8 @@ func newInitializeContext(cmd *cobra.Command, v *viper.Viper) (*initializeContex
 		Contracts:      make(map[string]*contractState),
 		ContractPath:   ctrPath,
 		Natives:        nativeHashes,
+		ExportPath:     exportPath,
+		ValidBlocks:    validBlocks,
 	}
 
 	if needContracts {
@@ -185,7 +199,9 @@ func (c *initializeContext) nativeHash(name string) util.Uint160 {
 	return c.Natives[name]
 }
 
-func openAlphabetWallets(walletDir string) ([]*wallet.Wallet, error) {
+// openAlphabetWallets opens all the alphabet wallets from the directory.
+// Accounts are decrypted if decrypt is set.
+func openAlphabetWallets(walletDir string, decrypt bool) ([]*wallet.Wallet, error) {
 	walletFiles, err := ioutil.ReadDir(walletDir)
 	if err != nil {
 		return nil, fmt.Errorf("can't read alphabet wallets dir: %w", err)
@@ -214,14 +230,9 @@ loop:
 			return nil, fmt.Errorf("can't open wallet: %w", err)
 		}
 
-		password, err := config.AlphabetPassword(viper.GetViper(), i)
-		if err != nil {
-			return nil, fmt.Errorf("can't fetch password: %w", err)
-		}
-
-		for i := range w.Accounts {
-			if err := w.Accounts[i].Decrypt(password, keys.NEP2ScryptParams()); err != nil {
-				return nil, fmt.Errorf("can't unlock wallet: %w", err)
+		if decrypt {
+			if err := decryptAlphabetWallet(w, i); err != nil {
+				return nil, err
 			}
 		}
 
@@ -354,3 +365,18 @@ func getNativeHashes(c *client.Client) (map[string]util.Uint160, error) {
 	}
 	return nativeHashes, nil
 }
+
+func decryptAlphabetWallet(w *wallet.Wallet, index int) error {
+	password, err := config.AlphabetPassword(viper.GetViper(), index)
+	if err != nil {
+		return fmt.Errorf("can't fetch password: %w", err)
+	}
+
+	for i := range w.Accounts {
+		if err := w.Accounts[i].Decrypt(password, keys.NEP2ScryptParams()); err != nil {
+			return fmt.Errorf("can't unlock wallet: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -58,6 +58,7 @@ const (
 	netmapBasicIncomeRateKey       = "BasicIncomeRate"
 	netmapInnerRingCandidateFeeKey = "InnerRingCandidateFee"
 	netmapWithdrawFeeKey           = "WithdrawFee"
+	netmapMaintenanceDurationKey   = "MaintenanceDuration"
 
 	defaultEigenTrustIterations = 4
 	defaultEigenTrustAlpha      = "0.1"
@@ -187,7 +188,7 @@ func (c *initializeContext) updateContracts() error {
 
 		keysParam = append(keysParam, smartcontract.Parameter{
 			Type:  smartcontract.PublicKeyType,
-			Value: acc.PrivateKey().PublicKey().Bytes(),
+			Value: accountPublicKey(acc).Bytes(),
 		})
 
 		params := c.getAlphabetDeployItems(i, len(c.Wallets))
@@ -301,7 +302,7 @@ func (c *initializeContext) deployContracts() error {
 		invokeHash := mgmtHash
 		keysParam = append(keysParam, smartcontract.Parameter{
 			Type:  smartcontract.PublicKeyType,
-			Value: acc.PrivateKey().PublicKey().Bytes(),
+			Value: accountPublicKey(acc).Bytes(),
 		})
 
 		params := getContractDeployParameters(alphaCs.RawNEF, alphaCs.RawManifest,
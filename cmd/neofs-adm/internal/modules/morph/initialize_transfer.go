@@ -83,6 +83,10 @@ func (c *initializeContext) transferFundsFinished() (bool, error) {
 }
 
 func (c *initializeContext) multiSignAndSend(tx *transaction.Transaction, accType string) error {
+	if c.ExportPath != "" {
+		return c.exportTx(tx)
+	}
+
 	if err := c.multiSign(tx, accType); err != nil {
 		return err
 	}
@@ -0,0 +1,321 @@
+package morph
+
+import (
+	"crypto/elliptic"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/context"
+	"github.com/nspcc-dev/neo-go/pkg/vm"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/config"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// txContextType is a type of the parameter context for transactions
+// as it is used by neo-go.
+const txContextType = "Neo.Network.P2P.Payloads.Transaction"
+
+// defaultExportValidBlocks is a default number of blocks the exported
+// transaction stays valid for.
+const defaultExportValidBlocks = 5000
+
+// exportTx saves unsigned transaction to the parameter context file instead
+// of sending it. The first transaction is saved to the export path, the
+// following ones get `.1`, `.2`, etc. suffixes.
+func (c *initializeContext) exportTx(tx *transaction.Transaction) error {
+	height, err := c.Client.GetBlockCount()
+	if err != nil {
+		return fmt.Errorf("can't fetch current height: %w", err)
+	}
+
+	return c.saveTx(tx, height, c.Client.GetNetwork())
+}
+
+// saveTx saves the transaction valid since the specified height
+// to the next export file.
+func (c *initializeContext) saveTx(tx *transaction.Transaction, height uint32, magic netmode.Magic) error {
+	// Signatures are collected manually, so the default increment
+	// is too short for the transaction to reach the chain.
+	tx.ValidUntilBlock = height + c.ValidBlocks
+
+	// re-decode transaction to drop the cached hash, witnesses
+	// are not attached yet, so only hashable fields are encoded
+	data, err := tx.EncodeHashableFields()
+	if err != nil {
+		return fmt.Errorf("can't encode transaction: %w", err)
+	}
+
+	tx = new(transaction.Transaction)
+	if err := tx.DecodeHashableFields(data); err != nil {
+		return fmt.Errorf("can't decode transaction: %w", err)
+	}
+
+	p := c.ExportPath
+	if c.exported > 0 {
+		p += "." + strconv.Itoa(c.exported)
+	}
+
+	pc := context.NewParameterContext(txContextType, magic, tx)
+	if err := writeParameterContext(p, pc); err != nil {
+		return err
+	}
+
+	c.exported++
+	c.Command.Printf("Transaction %s is saved to %s (valid until block %d).\n",
+		tx.Hash().StringLE(), p, tx.ValidUntilBlock)
+
+	return nil
+}
+
+// accountPublicKey returns public key of the single-signature account.
+// Key is taken from the verification script, so the account may be locked.
+func accountPublicKey(acc *wallet.Account) *keys.PublicKey {
+	if pub, ok := vm.ParseSignatureContract(acc.Contract.Script); ok {
+		if key, err := keys.NewPublicKeyFromBytes(pub, elliptic.P256()); err == nil {
+			return key
+		}
+	}
+
+	return acc.PrivateKey().PublicKey()
+}
+
+func signTxContext(cmd *cobra.Command, _ []string) error {
+	ctxPath, _ := cmd.Flags().GetString(txContextFlag)
+	outPath, _ := cmd.Flags().GetString(txOutFlag)
+	if outPath == "" {
+		outPath = ctxPath
+	}
+
+	pc, err := readParameterContext(ctxPath)
+	if err != nil {
+		return err
+	}
+
+	tx, err := contextTransaction(pc)
+	if err != nil {
+		return err
+	}
+
+	walletDir := config.ResolveHomePath(viper.GetString(alphabetWalletsFlag))
+
+	var signed int
+	for i := 0; ; i++ {
+		letter := innerring.GlagoliticLetter(i).String()
+		if letter == "unknown" {
+			break
+		}
+
+		w, err := wallet.NewWalletFromFile(filepath.Join(walletDir, letter+".json"))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return fmt.Errorf("can't open wallet: %w", err)
+		}
+
+		n, err := signWithWallet(pc, tx, w, i)
+		if err != nil {
+			return fmt.Errorf("%s wallet: %w", letter, err)
+		}
+
+		signed += n
+	}
+
+	if signed == 0 {
+		return errors.New("no alphabet accounts among transaction signers")
+	}
+
+	if err := writeParameterContext(outPath, pc); err != nil {
+		return err
+	}
+
+	cmd.Printf("Added %d signature(s), context is saved to %s.\n", signed, outPath)
+	return nil
+}
+
+// signWithWallet adds signatures of all the wallet accounts which are
+// required by the transaction signers. Returns number of added signatures.
+func signWithWallet(pc *context.ParameterContext, tx *transaction.Transaction, w *wallet.Wallet, index int) (int, error) {
+	var (
+		accs []*wallet.Account
+		res  int
+	)
+
+	for _, acc := range w.Accounts {
+		h := acc.Contract.ScriptHash()
+		for _, s := range tx.Signers {
+			if s.Account.Equals(h) {
+				accs = append(accs, acc)
+				break
+			}
+		}
+	}
+
+	if len(accs) == 0 {
+		return 0, nil
+	}
+
+	if err := decryptAlphabetWallet(&wallet.Wallet{Accounts: accs}, index); err != nil {
+		return 0, err
+	}
+
+	for _, acc := range accs {
+		priv := acc.PrivateKey()
+		h := acc.Contract.ScriptHash()
+
+		if item, ok := pc.Items[h]; ok {
+			if _, ok := item.Signatures[hex.EncodeToString(priv.PublicKey().Bytes())]; ok {
+				continue
+			}
+		}
+
+		sig := priv.SignHashable(uint32(pc.Network), tx)
+		if err := pc.AddSignature(h, acc.Contract, priv.PublicKey(), sig); err != nil {
+			return 0, fmt.Errorf("can't add signature: %w", err)
+		}
+
+		res++
+	}
+
+	return res, nil
+}
+
+func sendTxContext(cmd *cobra.Command, _ []string) error {
+	paths, _ := cmd.Flags().GetStringSlice(txContextFlag)
+	if len(paths) == 0 {
+		return errors.New("missing transaction context files")
+	}
+
+	pc, err := readParameterContext(paths[0])
+	if err != nil {
+		return err
+	}
+
+	tx, err := contextTransaction(pc)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range paths[1:] {
+		other, err := readParameterContext(p)
+		if err != nil {
+			return err
+		}
+
+		if err := mergeParameterContext(pc, other); err != nil {
+			return fmt.Errorf("%s: %w", p, err)
+		}
+	}
+
+	for _, s := range tx.Signers {
+		w, err := pc.GetWitness(s.Account)
+		if err != nil {
+			return fmt.Errorf("incomplete signature of %s: %w", s.Account.StringLE(), err)
+		}
+		tx.Scripts = append(tx.Scripts, *w)
+	}
+
+	c, err := getN3Client(viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("can't create N3 client: %w", err)
+	}
+
+	cmd.Printf("Sending transaction %s.\n", tx.Hash().StringLE())
+
+	return defaultClientContext(c).sendTx(tx, cmd, true)
+}
+
+// mergeParameterContext adds signatures from src to dst. Both contexts
+// must contain the same transaction.
+func mergeParameterContext(dst, src *context.ParameterContext) error {
+	srcTx, err := contextTransaction(src)
+	if err != nil {
+		return err
+	}
+
+	if dst.Verifiable.Hash() != srcTx.Hash() {
+		return fmt.Errorf("transaction mismatch: %s", srcTx.Hash().StringLE())
+	}
+
+	for h, item := range src.Items {
+		ctr := &wallet.Contract{
+			Script:     item.Script,
+			Parameters: make([]wallet.ContractParam, len(item.Parameters)),
+		}
+		for i := range item.Parameters {
+			ctr.Parameters[i] = wallet.ContractParam{
+				Name: "parameter" + strconv.Itoa(i),
+				Type: item.Parameters[i].Type,
+			}
+		}
+
+		for pubHex, sig := range item.Signatures {
+			if dstItem, ok := dst.Items[h]; ok {
+				if len(dstItem.Signatures) >= len(dstItem.Parameters) {
+					break
+				}
+				if _, ok := dstItem.Signatures[pubHex]; ok {
+					continue
+				}
+			}
+
+			pub, err := keys.NewPublicKeyFromString(pubHex)
+			if err != nil {
+				return fmt.Errorf("invalid public key: %w", err)
+			}
+
+			if err := dst.AddSignature(h, ctr, pub, sig); err != nil {
+				return fmt.Errorf("can't add signature: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func contextTransaction(pc *context.ParameterContext) (*transaction.Transaction, error) {
+	tx, ok := pc.Verifiable.(*transaction.Transaction)
+	if !ok {
+		return nil, fmt.Errorf("unexpected context type: %s", pc.Type)
+	}
+	return tx, nil
+}
+
+func readParameterContext(p string) (*context.ParameterContext, error) {
+	data, err := ioutil.ReadFile(p)
+	if err != nil {
+		return nil, fmt.Errorf("can't read transaction context: %w", err)
+	}
+
+	pc := new(context.ParameterContext)
+	if err := json.Unmarshal(data, pc); err != nil {
+		return nil, fmt.Errorf("can't parse transaction context: %w", err)
+	}
+
+	return pc, nil
+}
+
+func writeParameterContext(p string, pc *context.ParameterContext) error {
+	data, err := json.Marshal(pc)
+	if err != nil {
+		return fmt.Errorf("can't marshal transaction context: %w", err)
+	}
+
+	if err := ioutil.WriteFile(p, data, 0644); err != nil {
+		return fmt.Errorf("can't write transaction context: %w", err)
+	}
+
+	return nil
+}
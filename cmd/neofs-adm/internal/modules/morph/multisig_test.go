@@ -0,0 +1,261 @@
+package morph
+
+import (
+	"bytes"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/config/netmode"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/context"
+	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAlphabet creates alphabet wallets of the given size in the
+// temporary directory. Passwords are set in the config and removed
+// after the test.
+func newTestAlphabet(t *testing.T, size int) (string, []*wallet.Wallet) {
+	walletDir := newTempDir(t)
+	v := viper.GetViper()
+
+	for i := 0; i < size; i++ {
+		key := "credentials." + innerring.GlagoliticLetter(i).String()
+		v.Set(key, strconv.Itoa(i))
+		t.Cleanup(func() { v.Set(key, nil) })
+	}
+
+	_, err := initializeWallets(walletDir, size)
+	require.NoError(t, err)
+
+	wallets, err := openAlphabetWallets(walletDir, false)
+	require.NoError(t, err)
+
+	return walletDir, wallets
+}
+
+// newCommitteeTx returns unsigned transaction with
+// the committee account of the alphabet as a signer.
+func newCommitteeTx(t *testing.T, wallets []*wallet.Wallet) (*transaction.Transaction, *wallet.Account) {
+	acc, err := getWalletAccount(wallets[0], committeeAccountName)
+	require.NoError(t, err)
+
+	tx := transaction.New([]byte{byte(opcode.PUSH1)}, 0)
+	tx.ValidUntilBlock = 100
+	tx.Signers = []transaction.Signer{{
+		Account: acc.Contract.ScriptHash(),
+		Scopes:  transaction.CalledByEntry,
+	}}
+
+	return tx, acc
+}
+
+func TestExportTx(t *testing.T) {
+	const (
+		height      = 42
+		validBlocks = 10
+		magic       = netmode.UnitTestNet
+	)
+
+	_, wallets := newTestAlphabet(t, 1)
+	dir := newTempDir(t)
+
+	buf := bytes.NewBuffer(nil)
+	cmd := new(cobra.Command)
+	cmd.SetOut(buf)
+
+	c := &initializeContext{
+		Command:     cmd,
+		ExportPath:  filepath.Join(dir, "tx.json"),
+		ValidBlocks: validBlocks,
+	}
+
+	txs := make([]*transaction.Transaction, 2)
+	for i := range txs {
+		txs[i], _ = newCommitteeTx(t, wallets)
+		_ = txs[i].Hash() // cache the hash of the original transaction
+
+		require.NoError(t, c.saveTx(txs[i], height, magic))
+	}
+
+	for i, p := range []string{c.ExportPath, c.ExportPath + ".1"} {
+		pc, err := readParameterContext(p)
+		require.NoError(t, err)
+		require.Equal(t, magic, pc.Network)
+
+		tx, err := contextTransaction(pc)
+		require.NoError(t, err)
+		require.EqualValues(t, height+validBlocks, tx.ValidUntilBlock)
+		require.Equal(t, txs[i].Nonce, tx.Nonce)
+		require.Equal(t, txs[i].Signers, tx.Signers)
+		require.NotEqual(t, txs[i].Hash(), tx.Hash(), "hash must be recalculated")
+		require.Contains(t, buf.String(), tx.Hash().StringLE())
+	}
+}
+
+func TestSignWithWallet(t *testing.T) {
+	const size = 4
+
+	_, wallets := newTestAlphabet(t, size)
+	tx, acc := newCommitteeTx(t, wallets)
+	h := acc.Contract.ScriptHash()
+	m := len(acc.Contract.Parameters)
+
+	pc := context.NewParameterContext(txContextType, netmode.UnitTestNet, tx)
+
+	t.Run("not a signer", func(t *testing.T) {
+		other := &wallet.Wallet{Accounts: []*wallet.Account{wallets[0].Accounts[0]}}
+
+		n, err := signWithWallet(pc, tx, other, 0)
+		require.NoError(t, err)
+		require.Zero(t, n)
+	})
+
+	for i := 0; i < m-1; i++ {
+		n, err := signWithWallet(pc, tx, wallets[i], i)
+		require.NoError(t, err)
+		require.Equal(t, 1, n)
+	}
+
+	_, err := pc.GetWitness(h)
+	require.Error(t, err, "signature must be incomplete")
+
+	n, err := signWithWallet(pc, tx, wallets[0], 0)
+	require.NoError(t, err)
+	require.Zero(t, n, "signature must not be added twice")
+
+	n, err = signWithWallet(pc, tx, wallets[m-1], m-1)
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	w, err := pc.GetWitness(h)
+	require.NoError(t, err)
+	require.Equal(t, acc.Contract.Script, w.VerificationScript)
+}
+
+func TestMergeParameterContext(t *testing.T) {
+	const size = 4
+
+	_, wallets := newTestAlphabet(t, size)
+	tx, acc := newCommitteeTx(t, wallets)
+	h := acc.Contract.ScriptHash()
+	m := len(acc.Contract.Parameters)
+
+	newContext := func(signers ...int) *context.ParameterContext {
+		pc := context.NewParameterContext(txContextType, netmode.UnitTestNet, tx)
+
+		for _, i := range signers {
+			_, err := signWithWallet(pc, tx, wallets[i], i)
+			require.NoError(t, err)
+		}
+
+		return pc
+	}
+
+	t.Run("different transaction", func(t *testing.T) {
+		other, _ := newCommitteeTx(t, wallets)
+
+		err := mergeParameterContext(newContext(0),
+			context.NewParameterContext(txContextType, netmode.UnitTestNet, other))
+		require.Error(t, err)
+	})
+
+	dst := newContext(0)
+
+	// repeated signatures are skipped
+	require.NoError(t, mergeParameterContext(dst, newContext(0, 1)))
+	require.Len(t, dst.Items[h].Signatures, 2)
+
+	_, err := dst.GetWitness(h)
+	require.Error(t, err, "signature must be incomplete")
+
+	signers := make([]int, 0, size)
+	for i := 0; i < size; i++ {
+		signers = append(signers, i)
+	}
+
+	// excess signatures are not added
+	require.NoError(t, mergeParameterContext(dst, newContext(signers...)))
+	require.Len(t, dst.Items[h].Signatures, m)
+
+	_, err = dst.GetWitness(h)
+	require.NoError(t, err)
+}
+
+func TestSendTxContext(t *testing.T) {
+	const size = 4
+
+	_, wallets := newTestAlphabet(t, size)
+	tx, _ := newCommitteeTx(t, wallets)
+	dir := newTempDir(t)
+
+	saveContext := func(name string, signers ...int) string {
+		pc := context.NewParameterContext(txContextType, netmode.UnitTestNet, tx)
+
+		for _, i := range signers {
+			_, err := signWithWallet(pc, tx, wallets[i], i)
+			require.NoError(t, err)
+		}
+
+		p := filepath.Join(dir, name)
+		require.NoError(t, writeParameterContext(p, pc))
+
+		return p
+	}
+
+	newCmd := func(paths ...string) *cobra.Command {
+		cmd := new(cobra.Command)
+		cmd.SetOut(bytes.NewBuffer(nil))
+		cmd.Flags().StringSlice(txContextFlag, paths, "")
+
+		return cmd
+	}
+
+	// endpoint is not set, so the command fails right before sending
+	v := viper.GetViper()
+	v.Set(endpointFlag, "")
+	t.Cleanup(func() { v.Set(endpointFlag, nil) })
+
+	t.Run("no contexts", func(t *testing.T) {
+		require.Error(t, sendTxContext(newCmd(), nil))
+	})
+
+	t.Run("incomplete signature", func(t *testing.T) {
+		err := sendTxContext(newCmd(saveContext("a.json", 0), saveContext("b.json", 1)), nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "incomplete signature")
+	})
+
+	t.Run("different transaction", func(t *testing.T) {
+		other, _ := newCommitteeTx(t, wallets)
+
+		p := filepath.Join(dir, "other.json")
+		require.NoError(t, writeParameterContext(p,
+			context.NewParameterContext(txContextType, netmode.UnitTestNet, other)))
+
+		err := sendTxContext(newCmd(saveContext("c.json", 0), p), nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "transaction mismatch")
+	})
+
+	t.Run("merged signatures", func(t *testing.T) {
+		err := sendTxContext(newCmd(
+			saveContext("d.json", 0),
+			saveContext("e.json", 1),
+			saveContext("f.json", 2, 3),
+		), nil)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "can't create N3 client")
+	})
+}
+
+func TestInitializeExport(t *testing.T) {
+	// initialization stages depend on each other, so
+	// their transactions can't be exported
+	require.Nil(t, initCmd.Flags().Lookup(txExportFlag))
+}
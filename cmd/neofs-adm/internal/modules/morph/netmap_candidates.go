@@ -0,0 +1,335 @@
+package morph
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/rpc/client"
+	"github.com/nspcc-dev/neo-go/pkg/rpc/response/result"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	netmapCandidateOnline  = 1
+	netmapCandidateOffline = 2
+)
+
+type netmapCandidate struct {
+	info  *netmap.NodeInfo
+	state int64
+}
+
+func listNetmapCandidates(cmd *cobra.Command, _ []string) error {
+	c, err := getN3Client(viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("can't create N3 client: %w", err)
+	}
+
+	nmHash, err := netmapContractHash(c)
+	if err != nil {
+		return err
+	}
+
+	candidates, err := fetchNetmapCandidates(c, nmHash)
+	if err != nil {
+		return err
+	}
+
+	scanEpochs, _ := cmd.Flags().GetUint64(candidatesScanEpochsFlag)
+
+	var ages map[string]uint64
+	if scanEpochs > 0 {
+		ages, err = scanBootstrapAges(c, nmHash, scanEpochs)
+		if err != nil {
+			return fmt.Errorf("can't calculate candidates age: %w", err)
+		}
+	}
+
+	for i := range candidates {
+		key := hex.EncodeToString(candidates[i].info.PublicKey())
+
+		cmd.Printf("Node %d: %s %s\n", i+1, key, candidateStateString(candidates[i].state))
+
+		if scanEpochs > 0 {
+			if age, ok := ages[key]; ok {
+				cmd.Printf("\testimated age: %d epoch(s) since last bootstrap\n", age)
+			} else {
+				cmd.Printf("\testimated age: not bootstrapped in the last %d epoch(s)\n", scanEpochs)
+			}
+		}
+
+		netmap.IterateAllAddresses(candidates[i].info, func(s string) {
+			cmd.Println("\taddress:", s)
+		})
+
+		for _, a := range candidates[i].info.Attributes() {
+			cmd.Printf("\tattribute: %s=%s\n", a.Key(), a.Value())
+		}
+	}
+
+	return nil
+}
+
+func removeNodeCmd(cmd *cobra.Command, args []string) error {
+	key, err := keys.NewPublicKeyFromString(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid node public key: %w", err)
+	}
+
+	wCtx, err := newInitializeContext(cmd, viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("can't initialize context: %w", err)
+	}
+
+	nmHash, err := netmapContractHash(wCtx.Client)
+	if err != nil {
+		return err
+	}
+
+	candidates, err := fetchNetmapCandidates(wCtx.Client, nmHash)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i := range candidates {
+		if bytes.Equal(candidates[i].info.PublicKey(), key.Bytes()) {
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		return fmt.Errorf("node %s is not a netmap candidate", args[0])
+	}
+
+	bw := io.NewBufBinWriter()
+	emit.AppCall(bw.BinWriter, nmHash, "updateState", callflag.All,
+		int64(netmapCandidateOffline), key.Bytes())
+
+	if err := wCtx.sendCommitteeTx(bw.Bytes(), -1); err != nil {
+		return err
+	}
+
+	return wCtx.awaitTx()
+}
+
+func netmapContractHash(c *client.Client) (util.Uint160, error) {
+	cs, err := c.GetContractStateByID(1)
+	if err != nil {
+		return util.Uint160{}, fmt.Errorf("can't get NNS contract info: %w", err)
+	}
+
+	nmHash, err := nnsResolveHash(c, cs.Hash, netmapContract+".neofs")
+	if err != nil {
+		return util.Uint160{}, fmt.Errorf("can't get netmap contract hash: %w", err)
+	}
+
+	return nmHash, nil
+}
+
+func fetchNetmapCandidates(c *client.Client, nmHash util.Uint160) ([]netmapCandidate, error) {
+	res, err := c.InvokeFunction(nmHash, "netmapCandidates", []smartcontract.Parameter{}, nil)
+	if err != nil || res.State != vm.HaltState.String() || len(res.Stack) == 0 {
+		return nil, errors.New("can't fetch netmap candidates from the netmap contract")
+	}
+
+	arr, ok := res.Stack[0].Value().([]stackitem.Item)
+	if !ok {
+		return nil, errors.New("invalid netmapCandidates response from netmap contract")
+	}
+
+	candidates := make([]netmapCandidate, 0, len(arr))
+
+	for i := range arr {
+		tuple, ok := arr[i].Value().([]stackitem.Item)
+		if !ok || len(tuple) != 2 {
+			return nil, errors.New("invalid netmapCandidates response from netmap contract")
+		}
+
+		// node info is wrapped into a structure
+		if inner, ok := tuple[0].Value().([]stackitem.Item); ok && len(inner) != 0 {
+			tuple[0] = inner[0]
+		}
+
+		raw, err := tuple[0].TryBytes()
+		if err != nil {
+			return nil, fmt.Errorf("invalid node info of candidate #%d: %w", i, err)
+		}
+
+		state, err := tuple[1].TryInteger()
+		if err != nil {
+			return nil, fmt.Errorf("invalid state of candidate #%d: %w", i, err)
+		}
+
+		info := netmap.NewNodeInfo()
+		if err := info.Unmarshal(raw); err != nil {
+			return nil, fmt.Errorf("can't decode node info of candidate #%d: %w", i, err)
+		}
+
+		candidates = append(candidates, netmapCandidate{info: info, state: state.Int64()})
+	}
+
+	return candidates, nil
+}
+
+func candidateStateString(st int64) string {
+	switch st {
+	case netmapCandidateOnline:
+		return "online"
+	case netmapCandidateOffline:
+		return "offline"
+	default:
+		return "undefined"
+	}
+}
+
+// scanBootstrapAges returns estimated number of epochs passed since the last
+// bootstrap of each node (hex-encoded public key) within the last epochs. This
+// is the value inner ring netmap cleaner compares with its threshold.
+func scanBootstrapAges(c *client.Client, nmHash util.Uint160, epochs uint64) (map[string]uint64, error) {
+	cfg, err := fetchNetworkConfig(c, nmHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var epochDuration uint64
+	for _, p := range cfg {
+		if p.key == netmapEpochKey {
+			nbuf := make([]byte, 8)
+			copy(nbuf, p.value)
+			epochDuration = binary.LittleEndian.Uint64(nbuf)
+		}
+	}
+	if epochDuration == 0 {
+		return nil, errors.New("epoch duration is not set in network config")
+	}
+
+	res, err := c.InvokeFunction(nmHash, "epoch", []smartcontract.Parameter{}, nil)
+	if err != nil || res.State != vm.HaltState.String() || len(res.Stack) == 0 {
+		return nil, errors.New("can't fetch current epoch from the netmap contract")
+	}
+
+	bi, err := res.Stack[0].TryInteger()
+	if err != nil {
+		return nil, fmt.Errorf("can't parse current epoch: %w", err)
+	}
+
+	return scanBootstrapBlocks(c, nmHash, bi.Uint64(), epochs*epochDuration)
+}
+
+// bootstrapChain is a subset of N3 RPC client methods
+// used to scan the blocks for the bootstraps.
+type bootstrapChain interface {
+	GetBlockCount() (uint32, error)
+	GetBlockByIndex(uint32) (*block.Block, error)
+	GetApplicationLog(util.Uint256, *trigger.Type) (*result.ApplicationLog, error)
+}
+
+// scanBootstrapBlocks scans the window of the latest blocks and returns
+// number of epochs passed since the last bootstrap of each node. Epochs are
+// counted by `NewEpoch` notifications, so the ages are estimated: the
+// window may not be aligned with the epoch boundaries.
+func scanBootstrapBlocks(c bootstrapChain, nmHash util.Uint160, current, window uint64) (map[string]uint64, error) {
+	height, err := c.GetBlockCount()
+	if err != nil {
+		return nil, fmt.Errorf("can't fetch current height: %w", err)
+	}
+
+	var (
+		epoch = current
+		ages  = make(map[string]uint64)
+		at    = trigger.Application
+	)
+
+	// Blocks are scanned backwards, so the first found bootstrap of the node
+	// is the latest one. Epoch is decremented on each `NewEpoch` notification.
+	for i := uint64(height); i > 0 && uint64(height)-i < window; i-- {
+		b, err := c.GetBlockByIndex(uint32(i - 1))
+		if err != nil {
+			return nil, fmt.Errorf("can't fetch block %d: %w", i-1, err)
+		}
+
+		for j := len(b.Transactions) - 1; j >= 0; j-- {
+			tx := b.Transactions[j]
+			if !bytes.Contains(tx.Script, nmHash.BytesBE()) {
+				continue
+			}
+
+			log, err := c.GetApplicationLog(tx.Hash(), &at)
+			if err != nil {
+				return nil, fmt.Errorf("can't fetch application log of %s: %w", tx.Hash().StringLE(), err)
+			}
+
+			if len(log.Executions) == 0 || log.Executions[0].VMState != vm.HaltState {
+				continue
+			}
+
+			if raw := addPeerNodeInfo(tx.Script, nmHash); raw != nil {
+				info := netmap.NewNodeInfo()
+				if err := info.Unmarshal(raw); err == nil {
+					key := hex.EncodeToString(info.PublicKey())
+					if _, ok := ages[key]; !ok {
+						ages[key] = current - epoch
+					}
+				}
+			}
+
+			for _, ev := range log.Executions[0].Events {
+				if ev.ScriptHash.Equals(nmHash) && ev.Name == "NewEpoch" && epoch > 0 {
+					epoch--
+				}
+			}
+		}
+	}
+
+	return ages, nil
+}
+
+// addPeerNodeInfo returns node info passed to `addPeer` method of the netmap
+// contract in the script. Returns nil if there is no such call.
+func addPeerNodeInfo(script []byte, nmHash util.Uint160) []byte {
+	var (
+		ctx    = vm.NewContext(script)
+		params [][]byte
+		last   []byte
+	)
+
+	for ctx.NextIP() < len(script) {
+		op, param, err := ctx.Next()
+		if err != nil {
+			return nil
+		}
+
+		switch op {
+		case opcode.PUSHDATA1, opcode.PUSHDATA2, opcode.PUSHDATA4:
+			params = append(params, param)
+		case opcode.SYSCALL:
+			// AppCall pushes arguments, method name and contract hash
+			if n := len(params); n >= 3 &&
+				string(params[n-2]) == "addPeer" &&
+				bytes.Equal(params[n-1], nmHash.BytesBE()) {
+				last = params[n-3]
+			}
+			params = params[:0]
+		}
+	}
+
+	return last
+}
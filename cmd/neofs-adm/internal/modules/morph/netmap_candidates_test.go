@@ -0,0 +1,164 @@
+package morph
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/rpc/response/result"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func appCallScript(t *testing.T, h util.Uint160, method string, args ...interface{}) []byte {
+	bw := io.NewBufBinWriter()
+	emit.AppCall(bw.BinWriter, h, method, callflag.All, args...)
+	require.NoError(t, bw.Err)
+
+	return bw.Bytes()
+}
+
+func testNodeInfo(t *testing.T) (string, []byte) {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	info := netmap.NewNodeInfo()
+	info.SetPublicKey(k.PublicKey().Bytes())
+	info.SetAddresses("/ip4/127.0.0.1/tcp/8080")
+
+	raw, err := info.Marshal()
+	require.NoError(t, err)
+
+	return hex.EncodeToString(k.PublicKey().Bytes()), raw
+}
+
+func TestAddPeerNodeInfo(t *testing.T) {
+	nmHash := util.Uint160{1, 2, 3}
+	other := util.Uint160{3, 2, 1}
+	raw := []byte("node info")
+
+	t.Run("addPeer call", func(t *testing.T) {
+		script := appCallScript(t, nmHash, "addPeer", raw)
+		require.Equal(t, raw, addPeerNodeInfo(script, nmHash))
+	})
+
+	t.Run("other method", func(t *testing.T) {
+		script := appCallScript(t, nmHash, "updateState", int64(netmapCandidateOnline), raw)
+		require.Nil(t, addPeerNodeInfo(script, nmHash))
+	})
+
+	t.Run("other contract", func(t *testing.T) {
+		script := appCallScript(t, other, "addPeer", raw)
+		require.Nil(t, addPeerNodeInfo(script, nmHash))
+	})
+
+	t.Run("multiple calls", func(t *testing.T) {
+		last := []byte("last node info")
+
+		script := appCallScript(t, nmHash, "addPeer", raw)
+		script = append(script, appCallScript(t, other, "addPeer", []byte("other"))...)
+		script = append(script, appCallScript(t, nmHash, "addPeer", last)...)
+
+		require.Equal(t, last, addPeerNodeInfo(script, nmHash))
+	})
+
+	t.Run("invalid script", func(t *testing.T) {
+		script := appCallScript(t, nmHash, "addPeer", raw)
+		require.Nil(t, addPeerNodeInfo(script[:len(script)/2], nmHash))
+	})
+}
+
+// testChain is an in-memory chain implementing bootstrapChain.
+type testChain struct {
+	blocks []*block.Block
+	logs   map[util.Uint256]*result.ApplicationLog
+}
+
+func (c *testChain) GetBlockCount() (uint32, error) {
+	return uint32(len(c.blocks)), nil
+}
+
+func (c *testChain) GetBlockByIndex(i uint32) (*block.Block, error) {
+	if int(i) >= len(c.blocks) {
+		return nil, errors.New("block not found")
+	}
+
+	return c.blocks[i], nil
+}
+
+func (c *testChain) GetApplicationLog(h util.Uint256, _ *trigger.Type) (*result.ApplicationLog, error) {
+	log, ok := c.logs[h]
+	if !ok {
+		return nil, errors.New("application log not found")
+	}
+
+	return log, nil
+}
+
+// addBlock adds block with a single transaction executing
+// the script with the given VM state and notifications.
+func (c *testChain) addBlock(script []byte, st vm.State, events ...state.NotificationEvent) {
+	tx := transaction.New(script, 0)
+
+	c.blocks = append(c.blocks, &block.Block{Transactions: []*transaction.Transaction{tx}})
+	c.logs[tx.Hash()] = &result.ApplicationLog{
+		Container: tx.Hash(),
+		Executions: []state.Execution{{
+			Trigger: trigger.Application,
+			VMState: st,
+			Events:  events,
+		}},
+	}
+}
+
+func TestScanBootstrapBlocks(t *testing.T) {
+	nmHash := util.Uint160{1, 2, 3}
+	newEpoch := state.NotificationEvent{ScriptHash: nmHash, Name: "NewEpoch"}
+
+	keyA, infoA := testNodeInfo(t)
+	keyB, infoB := testNodeInfo(t)
+	keyC, infoC := testNodeInfo(t)
+	keyD, infoD := testNodeInfo(t)
+
+	c := &testChain{logs: make(map[util.Uint256]*result.ApplicationLog)}
+
+	// epoch 1
+	c.addBlock(appCallScript(t, nmHash, "addPeer", infoD), vm.HaltState)
+	c.addBlock(appCallScript(t, nmHash, "newEpoch", int64(2)), vm.HaltState, newEpoch)
+	// epoch 2
+	c.addBlock(appCallScript(t, nmHash, "addPeer", infoA), vm.HaltState)
+	c.addBlock(appCallScript(t, nmHash, "addPeer", infoB), vm.HaltState)
+	c.addBlock(appCallScript(t, nmHash, "newEpoch", int64(3)), vm.HaltState, newEpoch)
+	// epoch 3
+	c.addBlock(appCallScript(t, nmHash, "addPeer", infoB), vm.HaltState)
+	c.addBlock(appCallScript(t, nmHash, "addPeer", infoC), vm.FaultState)
+	c.addBlock(appCallScript(t, util.Uint160{3, 2, 1}, "addPeer", infoC), vm.HaltState)
+
+	ages, err := scanBootstrapBlocks(c, nmHash, 3, uint64(len(c.blocks)-1))
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{
+		keyA: 1,
+		keyB: 0,
+	}, ages)
+
+	ages, err = scanBootstrapBlocks(c, nmHash, 3, uint64(len(c.blocks)))
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{
+		keyA: 1,
+		keyB: 0,
+		keyD: 2,
+	}, ages)
+
+	_, ok := ages[keyC]
+	require.False(t, ok, "failed and foreign bootstraps must be ignored")
+}
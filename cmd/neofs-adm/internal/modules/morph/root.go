@@ -35,6 +35,14 @@ const (
 	refillGasAmountFlag       = "gas"
 	walletAccountFlag         = "account"
 	notaryDepositTillFlag     = "till"
+	txExportFlag              = "export"
+	txValidBlocksFlag         = "valid-blocks"
+	txContextFlag             = "context"
+	txOutFlag                 = "out"
+	forceConfigSetFlag        = "force"
+	dryRunConfigSetFlag       = "dry-run"
+	candidatesScanEpochsFlag  = "scan-epochs"
+	newAlphabetWalletsFlag    = "new-alphabet-wallets"
 )
 
 var (
@@ -124,6 +132,57 @@ var (
 		RunE: dumpNetworkConfig,
 	}
 
+	netmapCandidatesCmd = &cobra.Command{
+		Use:   "netmap-candidates",
+		Short: "List netmap candidates with their states and attributes",
+		Long: "List netmap candidates with their states and attributes.\n" +
+			"Age is a number of epochs since the last bootstrap of the node, inner ring\n" +
+			"removes the node when it exceeds the netmap cleaner threshold.",
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: listNetmapCandidates,
+	}
+
+	removeNode = &cobra.Command{
+		Use:   "remove-node <public-key>",
+		Short: "Remove storage node from the next network map",
+		Args:  cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: removeNodeCmd,
+	}
+
+	rotateAlphabet = &cobra.Command{
+		Use:   "rotate-alphabet",
+		Short: "Replace side chain alphabet with the new one",
+		Long: "Replace side chain alphabet with the new one step by step: generate new wallets,\n" +
+			"register and vote for new committee candidates, update designated roles and move\n" +
+			"committee funds. Command pauses before the vote until consensus nodes with the new\n" +
+			"wallets are started and inner ring `morph.validators` are updated.\n" +
+			"Command can be restarted, finished stages are skipped.",
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: rotateAlphabetCmd,
+	}
+
+	setConfig = &cobra.Command{
+		Use:                   "set-config key1=val1 [key2=val2 ...]",
+		DisableFlagsInUseLine: true,
+		Short:                 "Set NeoFS network configuration",
+		Long: "Set NeoFS network configuration values in the netmap contract.\n" +
+			"Values of the known keys are validated, changes are printed before the update.",
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: setConfigCmd,
+	}
+
 	updateContractsCmd = &cobra.Command{
 		Use:   "update-contracts",
 		Short: "Update NeoFS contracts.",
@@ -161,6 +220,26 @@ var (
 		},
 		RunE: depositNotary,
 	}
+
+	signTxCmd = &cobra.Command{
+		Use:   "sign",
+		Short: "Sign exported transaction with alphabet wallets",
+		Long: "Sign exported transaction with all the alphabet wallets present in the directory.\n" +
+			"Only accounts which are required by the transaction signers are unlocked.",
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(alphabetWalletsFlag, cmd.Flags().Lookup(alphabetWalletsFlag))
+		},
+		RunE: signTxContext,
+	}
+
+	sendTxCmd = &cobra.Command{
+		Use:   "send",
+		Short: "Merge signatures of exported transaction and send it",
+		PreRun: func(cmd *cobra.Command, _ []string) {
+			_ = viper.BindPFlag(endpointFlag, cmd.Flags().Lookup(endpointFlag))
+		},
+		RunE: sendTxContext,
+	}
 )
 
 func init() {
@@ -187,6 +266,7 @@ func init() {
 	RootCmd.AddCommand(forceNewEpoch)
 	forceNewEpoch.Flags().String(alphabetWalletsFlag, "", "path to alphabet wallets dir")
 	forceNewEpoch.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	addExportFlags(forceNewEpoch)
 
 	RootCmd.AddCommand(dumpContractHashesCmd)
 	dumpContractHashesCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
@@ -194,10 +274,33 @@ func init() {
 	RootCmd.AddCommand(dumpNetworkConfigCmd)
 	dumpNetworkConfigCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
 
+	RootCmd.AddCommand(netmapCandidatesCmd)
+	netmapCandidatesCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	netmapCandidatesCmd.Flags().Uint64(candidatesScanEpochsFlag, 4, "number of last epochs to scan for node bootstraps to estimate their age (0 disables age estimation)")
+
+	RootCmd.AddCommand(removeNode)
+	removeNode.Flags().String(alphabetWalletsFlag, "", "path to alphabet wallets dir")
+	removeNode.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	addExportFlags(removeNode)
+
+	RootCmd.AddCommand(rotateAlphabet)
+	rotateAlphabet.Flags().String(alphabetWalletsFlag, "", "path to current alphabet wallets dir")
+	rotateAlphabet.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	rotateAlphabet.Flags().String(newAlphabetWalletsFlag, "", "path to new alphabet wallets dir")
+	_ = rotateAlphabet.MarkFlagRequired(newAlphabetWalletsFlag)
+
+	RootCmd.AddCommand(setConfig)
+	setConfig.Flags().String(alphabetWalletsFlag, "", "path to alphabet wallets dir")
+	setConfig.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	setConfig.Flags().Bool(forceConfigSetFlag, false, "force setting not well-known configuration key")
+	setConfig.Flags().Bool(dryRunConfigSetFlag, false, "print changes without sending the transaction")
+	addExportFlags(setConfig)
+
 	RootCmd.AddCommand(updateContractsCmd)
 	updateContractsCmd.Flags().String(alphabetWalletsFlag, "", "path to alphabet wallets dir")
 	updateContractsCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
 	updateContractsCmd.Flags().String(contractsInitFlag, "", "path to archive with compiled NeoFS contracts (default fetched from latest github release)")
+	addExportFlags(updateContractsCmd)
 
 	RootCmd.AddCommand(dumpContainersCmd)
 	dumpContainersCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
@@ -225,4 +328,20 @@ func init() {
 	depositNotaryCmd.Flags().String(walletAccountFlag, "", "wallet account address")
 	depositNotaryCmd.Flags().String(refillGasAmountFlag, "", "amount of GAS to deposit")
 	depositNotaryCmd.Flags().String(notaryDepositTillFlag, "", "notary deposit duration in blocks")
+
+	RootCmd.AddCommand(signTxCmd)
+	signTxCmd.Flags().String(alphabetWalletsFlag, "", "path to alphabet wallets dir")
+	signTxCmd.Flags().String(txContextFlag, "", "path to transaction context file")
+	signTxCmd.Flags().String(txOutFlag, "", "path to save signed context to (default overwrites input file)")
+	_ = signTxCmd.MarkFlagRequired(txContextFlag)
+
+	RootCmd.AddCommand(sendTxCmd)
+	sendTxCmd.Flags().StringP(endpointFlag, "r", "", "N3 RPC node endpoint")
+	sendTxCmd.Flags().StringSlice(txContextFlag, nil, "signed transaction context files to merge")
+	_ = sendTxCmd.MarkFlagRequired(txContextFlag)
+}
+
+func addExportFlags(cmd *cobra.Command) {
+	cmd.Flags().String(txExportFlag, "", "save unsigned transactions to the file instead of sending them")
+	cmd.Flags().Uint32(txValidBlocksFlag, defaultExportValidBlocks, "number of blocks exported transactions are valid for")
 }
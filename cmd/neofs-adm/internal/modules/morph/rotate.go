@@ -0,0 +1,647 @@
+package morph
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/cli/input"
+	"github.com/nspcc-dev/neo-go/pkg/core/native"
+	"github.com/nspcc-dev/neo-go/pkg/core/native/nativenames"
+	"github.com/nspcc-dev/neo-go/pkg/core/native/noderoles"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/rpc/client"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/nspcc-dev/neo-go/pkg/vm/opcode"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/nspcc-dev/neo-go/pkg/wallet"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-adm/internal/modules/config"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// rotationStateFilename is a name of the file in the new alphabet wallets
+	// directory, which stores the last finished rotation stage.
+	rotationStateFilename = "rotation.json"
+
+	// rotationCommitteeTimeout is a time to wait for side chain committee update.
+	rotationCommitteeTimeout = 5 * time.Minute
+
+	// rotationBlocksAfterSwitch is a number of blocks which must be produced
+	// after validators update to consider new consensus nodes working.
+	rotationBlocksAfterSwitch = 2
+)
+
+type rotationState struct {
+	Stage int `json:"stage"`
+}
+
+type rotationContext struct {
+	// old is a context of the current alphabet.
+	old *initializeContext
+	// new is a context of the new alphabet.
+	new *initializeContext
+
+	keys      keys.PublicKeys
+	statePath string
+	state     rotationState
+}
+
+func rotateAlphabetCmd(cmd *cobra.Command, _ []string) error {
+	oldCtx, err := newInitializeContext(cmd, viper.GetViper())
+	if err != nil {
+		return fmt.Errorf("initialization error: %w", err)
+	}
+
+	newDir, _ := cmd.Flags().GetString(newAlphabetWalletsFlag)
+	if newDir == "" {
+		return errors.New("missing new alphabet wallets dir")
+	}
+
+	newDir = config.ResolveHomePath(newDir)
+	oldDir := config.ResolveHomePath(viper.GetString(alphabetWalletsFlag))
+	if filepath.Clean(newDir) == filepath.Clean(oldDir) {
+		return errors.New("new alphabet wallets dir must differ from the current one")
+	}
+
+	rCtx := &rotationContext{
+		old:       oldCtx,
+		statePath: filepath.Join(newDir, rotationStateFilename),
+	}
+
+	if err := rCtx.readState(); err != nil {
+		return err
+	}
+
+	if err := rCtx.run(cmd, rCtx.stages(newDir)); err != nil {
+		return err
+	}
+
+	cmd.Println("Alphabet rotation is finished, restart inner ring nodes with the new wallets.")
+	return nil
+}
+
+// rotationStage is a single step of the alphabet rotation.
+type rotationStage struct {
+	name string
+	f    func() error
+}
+
+// stages returns the stages of the rotation to the new alphabet
+// with the wallets in the specified directory.
+//
+// Consensus nodes must be reconfigured before the vote, because the
+// new keys become validators as soon as the votes are counted.
+func (r *rotationContext) stages(newDir string) []rotationStage {
+	return []rotationStage{
+		{"generate new alphabet wallets", func() error { return r.generateWallets(newDir) }},
+		{"transfer GAS to new alphabet nodes", r.transferGAS},
+		{"register new candidates", r.registerCandidates},
+		{"reconfigure consensus nodes and inner ring validators", r.confirmConsensus},
+		{"vote for new candidates via alphabet contracts", r.vote},
+		{"wait for side chain committee and validators update", r.awaitCommittee},
+		{"set notary and alphabet nodes in designate contract", r.designate},
+		{"transfer committee funds to the new committee account", r.transferCommitteeFunds},
+	}
+}
+
+// run performs the stages in order. Stages finished by the previous
+// runs are skipped, the number of the last finished stage is saved
+// after each successful stage.
+func (r *rotationContext) run(cmd *cobra.Command, stages []rotationStage) error {
+	for i, s := range stages {
+		stage := i + 1
+
+		if stage <= r.state.Stage && r.new != nil {
+			cmd.Printf("Stage %d: already performed.\n", stage)
+			continue
+		}
+
+		cmd.Printf("Stage %d: %s.\n", stage, s.name)
+		if err := s.f(); err != nil {
+			return fmt.Errorf("stage %d: %w", stage, err)
+		}
+
+		if err := r.writeState(stage); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *rotationContext) readState() error {
+	data, err := ioutil.ReadFile(r.statePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("can't read rotation state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &r.state); err != nil {
+		return fmt.Errorf("can't parse rotation state: %w", err)
+	}
+
+	// new wallets are required for all the stages after the first one
+	if r.state.Stage > 0 {
+		return r.openWallets(filepath.Dir(r.statePath))
+	}
+
+	return nil
+}
+
+func (r *rotationContext) writeState(stage int) error {
+	r.state.Stage = stage
+
+	data, err := json.Marshal(r.state)
+	if err != nil {
+		return fmt.Errorf("can't marshal rotation state: %w", err)
+	}
+
+	if err := ioutil.WriteFile(r.statePath, data, 0644); err != nil {
+		return fmt.Errorf("can't save rotation state: %w", err)
+	}
+
+	return nil
+}
+
+func (r *rotationContext) generateWallets(walletDir string) error {
+	if err := os.MkdirAll(walletDir, 0700); err != nil {
+		return fmt.Errorf("can't create new alphabet wallets dir: %w", err)
+	}
+
+	// wallets could be generated by the previous interrupted run
+	p := filepath.Join(walletDir, innerring.GlagoliticLetter(0).String()+".json")
+	if _, err := os.Stat(p); err == nil {
+		return r.openWallets(walletDir)
+	}
+
+	// committee size is fixed in the side chain protocol configuration
+	if _, err := initializeWallets(walletDir, len(r.old.Wallets)); err != nil {
+		return err
+	}
+
+	return r.openWallets(walletDir)
+}
+
+func (r *rotationContext) openWallets(walletDir string) error {
+	wallets, err := openAlphabetWallets(walletDir, true)
+	if err != nil {
+		return err
+	}
+
+	if len(wallets) != len(r.old.Wallets) {
+		return fmt.Errorf("new alphabet size differs from the current one: %d != %d",
+			len(wallets), len(r.old.Wallets))
+	}
+
+	newCtx := *r.old
+	newCtx.Hashes = nil
+	newCtx.Wallets = wallets
+	newCtx.Accounts = make([]*wallet.Account, len(wallets))
+	r.keys = make(keys.PublicKeys, len(wallets))
+
+	for i, w := range wallets {
+		acc, err := getWalletAccount(w, singleAccountName)
+		if err != nil {
+			return fmt.Errorf("wallet %s is invalid (no single account): %w", w.Path(), err)
+		}
+
+		newCtx.Accounts[i] = acc
+		r.keys[i] = acc.PrivateKey().PublicKey()
+	}
+
+	newCtx.CommitteeAcc, err = getWalletAccount(wallets[0], committeeAccountName)
+	if err != nil {
+		return fmt.Errorf("can't find committee account: %w", err)
+	}
+
+	newCtx.ConsensusAcc, err = getWalletAccount(wallets[0], consensusAccountName)
+	if err != nil {
+		return fmt.Errorf("can't find consensus account: %w", err)
+	}
+
+	sort.Sort(r.keys)
+	r.new = &newCtx
+
+	return nil
+}
+
+func (r *rotationContext) transferGAS() error {
+	gasHash := r.old.nativeHash(nativenames.Gas)
+
+	bw := io.NewBufBinWriter()
+	for _, acc := range r.new.Accounts {
+		bal, err := r.old.Client.NEP17BalanceOf(gasHash, acc.Contract.ScriptHash())
+		if err != nil {
+			return fmt.Errorf("can't fetch GAS balance: %w", err)
+		}
+
+		if bal >= initialAlphabetGASAmount/2 {
+			continue
+		}
+
+		emit.AppCall(bw.BinWriter, gasHash, "transfer", callflag.All,
+			r.old.CommitteeAcc.Contract.ScriptHash(), acc.Contract.ScriptHash(),
+			int64(initialAlphabetGASAmount), nil)
+		emit.Opcodes(bw.BinWriter, opcode.ASSERT)
+	}
+
+	if bw.Len() == 0 {
+		return nil
+	}
+
+	if err := r.old.sendCommitteeEntryTx(bw.Bytes()); err != nil {
+		return err
+	}
+
+	return r.old.awaitTx()
+}
+
+func (r *rotationContext) registerCandidates() error {
+	neoHash := r.new.nativeHash(nativenames.Neo)
+
+	candidates, err := getCandidateVotes(r.new.Client, neoHash)
+	if err != nil {
+		return err
+	}
+
+	regPrice, err := r.new.Client.GetCandidateRegisterPrice()
+	if err != nil {
+		return fmt.Errorf("can't fetch registration price: %w", err)
+	}
+
+	sysGas := regPrice + native.GASFactor // + 1 GAS
+	for _, acc := range r.new.Accounts {
+		pub := acc.PrivateKey().PublicKey()
+		if _, ok := candidates[string(pub.Bytes())]; ok {
+			continue
+		}
+
+		w := io.NewBufBinWriter()
+		emit.AppCall(w.BinWriter, neoHash, "registerCandidate", callflag.States, pub.Bytes())
+		emit.Opcodes(w.BinWriter, opcode.ASSERT)
+
+		h, err := r.new.Client.SignAndPushInvocationTx(w.Bytes(), acc, sysGas, 0, []client.SignerAccount{{
+			Signer: transaction.Signer{
+				Account: acc.Contract.ScriptHash(),
+				Scopes:  transaction.CalledByEntry,
+			},
+			Account: acc,
+		}})
+		if err != nil {
+			return err
+		}
+
+		r.new.Hashes = append(r.new.Hashes, h)
+	}
+
+	return r.new.awaitTx()
+}
+
+// errConsensusNotReady is returned when consensus nodes reconfiguration
+// is not confirmed by the operator.
+var errConsensusNotReady = errors.New("consensus nodes are not reconfigured, " +
+	"run the command again when they are ready")
+
+// confirmConsensus pauses the rotation before the vote. Once the votes
+// are counted, the new keys become side chain validators, so the chain
+// halts if there are no consensus nodes with the new wallets. Inner ring
+// nodes vote for the validators from `morph.validators` config section on
+// startup and on alphabet updates, so it must be changed too, otherwise
+// the old validators are voted for again.
+func (r *rotationContext) confirmConsensus() error {
+	cmd := r.old.Command
+
+	cmd.Println("New alphabet keys:")
+	for i, acc := range r.new.Accounts {
+		cmd.Printf("\t%s: %s (%s)\n", innerring.GlagoliticLetter(i),
+			hex.EncodeToString(acc.PrivateKey().PublicKey().Bytes()), r.new.Wallets[i].Path())
+	}
+
+	cmd.Println("After the vote the new keys become side chain validators. Before continuing:")
+	cmd.Println("\t1. Start side chain consensus nodes with the new alphabet wallets,")
+	cmd.Println("\t   they stay inactive until the new validators are elected.")
+	cmd.Println("\t2. Replace the keys in `morph.validators` section of inner ring")
+	cmd.Println("\t   configuration with the new ones and restart inner ring nodes,")
+	cmd.Println("\t   otherwise they vote for the old validators again.")
+
+	answer, err := input.ReadLine("Type 'yes' when consensus nodes are ready > ")
+	if err != nil {
+		return fmt.Errorf("can't read confirmation: %w", err)
+	}
+
+	if strings.TrimSpace(answer) != "yes" {
+		return errConsensusNotReady
+	}
+
+	return nil
+}
+
+func (r *rotationContext) vote() error {
+	nnsCs, err := r.old.nnsContractState()
+	if err != nil {
+		return fmt.Errorf("can't get NNS contract info: %w", err)
+	}
+
+	nmHash, err := nnsResolveHash(r.old.Client, nnsCs.Hash, netmapContract+".neofs")
+	if err != nil {
+		return fmt.Errorf("can't get netmap contract hash: %w", err)
+	}
+
+	res, err := r.old.Client.InvokeFunction(nmHash, "epoch", []smartcontract.Parameter{}, nil)
+	if err != nil || res.State != vm.HaltState.String() || len(res.Stack) == 0 {
+		return errors.New("can't fetch current epoch from the netmap contract")
+	}
+
+	epoch, err := res.Stack[0].TryInteger()
+	if err != nil {
+		return fmt.Errorf("can't parse current epoch: %w", err)
+	}
+
+	candidates, err := getCandidateVotes(r.old.Client, r.old.nativeHash(nativenames.Neo))
+	if err != nil {
+		return err
+	}
+
+	voted := true
+	for i := range r.keys {
+		if candidates[string(r.keys[i].Bytes())] == 0 {
+			voted = false
+			break
+		}
+	}
+
+	if voted {
+		return nil
+	}
+
+	pubs := make([]interface{}, len(r.keys))
+	for i := range r.keys {
+		pubs[i] = r.keys[i].Bytes()
+	}
+
+	// Each alphabet contract votes with its NEO for the candidate
+	// with the same index, so all the new keys get votes.
+	bw := io.NewBufBinWriter()
+	for i := range r.old.Wallets {
+		h, err := nnsResolveHash(r.old.Client, nnsCs.Hash, getAlphabetNNSDomain(i))
+		if err != nil {
+			return fmt.Errorf("can't resolve alphabet contract hash: %w", err)
+		}
+
+		emit.AppCall(bw.BinWriter, h, "vote", callflag.All, epoch.Int64(), pubs)
+	}
+
+	if err := r.old.sendCommitteeTx(bw.Bytes(), -1); err != nil {
+		return err
+	}
+
+	return r.old.awaitTx()
+}
+
+func (r *rotationContext) awaitCommittee() error {
+	// Committee is recalculated in the side chain every `committee size`
+	// blocks, so the new one is expected in a several blocks.
+	timer := time.NewTimer(rotationCommitteeTimeout)
+	defer timer.Stop()
+
+	tick := time.NewTicker(r.new.PollInterval)
+	defer tick.Stop()
+
+	var switchHeight uint32
+
+	for {
+		height, err := r.new.Client.GetBlockCount()
+		if err != nil {
+			return fmt.Errorf("can't fetch current height: %w", err)
+		}
+
+		if switchHeight == 0 {
+			updated, err := r.validatorsUpdated()
+			if err != nil {
+				return err
+			}
+
+			if updated {
+				switchHeight = height
+			}
+		} else if height >= switchHeight+rotationBlocksAfterSwitch {
+			// new consensus nodes produce blocks
+			return nil
+		}
+
+		select {
+		case <-tick.C:
+		case <-timer.C:
+			if switchHeight != 0 {
+				return errors.New("side chain does not produce blocks after validators update, " +
+					"check consensus nodes with the new wallets")
+			}
+
+			return errors.New("side chain committee has not been updated yet, run the command again later")
+		}
+	}
+}
+
+// validatorsUpdated checks that side chain committee consists of the new
+// keys and that the next block validators are among them.
+func (r *rotationContext) validatorsUpdated() (bool, error) {
+	committee, err := r.new.Client.GetCommittee()
+	if err != nil {
+		return false, fmt.Errorf("can't fetch side chain committee: %w", err)
+	}
+
+	if !equalKeys(committee, r.keys) {
+		return false, nil
+	}
+
+	validators, err := r.new.Client.GetNextBlockValidators()
+	if err != nil {
+		return false, fmt.Errorf("can't fetch next block validators: %w", err)
+	}
+
+	pubs := make(keys.PublicKeys, len(validators))
+	for i := range validators {
+		pubs[i] = &validators[i].PublicKey
+	}
+
+	return containsKeys(r.keys, pubs), nil
+}
+
+func (r *rotationContext) designate() error {
+	height, err := r.new.Client.GetBlockCount()
+	if err != nil {
+		return err
+	}
+
+	pubs, err := r.new.Client.GetDesignatedByRole(noderoles.NeoFSAlphabet, height)
+	if err != nil {
+		return fmt.Errorf("can't fetch designated alphabet nodes: %w", err)
+	}
+
+	if equalKeys(pubs, r.keys) {
+		return nil
+	}
+
+	designateHash := r.new.nativeHash(nativenames.Designation)
+
+	keysParam := make([]interface{}, len(r.keys))
+	for i := range r.keys {
+		keysParam[i] = r.keys[i].Bytes()
+	}
+
+	// roles are designated by the committee, which is the new alphabet now
+	w := io.NewBufBinWriter()
+	emit.AppCall(w.BinWriter, designateHash, "designateAsRole",
+		callflag.States|callflag.AllowNotify, int64(noderoles.P2PNotary), keysParam)
+	emit.AppCall(w.BinWriter, designateHash, "designateAsRole",
+		callflag.States|callflag.AllowNotify, int64(noderoles.NeoFSAlphabet), keysParam)
+
+	if err := r.new.sendCommitteeEntryTx(w.Bytes()); err != nil {
+		return err
+	}
+
+	return r.new.awaitTx()
+}
+
+func (r *rotationContext) transferCommitteeFunds() error {
+	var (
+		gasHash = r.old.nativeHash(nativenames.Gas)
+		neoHash = r.old.nativeHash(nativenames.Neo)
+		from    = r.old.CommitteeAcc.Contract.ScriptHash()
+		to      = r.new.CommitteeAcc.Contract.ScriptHash()
+	)
+
+	bw := io.NewBufBinWriter()
+
+	neoBal, err := r.old.Client.NEP17BalanceOf(neoHash, from)
+	if err != nil {
+		return fmt.Errorf("can't fetch NEO balance: %w", err)
+	}
+
+	if neoBal > 0 {
+		emit.AppCall(bw.BinWriter, neoHash, "transfer", callflag.All, from, to, neoBal, nil)
+		emit.Opcodes(bw.BinWriter, opcode.ASSERT)
+	}
+
+	gasBal, err := r.old.Client.NEP17BalanceOf(gasHash, from)
+	if err != nil {
+		return fmt.Errorf("can't fetch GAS balance: %w", err)
+	}
+
+	// 1 GAS is left to pay for the transaction
+	if gasBal > native.GASFactor {
+		emit.AppCall(bw.BinWriter, gasHash, "transfer", callflag.All, from, to, gasBal-native.GASFactor, nil)
+		emit.Opcodes(bw.BinWriter, opcode.ASSERT)
+	}
+
+	if bw.Len() == 0 {
+		return nil
+	}
+
+	if err := r.old.sendCommitteeEntryTx(bw.Bytes()); err != nil {
+		return err
+	}
+
+	return r.old.awaitTx()
+}
+
+// sendCommitteeEntryTx sends committee transaction with the witness scope
+// limited to the entry script. It is used for native contract calls, which
+// are not covered by the contract group scope.
+func (c *initializeContext) sendCommitteeEntryTx(script []byte) error {
+	tx, err := c.Client.CreateTxFromScript(script, c.CommitteeAcc, -1, 0, []client.SignerAccount{{
+		Signer: transaction.Signer{
+			Account: c.CommitteeAcc.Contract.ScriptHash(),
+			Scopes:  transaction.CalledByEntry,
+		},
+		Account: c.CommitteeAcc,
+	}})
+	if err != nil {
+		return fmt.Errorf("can't create tx: %w", err)
+	}
+
+	return c.multiSignAndSend(tx, committeeAccountName)
+}
+
+// getCandidateVotes returns registered candidates of the side chain
+// committee with their votes. Map keys are binary public keys.
+func getCandidateVotes(c *client.Client, neoHash util.Uint160) (map[string]int64, error) {
+	res, err := c.InvokeFunction(neoHash, "getCandidates", []smartcontract.Parameter{}, nil)
+	if err != nil || res.State != vm.HaltState.String() || len(res.Stack) == 0 {
+		return nil, errors.New("can't fetch candidates from NEO contract")
+	}
+
+	arr, ok := res.Stack[0].Value().([]stackitem.Item)
+	if !ok {
+		return nil, errors.New("invalid getCandidates response from NEO contract")
+	}
+
+	candidates := make(map[string]int64, len(arr))
+	for i := range arr {
+		tuple, ok := arr[i].Value().([]stackitem.Item)
+		if !ok || len(tuple) != 2 {
+			return nil, errors.New("invalid getCandidates response from NEO contract")
+		}
+
+		pub, err := tuple[0].TryBytes()
+		if err != nil {
+			return nil, fmt.Errorf("invalid candidate key: %w", err)
+		}
+
+		votes, err := tuple[1].TryInteger()
+		if err != nil {
+			return nil, fmt.Errorf("invalid candidate votes: %w", err)
+		}
+
+		candidates[string(pub)] = votes.Int64()
+	}
+
+	return candidates, nil
+}
+
+// containsKeys checks that all the keys from sub are present in set.
+func containsKeys(set, sub keys.PublicKeys) bool {
+	for i := range sub {
+		if !set.Contains(sub[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalKeys(a, b keys.PublicKeys) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a, b = a.Copy(), b.Copy()
+	sort.Sort(a)
+	sort.Sort(b)
+
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
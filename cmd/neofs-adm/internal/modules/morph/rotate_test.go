@@ -0,0 +1,136 @@
+package morph
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRotation(t *testing.T) (*rotationContext, string) {
+	const size = 4
+
+	_, oldWallets := newTestAlphabet(t, size)
+	newDir, _ := newTestAlphabet(t, size)
+
+	cmd := new(cobra.Command)
+	cmd.SetOut(bytes.NewBuffer(nil))
+
+	return &rotationContext{
+		old: &initializeContext{
+			Command: cmd,
+			Wallets: oldWallets,
+		},
+		statePath: filepath.Join(newDir, rotationStateFilename),
+	}, newDir
+}
+
+func TestRotationContext_Stages(t *testing.T) {
+	r, newDir := newTestRotation(t)
+
+	index := func(prefix string) int {
+		for i, s := range r.stages(newDir) {
+			if strings.HasPrefix(s.name, prefix) {
+				return i
+			}
+		}
+
+		t.Fatalf("missing stage %q", prefix)
+		return -1
+	}
+
+	// new keys become validators right after the vote
+	require.Less(t, index("reconfigure consensus"), index("vote"))
+	require.Less(t, index("vote"), index("wait for side chain committee"))
+	require.Less(t, index("wait for side chain committee"), index("set notary and alphabet"))
+}
+
+func TestRotationContext_Run(t *testing.T) {
+	r, newDir := newTestRotation(t)
+	cmd := r.old.Command
+
+	var (
+		calls   []int
+		errTest = errors.New("test error")
+		failing = 2
+	)
+
+	stages := make([]rotationStage, 4)
+	for i := range stages {
+		i := i
+		stages[i].name = "test"
+		stages[i].f = func() error {
+			calls = append(calls, i)
+			if i == failing {
+				return errTest
+			}
+
+			// the first stage opens new wallets
+			if i == 0 {
+				return r.openWallets(newDir)
+			}
+
+			return nil
+		}
+	}
+
+	err := r.run(cmd, stages)
+	require.True(t, errors.Is(err, errTest), "got: %v", err)
+	require.Equal(t, []int{0, 1, 2}, calls)
+
+	t.Run("restart", func(t *testing.T) {
+		restarted := &rotationContext{
+			old:       r.old,
+			statePath: r.statePath,
+		}
+
+		require.NoError(t, restarted.readState())
+		require.Equal(t, failing, restarted.state.Stage)
+		require.NotNil(t, restarted.new, "new wallets must be opened")
+		require.True(t, equalKeys(r.keys, restarted.keys))
+
+		calls, failing = nil, -1
+
+		require.NoError(t, restarted.run(cmd, stages))
+		require.Equal(t, []int{2, 3}, calls)
+
+		require.NoError(t, restarted.readState())
+		require.Equal(t, len(stages), restarted.state.Stage)
+	})
+}
+
+func TestRotationContext_ConfirmConsensus(t *testing.T) {
+	r, newDir := newTestRotation(t)
+	require.NoError(t, r.openWallets(newDir))
+
+	buf := setupTestTerminal(t)
+
+	buf.WriteString("no\r")
+	require.True(t, errors.Is(r.confirmConsensus(), errConsensusNotReady))
+
+	buf.WriteString("yes\r")
+	require.NoError(t, r.confirmConsensus())
+}
+
+func TestContainsKeys(t *testing.T) {
+	set := make(keys.PublicKeys, 4)
+	for i := range set {
+		k, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		set[i] = k.PublicKey()
+	}
+
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	require.True(t, containsKeys(set, set[:3]))
+	require.True(t, containsKeys(set, nil))
+	require.False(t, containsKeys(set, keys.PublicKeys{set[0], k.PublicKey()}))
+	require.False(t, containsKeys(set[:3], set))
+}
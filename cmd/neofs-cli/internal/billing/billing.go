@@ -0,0 +1,292 @@
+// Package billing provides helpers for the calculation of the container
+// storage costs according to the settlement rules of the Inner Ring.
+//
+// Basic income of the epoch is calculated from the container size
+// estimations announced by the storage nodes in that epoch, so the
+// expected payments can be compared with the actual transfers made
+// by the Inner Ring.
+package billing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	core "github.com/nspcc-dev/neofs-node/pkg/core/container"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/basic"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/common"
+	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/owner"
+)
+
+// Source provides the settlement data of the NeoFS network.
+type Source interface {
+	// Estimations must return container size estimations
+	// announced in the epoch. Estimations of the containers
+	// rejected by the filter may be omitted.
+	Estimations(epoch uint64, filter func(*cid.ID) bool) ([]*cntClient.Estimations, error)
+
+	// ContainerOwner must return the owner of the container.
+	// Must return core.ErrNotFound if the container has been removed.
+	ContainerOwner(cnr *cid.ID) (*owner.ID, error)
+
+	// ContainerNodes must return the number of nodes which
+	// store the container in the epoch. Must return
+	// core.ErrNotFound if the container has been removed.
+	ContainerNodes(epoch uint64, cnr *cid.ID) (int, error)
+}
+
+// Transfer describes a settlement transfer of the account.
+type Transfer struct {
+	// Type of the settlement.
+	Type common.SettlementType
+
+	// Epoch of the settlement.
+	Epoch uint64
+
+	// Amount of the transfer. Negative if the account is the payer.
+	Amount *big.Int
+}
+
+// ContainerCost describes the basic income payment for the storage
+// of the container in the epoch.
+type ContainerCost struct {
+	// Container identifier.
+	Container *cid.ID
+
+	// Size is an average container size per node.
+	Size uint64
+
+	// Nodes is a number of container nodes.
+	Nodes int
+
+	// Cost of the storage. Nil if the container has been removed.
+	Cost *big.Int
+
+	// Removed is true if the container has been removed, so its
+	// owner, nodes and cost are unknown.
+	Removed bool
+}
+
+// EpochReport is a settlement cost breakdown of the epoch.
+type EpochReport struct {
+	// Epoch number.
+	Epoch uint64
+
+	// Containers contains the expected costs of the containers
+	// with size estimations.
+	Containers []ContainerCost
+
+	// Expected is a sum of the costs of the existing containers.
+	Expected *big.Int
+
+	// Transfers contains the sums of the actual transfers
+	// of the account per settlement type.
+	Transfers map[common.SettlementType]*big.Int
+}
+
+// Prm groups the parameters of Report.
+type Prm struct {
+	// From and To are the bounds of the epoch range inclusively.
+	From, To uint64
+
+	// Rate is a basic income rate per GB.
+	Rate uint64
+
+	// Containers limits the report to the containers. Containers of
+	// the Owner are reported if empty.
+	Containers []*cid.ID
+
+	// Owner of the containers. Removed containers are not reported
+	// since their owner can't be resolved anymore, they can be
+	// reported by identifier only.
+	Owner *owner.ID
+
+	// Transfers are the settlement transfers of the account.
+	Transfers []Transfer
+}
+
+// MaxEpochs is a maximum number of epochs in the range.
+const MaxEpochs = 10000
+
+var errEpochRange = errors.New("invalid epoch range")
+
+// ParseEpochs parses the epoch range in "a..b" format. Single epoch
+// number is also accepted. Range must contain no more than MaxEpochs
+// epochs.
+func ParseEpochs(s string) (from, to uint64, err error) {
+	bounds := strings.SplitN(s, "..", 2)
+
+	from, err = strconv.ParseUint(bounds[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %s", errEpochRange, s)
+	}
+
+	if len(bounds) == 1 {
+		return from, from, nil
+	}
+
+	to, err = strconv.ParseUint(bounds[1], 10, 64)
+	if err != nil || !validEpochRange(from, to) {
+		return 0, 0, fmt.Errorf("%w: %s", errEpochRange, s)
+	}
+
+	return from, to, nil
+}
+
+func validEpochRange(from, to uint64) bool {
+	return from <= to && to-from < MaxEpochs
+}
+
+// Report calculates the expected storage costs of the containers
+// per epoch and groups the actual transfers in the same epochs.
+func Report(src Source, prm Prm) ([]EpochReport, error) {
+	if !validEpochRange(prm.From, prm.To) {
+		return nil, fmt.Errorf("%w: %d..%d", errEpochRange, prm.From, prm.To)
+	}
+
+	ids := make(map[string]struct{}, len(prm.Containers))
+	for i := range prm.Containers {
+		ids[prm.Containers[i].String()] = struct{}{}
+	}
+
+	// owners are cached since the containers are checked in
+	// the filter and after the estimations are fetched
+	owners := make(map[string]containerOwner)
+
+	ownerOf := func(id *cid.ID) (*owner.ID, error) {
+		key := id.String()
+
+		o, ok := owners[key]
+		if !ok {
+			o.id, o.err = src.ContainerOwner(id)
+			owners[key] = o
+		}
+
+		return o.id, o.err
+	}
+
+	// filter is applied before the estimations are fetched, so the
+	// owner check errors are handled after
+	filter := func(id *cid.ID) bool {
+		if len(ids) != 0 {
+			_, ok := ids[id.String()]
+			return ok
+		}
+
+		ownerID, err := ownerOf(id)
+		if err != nil {
+			return !errors.Is(err, core.ErrNotFound)
+		}
+
+		return ownerID.Equal(prm.Owner)
+	}
+
+	res := make([]EpochReport, 0, prm.To-prm.From+1)
+
+	for i := uint64(0); i <= prm.To-prm.From; i++ {
+		epoch := prm.From + i
+
+		estimations, err := src.Estimations(epoch, filter)
+		if err != nil {
+			return nil, fmt.Errorf("could not get size estimations of epoch %d: %w", epoch, err)
+		}
+
+		r := EpochReport{
+			Epoch:     epoch,
+			Expected:  new(big.Int),
+			Transfers: make(map[common.SettlementType]*big.Int),
+		}
+
+		for _, e := range estimations {
+			cost, ok, err := containerCost(src, prm, ids, ownerOf, epoch, e)
+			if err != nil {
+				return nil, err
+			} else if !ok {
+				continue
+			}
+
+			r.Containers = append(r.Containers, cost)
+
+			if !cost.Removed {
+				r.Expected.Add(r.Expected, cost.Cost)
+			}
+		}
+
+		for _, t := range prm.Transfers {
+			if t.Epoch != epoch {
+				continue
+			}
+
+			sum, ok := r.Transfers[t.Type]
+			if !ok {
+				sum = new(big.Int)
+				r.Transfers[t.Type] = sum
+			}
+
+			sum.Add(sum, t.Amount)
+		}
+
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+type containerOwner struct {
+	id  *owner.ID
+	err error
+}
+
+// containerCost calculates the cost of the container with the size estimations.
+// Returns false if the container is not reported.
+func containerCost(src Source, prm Prm, ids map[string]struct{}, ownerOf func(*cid.ID) (*owner.ID, error),
+	epoch uint64, e *cntClient.Estimations) (ContainerCost, bool, error) {
+	cost := ContainerCost{
+		Container: e.ContainerID,
+		Size:      basic.AverageEstimation(e),
+	}
+
+	if len(ids) != 0 {
+		if _, ok := ids[e.ContainerID.String()]; !ok {
+			return cost, false, nil
+		}
+	} else {
+		ownerID, err := ownerOf(e.ContainerID)
+
+		switch {
+		case errors.Is(err, core.ErrNotFound):
+			// owner of the removed container is unknown
+			return cost, false, nil
+		case err != nil:
+			return cost, false, fmt.Errorf("could not get owner of container %s: %w", e.ContainerID, err)
+		case !ownerID.Equal(prm.Owner):
+			return cost, false, nil
+		}
+	}
+
+	nodes, err := src.ContainerNodes(epoch, e.ContainerID)
+	if errors.Is(err, core.ErrNotFound) {
+		cost.Removed = true
+		return cost, true, nil
+	} else if err != nil {
+		return cost, false, fmt.Errorf("could not get nodes of container %s in epoch %d: %w",
+			e.ContainerID, epoch, err)
+	}
+
+	cost.Nodes = nodes
+	cost.Cost = basic.CalculateBasicSum(cost.Size, prm.Rate, nodes)
+
+	return cost, true, nil
+}
+
+// Forecast returns the storage cost of the container of the given size
+// per node stored on the nodes during the number of epochs.
+func Forecast(size, rate uint64, nodes int, epochs uint64) *big.Int {
+	cost := basic.CalculateBasicSum(size, rate, nodes)
+
+	return cost.Mul(cost, new(big.Int).SetUint64(epochs))
+}
@@ -0,0 +1,237 @@
+package billing
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"testing"
+
+	core "github.com/nspcc-dev/neofs-node/pkg/core/container"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/common"
+	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/owner"
+	ownertest "github.com/nspcc-dev/neofs-sdk-go/owner/test"
+	"github.com/stretchr/testify/require"
+)
+
+const gb = 1 << 30
+
+type testSource struct {
+	estimations map[uint64][]*cntClient.Estimations
+	owners      map[string]*owner.ID
+	nodes       map[string]int
+
+	// containers fetched by Estimations
+	fetched []*cid.ID
+
+	// number of ContainerOwner calls per container
+	ownerCalls map[string]int
+}
+
+func (s *testSource) Estimations(epoch uint64, filter func(*cid.ID) bool) ([]*cntClient.Estimations, error) {
+	var res []*cntClient.Estimations
+
+	for _, e := range s.estimations[epoch] {
+		if filter(e.ContainerID) {
+			s.fetched = append(s.fetched, e.ContainerID)
+			res = append(res, e)
+		}
+	}
+
+	return res, nil
+}
+
+func (s *testSource) ContainerOwner(cnr *cid.ID) (*owner.ID, error) {
+	if s.ownerCalls == nil {
+		s.ownerCalls = make(map[string]int)
+	}
+
+	s.ownerCalls[cnr.String()]++
+
+	id, ok := s.owners[cnr.String()]
+	if !ok {
+		return nil, core.ErrNotFound
+	}
+
+	return id, nil
+}
+
+func (s *testSource) ContainerNodes(_ uint64, cnr *cid.ID) (int, error) {
+	n, ok := s.nodes[cnr.String()]
+	if !ok {
+		return 0, core.ErrNotFound
+	}
+
+	return n, nil
+}
+
+func TestParseEpochs(t *testing.T) {
+	from, to, err := ParseEpochs("10..20")
+	require.NoError(t, err)
+	require.EqualValues(t, 10, from)
+	require.EqualValues(t, 20, to)
+
+	from, to, err = ParseEpochs("7")
+	require.NoError(t, err)
+	require.EqualValues(t, 7, from)
+	require.EqualValues(t, 7, to)
+
+	from, to, err = ParseEpochs("1.." + strconv.Itoa(MaxEpochs))
+	require.NoError(t, err)
+	require.EqualValues(t, MaxEpochs, to-from+1)
+
+	for _, s := range []string{"", "a..b", "10..", "20..10", "1..2..3",
+		"0.." + strconv.Itoa(MaxEpochs),
+		"0.." + strconv.FormatUint(math.MaxUint64, 10),
+	} {
+		_, _, err = ParseEpochs(s)
+		require.ErrorIs(t, err, errEpochRange, s)
+	}
+}
+
+func TestReport(t *testing.T) {
+	cnr1, cnr2 := cidtest.ID(), cidtest.ID()
+
+	src := &testSource{
+		estimations: map[uint64][]*cntClient.Estimations{
+			1: {
+				{
+					ContainerID: cnr1,
+					Values:      []cntClient.Estimation{{Size: 2 * gb}, {Size: 4 * gb}},
+				},
+				{
+					ContainerID: cnr2,
+					Values:      []cntClient.Estimation{{Size: gb}},
+				},
+			},
+		},
+		owners: map[string]*owner.ID{
+			cnr1.String(): ownertest.ID(),
+			cnr2.String(): ownertest.ID(),
+		},
+		nodes: map[string]int{
+			cnr1.String(): 2,
+			cnr2.String(): 4,
+		},
+	}
+
+	res, err := Report(src, Prm{
+		From:       1,
+		To:         2,
+		Rate:       10,
+		Containers: []*cid.ID{cnr1},
+		Transfers: []Transfer{
+			{Type: common.BasicIncomeCollection, Epoch: 1, Amount: big.NewInt(-50)},
+			{Type: common.BasicIncomeCollection, Epoch: 1, Amount: big.NewInt(-10)},
+			{Type: common.AuditSettlement, Epoch: 2, Amount: big.NewInt(-1)},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+
+	require.EqualValues(t, 1, res[0].Epoch)
+	require.Len(t, res[0].Containers, 1)
+	require.Equal(t, cnr1, res[0].Containers[0].Container)
+	require.EqualValues(t, 3*gb, res[0].Containers[0].Size)
+	require.Equal(t, 2, res[0].Containers[0].Nodes)
+	require.EqualValues(t, 60, res[0].Expected.Int64())
+	require.EqualValues(t, -60, res[0].Transfers[common.BasicIncomeCollection].Int64())
+
+	require.EqualValues(t, 2, res[1].Epoch)
+	require.Empty(t, res[1].Containers)
+	require.Zero(t, res[1].Expected.Sign())
+	require.EqualValues(t, -1, res[1].Transfers[common.AuditSettlement].Int64())
+
+	// estimations of the other containers are not fetched
+	require.Equal(t, []*cid.ID{cnr1}, src.fetched)
+}
+
+func TestReportOwner(t *testing.T) {
+	ownerID := ownertest.ID()
+	cnr1, cnr2, removed := cidtest.ID(), cidtest.ID(), cidtest.ID()
+
+	src := &testSource{
+		estimations: map[uint64][]*cntClient.Estimations{
+			1: {
+				{
+					ContainerID: cnr1,
+					Values:      []cntClient.Estimation{{Size: gb}},
+				},
+				{
+					ContainerID: cnr2,
+					Values:      []cntClient.Estimation{{Size: gb}},
+				},
+				{
+					ContainerID: removed,
+					Values:      []cntClient.Estimation{{Size: 2 * gb}},
+				},
+			},
+		},
+		owners: map[string]*owner.ID{
+			cnr1.String(): ownerID,
+			cnr2.String(): ownertest.ID(),
+		},
+		nodes: map[string]int{
+			cnr1.String(): 1,
+			cnr2.String(): 1,
+		},
+	}
+
+	res, err := Report(src, Prm{
+		From:  1,
+		To:    1,
+		Rate:  10,
+		Owner: ownerID,
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+
+	// owner of the removed container is unknown
+	require.Len(t, res[0].Containers, 1)
+	require.Equal(t, cnr1, res[0].Containers[0].Container)
+	require.False(t, res[0].Containers[0].Removed)
+	require.EqualValues(t, 10, res[0].Expected.Int64())
+
+	require.Equal(t, []*cid.ID{cnr1}, src.fetched)
+
+	// owners are requested once per container
+	require.Equal(t, map[string]int{
+		cnr1.String():    1,
+		cnr2.String():    1,
+		removed.String(): 1,
+	}, src.ownerCalls)
+
+	t.Run("removed by identifier", func(t *testing.T) {
+		res, err := Report(src, Prm{
+			From:       1,
+			To:         1,
+			Rate:       10,
+			Containers: []*cid.ID{removed},
+		})
+		require.NoError(t, err)
+		// removed container is reported with unknown cost
+		require.Len(t, res[0].Containers, 1)
+		require.Equal(t, removed, res[0].Containers[0].Container)
+		require.True(t, res[0].Containers[0].Removed)
+		require.EqualValues(t, 2*gb, res[0].Containers[0].Size)
+		require.Nil(t, res[0].Containers[0].Cost)
+		require.Zero(t, res[0].Expected.Sign())
+	})
+
+	t.Run("invalid epoch range", func(t *testing.T) {
+		_, err := Report(src, Prm{From: 0, To: math.MaxUint64})
+		require.ErrorIs(t, err, errEpochRange)
+
+		_, err = Report(src, Prm{From: 2, To: 1})
+		require.ErrorIs(t, err, errEpochRange)
+	})
+}
+
+func TestForecast(t *testing.T) {
+	require.EqualValues(t, 3*4*10*5, Forecast(3*gb, 10, 4, 5).Int64())
+
+	// payment is never less than 1 per epoch
+	require.EqualValues(t, 5, Forecast(1, 10, 1, 5).Int64())
+}
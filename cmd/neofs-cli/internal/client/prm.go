@@ -80,15 +80,23 @@ type commonObjectPrm struct {
 	bearerTokenPrm
 
 	opts []client.CallOption
+
+	// raw values of the options for the requests
+	// which are sent bypassing the SDK client
+	ttl   uint32
+	xhdrs []*session.XHeader
 }
 
 // SetTTL sets request TTL value.
 func (x *commonObjectPrm) SetTTL(ttl uint32) {
+	x.ttl = ttl
 	x.opts = append(x.opts, client.WithTTL(ttl))
 }
 
 // SetXHeaders sets request X-Headers.
 func (x *commonObjectPrm) SetXHeaders(xhdrs []*session.XHeader) {
+	x.xhdrs = append(x.xhdrs, xhdrs...)
+
 	for _, xhdr := range xhdrs {
 		x.opts = append(x.opts, client.WithXHeader(xhdr))
 	}
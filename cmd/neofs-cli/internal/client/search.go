@@ -0,0 +1,165 @@
+package internal
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	rpcapi "github.com/nspcc-dev/neofs-api-go/v2/rpc"
+	rpcclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	sessionV2 "github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/session"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// default TTL of the search request.
+const defaultSearchTTL = 2
+
+// SearchObjectsStreamPrm groups parameters of SearchObjectsStream operation.
+type SearchObjectsStreamPrm struct {
+	SearchObjectsPrm
+
+	key *ecdsa.PrivateKey
+
+	handler func([]*oidSDK.ID) bool
+}
+
+// SetKey sets private key to sign the request with.
+func (x *SearchObjectsStreamPrm) SetKey(key *ecdsa.PrivateKey) {
+	x.key = key
+}
+
+// SetHandler sets function which is called for each portion
+// of the identifiers received from the server. If handler
+// returns false, the stream is closed.
+func (x *SearchObjectsStreamPrm) SetHandler(f func([]*oidSDK.ID) bool) {
+	x.handler = f
+}
+
+// SearchObjectsStream selects objects from container which match the filters
+// and passes the identifiers to the handler as soon as they are received.
+//
+// Unlike SearchObjects, does not accumulate the result, so it is
+// suitable for the containers with a large number of objects.
+// Session token is attached to the request with the search context
+// of the container and signed with the request key if it is not signed yet.
+//
+// Returns any error prevented the operation from completing correctly in error return.
+func SearchObjectsStream(prm SearchObjectsStreamPrm) error {
+	body := new(objectV2.SearchRequestBody)
+	body.SetVersion(1)
+	body.SetContainerID(prm.cnrID.ToV2())
+	body.SetFilters(prm.filters.ToV2())
+
+	xhdrs := make([]*sessionV2.XHeader, 0, len(prm.xhdrs))
+	for i := range prm.xhdrs {
+		xhdrs = append(xhdrs, prm.xhdrs[i].ToV2())
+	}
+
+	ttl := prm.ttl
+	if ttl == 0 {
+		ttl = defaultSearchTTL
+	}
+
+	meta := new(sessionV2.RequestMetaHeader)
+	meta.SetVersion(version.Current().ToV2())
+	meta.SetTTL(ttl)
+	meta.SetXHeaders(xhdrs)
+	meta.SetBearerToken(prm.bearerToken.ToV2())
+
+	if prm.sessionToken != nil {
+		tok, err := searchSessionToken(prm)
+		if err != nil {
+			return err
+		}
+
+		meta.SetSessionToken(tok.ToV2())
+	}
+
+	req := new(objectV2.SearchRequest)
+	req.SetBody(body)
+	req.SetMetaHeader(meta)
+
+	if err := signature.SignServiceMessage(prm.key, req); err != nil {
+		return fmt.Errorf("could not sign request: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := rpcapi.SearchObjects(prm.cli.Raw(), req, rpcclient.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("could not open stream: %w", err)
+	}
+
+	resp := new(objectV2.SearchResponse)
+
+	for {
+		if err := stream.Read(resp); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return fmt.Errorf("reading the response failed: %w", err)
+		}
+
+		if err := signature.VerifyServiceMessage(resp); err != nil {
+			return fmt.Errorf("could not verify %T: %w", resp, err)
+		}
+
+		if err := apistatus.ErrFromStatus(apistatus.FromStatusV2(resp.GetMetaHeader().GetStatus())); err != nil {
+			return err
+		}
+
+		chunk := resp.GetBody().GetIDList()
+		if len(chunk) == 0 {
+			continue
+		}
+
+		ids := make([]*oidSDK.ID, len(chunk))
+		for i := range chunk {
+			ids[i] = oidSDK.NewIDFromV2(chunk[i])
+		}
+
+		if !prm.handler(ids) {
+			return nil
+		}
+	}
+}
+
+// searchSessionToken returns session token of the search request.
+// Token signed by the caller is used as is.
+func searchSessionToken(prm SearchObjectsStreamPrm) (*session.Token, error) {
+	if prm.sessionToken.Signature() != nil {
+		return prm.sessionToken, nil
+	}
+
+	addr := addressSDK.NewAddress()
+	addr.SetContainerID(prm.cnrID)
+
+	opCtx := session.NewObjectContext()
+	opCtx.ForSearch()
+	opCtx.ApplyTo(addr)
+
+	tok := session.NewToken()
+	tok.SetID(prm.sessionToken.ID())
+	tok.SetOwnerID(prm.sessionToken.OwnerID())
+	tok.SetSessionKey(prm.sessionToken.SessionKey())
+	tok.SetIat(prm.sessionToken.Iat())
+	tok.SetNbf(prm.sessionToken.Nbf())
+	tok.SetExp(prm.sessionToken.Exp())
+	tok.SetContext(opCtx)
+
+	if err := tok.Sign(prm.key); err != nil {
+		return nil, fmt.Errorf("could not sign session token: %w", err)
+	}
+
+	return tok, nil
+}
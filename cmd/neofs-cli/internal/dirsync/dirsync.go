@@ -0,0 +1,158 @@
+// Package dirsync provides helpers for synchronization of the local
+// directory with the NeoFS container.
+//
+// Files are mapped to the objects by the path relative to the synchronized
+// directory which is stored in the FilePath attribute using slash
+// as a separator. Contents are compared by SHA-256 payload checksum.
+package dirsync
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// AttributeFilePath is a key of the object attribute with
+// the relative path of the file.
+const AttributeFilePath = "FilePath"
+
+// LocalFile describes a regular file in the synchronized directory.
+type LocalFile struct {
+	// Path relative to the directory in slash-separated form.
+	Path string
+
+	// FullPath is the path to the file in the local file system.
+	FullPath string
+
+	// Size of the file.
+	Size int64
+}
+
+// RemoteObject describes the object stored in the container.
+type RemoteObject struct {
+	// ID of the object in string form.
+	ID string
+
+	// Path is the value of FilePath attribute.
+	Path string
+
+	// Hash is the SHA-256 payload checksum.
+	Hash []byte
+
+	// Timestamp is the value of Timestamp attribute.
+	Timestamp int64
+}
+
+// Walk lists all regular files in the directory recursively
+// sorted by path. Symbolic links are not followed.
+func Walk(root string) ([]LocalFile, error) {
+	var res []LocalFile
+
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+
+		res = append(res, LocalFile{
+			Path:     filepath.ToSlash(rel),
+			FullPath: p,
+			Size:     info.Size(),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not walk directory: %w", err)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Path < res[j].Path
+	})
+
+	return res, nil
+}
+
+// HashFile returns SHA-256 checksum of the file contents.
+func HashFile(p string) ([]byte, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, fmt.Errorf("could not read file %s: %w", p, err)
+	}
+
+	return h.Sum(nil), nil
+}
+
+var errInvalidPath = errors.New("invalid file path")
+
+// LocalPath converts slash-separated relative path from the FilePath attribute
+// to the path inside the root directory. Returns an error if the path is
+// absolute or leads outside the root.
+func LocalPath(root, p string) (string, error) {
+	clean := path.Clean(p)
+
+	if p == "" || path.IsAbs(clean) || clean == "." || clean == ".." || strings.HasPrefix(clean, "../") {
+		return "", fmt.Errorf("%w: %q", errInvalidPath, p)
+	}
+
+	return filepath.Join(root, filepath.FromSlash(clean)), nil
+}
+
+// Latest selects the most recent object for each path. Objects are compared
+// by timestamp, ties are broken by identifier to keep the choice stable.
+func Latest(objs []RemoteObject) map[string]RemoteObject {
+	res := make(map[string]RemoteObject, len(objs))
+
+	for i := range objs {
+		cur, ok := res[objs[i].Path]
+		if !ok || objs[i].Timestamp > cur.Timestamp ||
+			objs[i].Timestamp == cur.Timestamp && objs[i].ID > cur.ID {
+			res[objs[i].Path] = objs[i]
+		}
+	}
+
+	return res
+}
+
+// Stale returns objects which have no corresponding local file or whose
+// payload differs from the contents of the local file. Local files
+// are passed as a map from path to SHA-256 checksum.
+func Stale(objs []RemoteObject, local map[string][]byte) []RemoteObject {
+	var res []RemoteObject
+
+	for i := range objs {
+		h, ok := local[objs[i].Path]
+		if !ok || string(h) != string(objs[i].Hash) {
+			res = append(res, objs[i])
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,75 @@
+package dirsync
+
+import (
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalk(t *testing.T) {
+	root := t.TempDir()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "a", "b"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a", "b", "c.txt"), []byte("c"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "z.txt"), []byte("zz"), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "empty"), 0700))
+
+	files, err := Walk(root)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	require.Equal(t, "a/b/c.txt", files[0].Path)
+	require.EqualValues(t, 1, files[0].Size)
+	require.Equal(t, "z.txt", files[1].Path)
+	require.EqualValues(t, 2, files[1].Size)
+
+	h, err := HashFile(files[1].FullPath)
+	require.NoError(t, err)
+
+	exp := sha256.Sum256([]byte("zz"))
+	require.Equal(t, exp[:], h)
+}
+
+func TestLocalPath(t *testing.T) {
+	root := filepath.Join("tmp", "root")
+
+	p, err := LocalPath(root, "a/b/../c.txt")
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(root, "a", "c.txt"), p)
+
+	for _, s := range []string{"", ".", "..", "../x", "a/../../x", "/etc/passwd"} {
+		_, err := LocalPath(root, s)
+		require.ErrorIs(t, err, errInvalidPath, s)
+	}
+}
+
+func TestLatestAndStale(t *testing.T) {
+	objs := []RemoteObject{
+		{ID: "1", Path: "a", Hash: []byte{1}, Timestamp: 10},
+		{ID: "2", Path: "a", Hash: []byte{2}, Timestamp: 20},
+		{ID: "3", Path: "b", Hash: []byte{3}, Timestamp: 5},
+		{ID: "4", Path: "b", Hash: []byte{4}, Timestamp: 5},
+		{ID: "5", Path: "c", Hash: []byte{5}},
+	}
+
+	latest := Latest(objs)
+	require.Len(t, latest, 3)
+	require.Equal(t, "2", latest["a"].ID)
+	require.Equal(t, "4", latest["b"].ID)
+	require.Equal(t, "5", latest["c"].ID)
+
+	stale := Stale(objs, map[string][]byte{
+		"a": {2},
+		"b": {3},
+	})
+
+	ids := make([]string, 0, len(stale))
+	for i := range stale {
+		ids = append(ids, stale[i].ID)
+	}
+
+	require.Equal(t, []string{"1", "4", "5"}, ids)
+}
@@ -0,0 +1,171 @@
+package multipart
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/owner"
+	"github.com/stretchr/testify/require"
+)
+
+func planTestFile(t *testing.T, payload []byte, partSize uint64) (*os.File, *State) {
+	path := filepath.Join(t.TempDir(), "payload")
+	require.NoError(t, os.WriteFile(path, payload, 0600))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	hdr := object.NewRaw()
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(owner.NewIDFromPublicKey(&key.PrivateKey.PublicKey))
+
+	st, err := Plan(f, PlanPrm{
+		Header:   hdr,
+		Key:      &key.PrivateKey,
+		PartSize: partSize,
+		Epoch:    10,
+	})
+	require.NoError(t, err)
+
+	return f, st
+}
+
+func TestPlan(t *testing.T) {
+	t.Run("small", func(t *testing.T) {
+		_, st := planTestFile(t, []byte("payload"), 16)
+		require.Len(t, st.Parts, 1)
+		require.Nil(t, st.Link)
+		require.EqualValues(t, 7, st.Parts[0].Length)
+
+		id, err := st.ObjectID()
+		require.NoError(t, err)
+
+		hdr, err := st.Parts[0].object()
+		require.NoError(t, err)
+		require.Equal(t, hdr.ID(), id)
+	})
+
+	t.Run("split", func(t *testing.T) {
+		_, st := planTestFile(t, []byte("0123456789"), 4)
+		require.Len(t, st.Parts, 3)
+		require.NotNil(t, st.Link)
+
+		var offset int64
+
+		for i, ln := range []int64{4, 4, 2} {
+			require.Equal(t, offset, st.Parts[i].Offset)
+			require.Equal(t, ln, st.Parts[i].Length)
+			offset += ln
+		}
+
+		link, err := st.Link.object()
+		require.NoError(t, err)
+
+		children := link.Children()
+		require.Len(t, children, len(st.Parts))
+
+		for i := range st.Parts {
+			hdr, err := st.Parts[i].object()
+			require.NoError(t, err)
+			require.Equal(t, hdr.ID(), children[i])
+		}
+
+		id, err := st.ObjectID()
+		require.NoError(t, err)
+		require.Equal(t, link.Parent().ID(), id)
+	})
+}
+
+func TestUploadResume(t *testing.T) {
+	payload := []byte("0123456789abcdef")
+	f, st := planTestFile(t, payload, 4)
+
+	statePath := filepath.Join(t.TempDir(), "state")
+
+	var (
+		mtx      sync.Mutex
+		received = make(map[string][]byte)
+		errPut   = errors.New("put failure")
+	)
+
+	put := func(fail bool) PutFunc {
+		return func(hdr *objectSDK.Object, r io.Reader) error {
+			data, err := io.ReadAll(r)
+			if err != nil {
+				return err
+			}
+
+			if fail && len(hdr.Children()) == 0 && hdr.PreviousID() != nil {
+				return errPut
+			}
+
+			mtx.Lock()
+			defer mtx.Unlock()
+
+			received[hdr.ID().String()] = data
+
+			return nil
+		}
+	}
+
+	prm := UploadPrm{
+		Source:  f,
+		Put:     put(true),
+		Workers: 2,
+		Progress: func(st *State) error {
+			return st.WriteFile(statePath)
+		},
+	}
+
+	require.ErrorIs(t, Upload(st, prm), errPut)
+
+	st, err := ReadState(statePath)
+	require.NoError(t, err)
+
+	done, total := st.Done()
+	require.Equal(t, 1, done)
+	require.Equal(t, 5, total)
+
+	fi, err := f.Stat()
+	require.NoError(t, err)
+	require.NoError(t, st.CheckSource(fi))
+
+	prm.Put = put(false)
+	require.NoError(t, Upload(st, prm))
+
+	done, total = st.Done()
+	require.Equal(t, total, done)
+
+	var res []byte
+
+	for i := range st.Parts {
+		hdr, err := st.Parts[i].object()
+		require.NoError(t, err)
+
+		res = append(res, received[hdr.ID().String()]...)
+	}
+
+	require.Equal(t, payload, res)
+}
+
+func TestState_CheckSource(t *testing.T) {
+	f, st := planTestFile(t, []byte("payload"), 4)
+
+	require.NoError(t, os.WriteFile(f.Name(), []byte("changed payload"), 0600))
+
+	fi, err := os.Stat(f.Name())
+	require.NoError(t, err)
+	require.ErrorIs(t, st.CheckSource(fi), errSourceChanged)
+}
@@ -0,0 +1,131 @@
+package multipart
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+)
+
+// PlanPrm groups parameters of Plan operation.
+type PlanPrm struct {
+	// Header of the resulting object. Must contain
+	// container, owner and attributes.
+	Header *object.RawObject
+
+	// Key to sign objects of the split-chain with.
+	// Must correspond to the owner.
+	Key *ecdsa.PrivateKey
+
+	// PartSize is the maximum payload size of the single object.
+	PartSize uint64
+
+	// Epoch is the creation epoch of the objects.
+	Epoch uint64
+}
+
+type epochState uint64
+
+func (x epochState) CurrentEpoch() uint64 {
+	return uint64(x)
+}
+
+// partTarget is the last target of the split-chain which
+// records object headers and payload bounds instead of writing them.
+type partTarget struct {
+	st *State
+
+	offset *int64
+
+	ln int64
+
+	hdr []byte
+}
+
+func (x *partTarget) Write(p []byte) (int, error) {
+	x.ln += int64(len(p))
+	return len(p), nil
+}
+
+func (x *partTarget) WriteHeader(obj *object.RawObject) error {
+	var err error
+
+	x.hdr, err = obj.Object().Marshal()
+	if err != nil {
+		return fmt.Errorf("could not encode object header: %w", err)
+	}
+
+	return nil
+}
+
+func (x *partTarget) Close() (*transformer.AccessIdentifiers, error) {
+	part := Part{
+		Offset: *x.offset,
+		Length: x.ln,
+		Header: x.hdr,
+	}
+
+	*x.offset += x.ln
+
+	if len(x.st.Parts) > 0 && x.ln == 0 {
+		// linking object is the only one without payload after the 1st
+		x.st.Link = &part
+	} else {
+		x.st.Parts = append(x.st.Parts, part)
+	}
+
+	return nil, nil
+}
+
+// Plan reads the file and builds split-chain of the objects carrying its
+// payload. Returned State has no uploaded objects.
+func Plan(f *os.File, prm PlanPrm) (*State, error) {
+	if prm.PartSize == 0 {
+		return nil, errors.New("zero part size")
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("could not stat file: %w", err)
+	}
+
+	st := &State{
+		Size:    fi.Size(),
+		ModTime: fi.ModTime().UnixNano(),
+	}
+
+	var offset int64
+
+	target := transformer.NewPayloadSizeLimiter(prm.PartSize, func() transformer.ObjectTarget {
+		return transformer.NewFormatTarget(&transformer.FormatterParams{
+			Key: prm.Key,
+			NextTarget: &partTarget{
+				st:     st,
+				offset: &offset,
+			},
+			NetworkState: epochState(prm.Epoch),
+		})
+	})
+
+	if err := target.WriteHeader(prm.Header); err != nil {
+		return nil, fmt.Errorf("could not write header: %w", err)
+	}
+
+	if _, err := io.Copy(target, io.NewSectionReader(f, 0, st.Size)); err != nil {
+		return nil, fmt.Errorf("could not read payload: %w", err)
+	}
+
+	if _, err := target.Close(); err != nil {
+		return nil, fmt.Errorf("could not finalize split-chain: %w", err)
+	}
+
+	if offset != st.Size {
+		return nil, errSourceChanged
+	}
+
+	return st, nil
+}
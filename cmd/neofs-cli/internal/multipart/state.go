@@ -0,0 +1,177 @@
+// Package multipart provides resumable parallel upload of large payloads
+// to NeoFS as split-chains of objects.
+//
+// Upload is done in two stages. At first, the payload is read once to build
+// the split-chain: headers of all child objects and the linking object are
+// formed and signed locally the same way the storage node does it for the
+// objects put within a session. Then the payload parts are uploaded in
+// parallel as separate pre-signed objects. Progress is recorded in the State
+// which can be saved to the file and used to resume interrupted upload.
+package multipart
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// Part is a descriptor of the single object of the split-chain.
+type Part struct {
+	// Offset of the object payload in the source.
+	Offset int64 `json:"offset"`
+
+	// Length of the object payload.
+	Length int64 `json:"length"`
+
+	// Header is a binary encoded header of the object
+	// with ID and signature.
+	Header []byte `json:"header"`
+
+	// Done is true if object has been successfully uploaded.
+	Done bool `json:"done"`
+}
+
+// State represents progress of the payload upload.
+type State struct {
+	// Size of the source file.
+	Size int64 `json:"size"`
+
+	// ModTime is the modification time of the source file
+	// in nanoseconds since Unix epoch.
+	ModTime int64 `json:"mod_time"`
+
+	// Parts are objects carrying the payload in the order of the split-chain.
+	Parts []Part `json:"parts"`
+
+	// Link is the linking object of the split-chain.
+	// Nil if payload fits into single object.
+	Link *Part `json:"link,omitempty"`
+}
+
+var errSourceChanged = errors.New("source file has been changed since upload start")
+
+// ReadState reads State from the file. Returns os.ErrNotExist
+// (wrapped) if file is missing.
+func ReadState(path string) (*State, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	st := new(State)
+
+	if err := json.Unmarshal(data, st); err != nil {
+		return nil, fmt.Errorf("could not decode upload state: %w", err)
+	}
+
+	return st, nil
+}
+
+// WriteFile atomically saves State to the file.
+func (x *State) WriteFile(path string) error {
+	data, err := json.Marshal(x)
+	if err != nil {
+		return fmt.Errorf("could not encode upload state: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("could not create temporary state file: %w", err)
+	}
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(tmp.Name(), path)
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return fmt.Errorf("could not write state file: %w", err)
+	}
+
+	return nil
+}
+
+// CheckSource returns an error if the source file described by fi
+// differs from the one the State was built for.
+func (x *State) CheckSource(fi os.FileInfo) error {
+	if fi.Size() != x.Size || fi.ModTime().UnixNano() != x.ModTime {
+		return errSourceChanged
+	}
+
+	return nil
+}
+
+// Done returns number of uploaded objects and total number of objects
+// including linking one.
+func (x *State) Done() (done, total int) {
+	total = len(x.Parts)
+
+	for i := range x.Parts {
+		if x.Parts[i].Done {
+			done++
+		}
+	}
+
+	if x.Link != nil {
+		total++
+
+		if x.Link.Done {
+			done++
+		}
+	}
+
+	return
+}
+
+// ObjectID returns identifier of the resulting object: parent object
+// of the split-chain or the only object if payload was not split.
+func (x *State) ObjectID() (*oidSDK.ID, error) {
+	if x.Link == nil {
+		if len(x.Parts) != 1 {
+			return nil, fmt.Errorf("unexpected number of parts without linking object: %d", len(x.Parts))
+		}
+
+		hdr, err := x.Parts[0].object()
+		if err != nil {
+			return nil, err
+		}
+
+		return hdr.ID(), nil
+	}
+
+	hdr, err := x.Link.object()
+	if err != nil {
+		return nil, err
+	}
+
+	par := hdr.Parent()
+	if par == nil {
+		return nil, errors.New("missing parent header in linking object")
+	}
+
+	return par.ID(), nil
+}
+
+func (x *Part) object() (*object.Object, error) {
+	obj := object.New()
+
+	if err := obj.Unmarshal(x.Header); err != nil {
+		return nil, fmt.Errorf("could not decode object header: %w", err)
+	}
+
+	return obj, nil
+}
@@ -0,0 +1,142 @@
+package multipart
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+)
+
+// PutFunc is a function which saves pre-signed object with
+// the payload read from the reader.
+type PutFunc func(hdr *objectSDK.Object, payload io.Reader) error
+
+// UploadPrm groups parameters of Upload operation.
+type UploadPrm struct {
+	// Source of the payload.
+	Source io.ReaderAt
+
+	// Put saves the objects. Must be safe for concurrent use.
+	Put PutFunc
+
+	// Workers is a number of parts uploaded in parallel.
+	Workers int
+
+	// Progress is called after each successfully uploaded object.
+	// The State is locked during the call, so it can be saved.
+	// Error returned by Progress aborts the upload.
+	Progress func(*State) error
+}
+
+// Upload uploads all not yet uploaded objects of the split-chain described
+// by the State. Child objects are uploaded in parallel, linking object is
+// uploaded last.
+//
+// On failure, the State reflects all successfully uploaded objects, so the
+// upload can be continued later.
+func Upload(st *State, prm UploadPrm) error {
+	if prm.Workers <= 0 {
+		prm.Workers = 1
+	}
+
+	var (
+		mtx      sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+
+		ch = make(chan int)
+	)
+
+	fail := func(err error) {
+		mtx.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		mtx.Unlock()
+	}
+
+	failed := func() bool {
+		mtx.Lock()
+		defer mtx.Unlock()
+
+		return firstErr != nil
+	}
+
+	for i := 0; i < prm.Workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for ind := range ch {
+				if err := uploadPart(&st.Parts[ind], prm.Source, prm.Put); err != nil {
+					fail(fmt.Errorf("part #%d: %w", ind, err))
+					continue
+				}
+
+				mtx.Lock()
+				st.Parts[ind].Done = true
+
+				var err error
+				if prm.Progress != nil {
+					err = prm.Progress(st)
+				}
+				mtx.Unlock()
+
+				if err != nil {
+					fail(err)
+				}
+			}
+		}()
+	}
+
+	for i := range st.Parts {
+		if failed() {
+			break
+		}
+
+		if !st.Parts[i].Done {
+			ch <- i
+		}
+	}
+
+	close(ch)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	if st.Link == nil || st.Link.Done {
+		return nil
+	}
+
+	if err := uploadPart(st.Link, prm.Source, prm.Put); err != nil {
+		return fmt.Errorf("linking object: %w", err)
+	}
+
+	st.Link.Done = true
+
+	if prm.Progress != nil {
+		return prm.Progress(st)
+	}
+
+	return nil
+}
+
+var errNoHeader = errors.New("missing object header")
+
+func uploadPart(p *Part, src io.ReaderAt, put PutFunc) error {
+	if len(p.Header) == 0 {
+		return errNoHeader
+	}
+
+	hdr, err := p.object()
+	if err != nil {
+		return err
+	}
+
+	return put(hdr.SDK(), io.NewSectionReader(src, p.Offset, p.Length))
+}
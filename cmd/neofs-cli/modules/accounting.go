@@ -73,6 +73,7 @@ func initAccountingBalanceCmd() {
 func init() {
 	rootCmd.AddCommand(accountingCmd)
 	accountingCmd.AddCommand(accountingBalanceCmd)
+	accountingCmd.AddCommand(accountingReportCmd)
 
 	// Here you will define your flags and configuration settings.
 
@@ -85,6 +86,7 @@ func init() {
 	// accountingCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 
 	initAccountingBalanceCmd()
+	initAccountingReportCmd()
 }
 
 func prettyPrintDecimal(cmd *cobra.Command, decimal *accounting.Decimal) {
@@ -0,0 +1,522 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	neoaddress "github.com/nspcc-dev/neo-go/pkg/encoding/address"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
+	"github.com/nspcc-dev/neo-go/pkg/rpc/response/result"
+	"github.com/nspcc-dev/neo-go/pkg/rpc/response/result/subscriptions"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/billing"
+	containerCore "github.com/nspcc-dev/neofs-node/pkg/core/container"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/common"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
+	balanceClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/balance"
+	cntClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/container"
+	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
+	balanceEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/balance"
+	"github.com/nspcc-dev/neofs-node/pkg/util/precision"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/owner"
+	"github.com/spf13/cobra"
+)
+
+const (
+	reportMorphEndpointFlag = "morph-endpoint"
+	reportOwnerFlag         = "owner"
+	reportContainersFlag    = "cid"
+	reportEpochsFlag        = "epochs"
+	reportForecastFlag      = "forecast"
+	reportNodesFlag         = "nodes"
+
+	// number of NEP-17 transfers requested at once
+	reportTransfersPageSize = 100
+)
+
+var accountingReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Show settlement costs of the containers",
+	Long: `Show settlement costs of the containers.
+
+Reads container size estimations from the side chain and calculates the expected
+basic income payments per epoch in the same way as Inner Ring does. Actual
+settlement transfers of the owner account (basic income collection, audit fees
+and basic income distribution) are shown for the same epochs.
+
+Containers are selected with --cid, all containers of the --owner with size
+estimations are reported otherwise. Removed containers are reported without
+the cost since their placement can't be resolved anymore. Owner of removed
+containers is unknown too, so they are reported with --cid only. Current
+basic income rate is applied to all epochs. Range must contain no more than
+10000 epochs.
+
+With --forecast, the cost of the storage of the container of the given size per
+node is calculated for the number of epochs in --epochs range. Number of nodes is
+taken from --nodes or from the current placement of the --cid container.`,
+	Run: accountingReport,
+}
+
+func initAccountingReportCmd() {
+	ff := accountingReportCmd.Flags()
+
+	ff.StringP(walletPath, walletPathShorthand, walletPathDefault, walletPathUsage)
+	ff.StringP(address, addressShorthand, addressDefault, addressUsage)
+
+	ff.String(reportMorphEndpointFlag, "", "side chain RPC endpoint")
+	ff.String(reportOwnerFlag, "", "owner of the containers (omit to use owner from private key)")
+	ff.StringSlice(reportContainersFlag, nil, "report only the specified containers")
+	ff.String(reportEpochsFlag, "", "epoch range in a..b form, single epoch number is allowed")
+	ff.Uint64(reportForecastFlag, 0, "calculate cost of the storage of the container with size in bytes per node")
+	ff.Int(reportNodesFlag, 0, "number of container nodes for --forecast")
+
+	_ = accountingReportCmd.MarkFlagRequired(reportMorphEndpointFlag)
+}
+
+// morphSettlementSource reads the settlement data from the side chain.
+type morphSettlementSource struct {
+	cnr *cntClient.Client
+
+	nm *nmClient.Client
+
+	netmaps map[uint64]*netmap.Netmap
+
+	containers map[string]*container.Container
+}
+
+func (s *morphSettlementSource) Estimations(epoch uint64, filter func(*cid.ID) bool) ([]*cntClient.Estimations, error) {
+	ids, err := s.cnr.ListLoadEstimationsByEpoch(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*cntClient.Estimations, 0, len(ids))
+
+	for i := range ids {
+		if id := estimationContainer(ids[i]); id != nil && !filter(id) {
+			continue
+		}
+
+		e, err := s.cnr.GetUsedSpaceEstimations(ids[i])
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, e)
+	}
+
+	return res, nil
+}
+
+// estimationContainer returns the container of the estimation. Container
+// contract composes estimation identifiers of a prefix and the container
+// identifier. Returns nil if the identifier has another format.
+func estimationContainer(id cntClient.EstimationID) *cid.ID {
+	if len(id) < sha256.Size {
+		return nil
+	}
+
+	var cs [sha256.Size]byte
+
+	copy(cs[:], id[len(id)-sha256.Size:])
+
+	res := cid.New()
+	res.SetSHA256(cs)
+
+	return res
+}
+
+// container returns the container by identifier. Removed containers
+// are cached as nil values.
+func (s *morphSettlementSource) container(id *cid.ID) (*container.Container, error) {
+	cnr, ok := s.containers[id.String()]
+	if !ok {
+		var err error
+
+		cnr, err = cntClient.Get(s.cnr, id)
+		if err != nil && !errors.Is(err, containerCore.ErrNotFound) {
+			return nil, fmt.Errorf("could not get container: %w", err)
+		}
+
+		s.containers[id.String()] = cnr
+	}
+
+	if cnr == nil {
+		return nil, containerCore.ErrNotFound
+	}
+
+	return cnr, nil
+}
+
+func (s *morphSettlementSource) ContainerOwner(id *cid.ID) (*owner.ID, error) {
+	cnr, err := s.container(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return cnr.OwnerID(), nil
+}
+
+func (s *morphSettlementSource) ContainerNodes(epoch uint64, id *cid.ID) (int, error) {
+	nm, ok := s.netmaps[epoch]
+	if !ok {
+		var err error
+
+		nm, err = s.nm.GetNetMapByEpoch(epoch)
+		if err != nil {
+			return 0, fmt.Errorf("could not get network map: %w", err)
+		}
+
+		s.netmaps[epoch] = nm
+	}
+
+	cnr, err := s.container(id)
+	if err != nil {
+		return 0, err
+	}
+
+	cn, err := nm.GetContainerNodes(cnr.PlacementPolicy(), id.ToV2().GetValue())
+	if err != nil {
+		return 0, fmt.Errorf("could not calculate container nodes: %w", err)
+	}
+
+	return len(cn.Flatten()), nil
+}
+
+func accountingReport(cmd *cobra.Command, _ []string) {
+	ff := cmd.Flags()
+
+	epochs, _ := ff.GetString(reportEpochsFlag)
+	forecast, _ := ff.GetUint64(reportForecastFlag)
+
+	// the key is used for reading only
+	key, err := keys.NewPrivateKey()
+	exitOnErr(cmd, errf("can't generate key to sign requests: %w", err))
+
+	endpoint, _ := ff.GetString(reportMorphEndpointFlag)
+
+	cli, err := client.New(key, endpoint)
+	exitOnErr(cmd, errf("can't create side chain client: %w", err))
+
+	src, bal, balHash := initSettlementClients(cmd, cli)
+
+	rate, err := src.nm.BasicIncomeRate()
+	exitOnErr(cmd, errf("can't read basic income rate: %w", err))
+
+	decimals, err := bal.Decimals()
+	exitOnErr(cmd, errf("can't read balance precision: %w", err))
+
+	cnrs := parseReportContainers(cmd)
+
+	if forecast > 0 {
+		from, to := uint64(1), uint64(1)
+		if epochs != "" {
+			from, to, err = billing.ParseEpochs(epochs)
+			exitOnErr(cmd, err)
+		}
+
+		nodes, _ := ff.GetInt(reportNodesFlag)
+		if nodes <= 0 {
+			if len(cnrs) == 0 {
+				exitOnErr(cmd, errors.New("--nodes or --cid is required for --forecast"))
+			}
+
+			epoch, err := src.nm.Epoch()
+			exitOnErr(cmd, errf("can't read current epoch: %w", err))
+
+			nodes, err = src.ContainerNodes(epoch, cnrs[0])
+			exitOnErr(cmd, errf("can't get container nodes: %w", err))
+		}
+
+		cmd.Printf("Basic income rate: %s per GB\n", formatReportAmount(new(big.Int).SetUint64(rate), decimals))
+		cmd.Printf("Size: %d bytes per node, nodes: %d, epochs: %d\n", forecast, nodes, to-from+1)
+		cmd.Printf("Cost: %s\n", formatReportAmount(billing.Forecast(forecast, rate, nodes, to-from+1), decimals))
+
+		return
+	}
+
+	if epochs == "" {
+		exitOnErr(cmd, errors.New("--epochs is required"))
+	}
+
+	from, to, err := billing.ParseEpochs(epochs)
+	exitOnErr(cmd, err)
+
+	ownerID := reportOwner(cmd)
+
+	transfers, err := settlementTransfers(cli, src.nm, balHash, ownerID, from, to)
+	exitOnErr(cmd, errf("can't read settlement transfers: %w", err))
+
+	res, err := billing.Report(src, billing.Prm{
+		From:       from,
+		To:         to,
+		Rate:       rate,
+		Containers: cnrs,
+		Owner:      ownerID,
+		Transfers:  transfers,
+	})
+	exitOnErr(cmd, errf("can't build report: %w", err))
+
+	cmd.Printf("Owner: %s\n", ownerID)
+	cmd.Printf("Basic income rate: %s per GB (current value)\n", formatReportAmount(new(big.Int).SetUint64(rate), decimals))
+
+	for _, r := range res {
+		cmd.Printf("Epoch %d:\n", r.Epoch)
+
+		for _, c := range r.Containers {
+			if c.Removed {
+				cmd.Printf("\tcontainer %s: %d bytes per node, removed, cost unknown\n", c.Container, c.Size)
+				continue
+			}
+
+			cmd.Printf("\tcontainer %s: %d bytes per node on %d nodes, cost %s\n",
+				c.Container, c.Size, c.Nodes, formatReportAmount(c.Cost, decimals))
+		}
+
+		cmd.Printf("\texpected basic income payment: %s\n", formatReportAmount(r.Expected, decimals))
+
+		for _, typ := range []common.SettlementType{
+			common.BasicIncomeCollection,
+			common.AuditSettlement,
+			common.BasicIncomeDistribution,
+		} {
+			if sum, ok := r.Transfers[typ]; ok {
+				cmd.Printf("\t%s: %s\n", typ, formatReportAmount(sum, decimals))
+			}
+		}
+	}
+}
+
+func initSettlementClients(cmd *cobra.Command, cli *client.Client) (*morphSettlementSource, *balanceClient.Client, util.Uint160) {
+	cnrHash, err := cli.NNSContractAddress(client.NNSContainerContractName)
+	exitOnErr(cmd, errf("can't resolve container contract address: %w", err))
+
+	nmHash, err := cli.NNSContractAddress(client.NNSNetmapContractName)
+	exitOnErr(cmd, errf("can't resolve netmap contract address: %w", err))
+
+	balHash, err := cli.NNSContractAddress(client.NNSBalanceContractName)
+	exitOnErr(cmd, errf("can't resolve balance contract address: %w", err))
+
+	cnr, err := cntClient.NewFromMorph(cli, cnrHash, 0)
+	exitOnErr(cmd, errf("can't create container contract client: %w", err))
+
+	nm, err := nmClient.NewFromMorph(cli, nmHash, 0)
+	exitOnErr(cmd, errf("can't create netmap contract client: %w", err))
+
+	bal, err := balanceClient.NewFromMorph(cli, balHash, 0)
+	exitOnErr(cmd, errf("can't create balance contract client: %w", err))
+
+	return &morphSettlementSource{
+		cnr:        cnr,
+		nm:         nm,
+		netmaps:    make(map[uint64]*netmap.Netmap),
+		containers: make(map[string]*container.Container),
+	}, bal, balHash
+}
+
+func parseReportContainers(cmd *cobra.Command) []*cid.ID {
+	strs, _ := cmd.Flags().GetStringSlice(reportContainersFlag)
+
+	res := make([]*cid.ID, 0, len(strs))
+
+	for i := range strs {
+		id, err := parseContainerID(strs[i])
+		exitOnErr(cmd, err)
+
+		res = append(res, id)
+	}
+
+	return res
+}
+
+func reportOwner(cmd *cobra.Command) *owner.ID {
+	s, _ := cmd.Flags().GetString(reportOwnerFlag)
+	if s != "" {
+		id, err := ownerFromString(s)
+		exitOnErr(cmd, err)
+
+		return id
+	}
+
+	key, err := getKey()
+	exitOnErr(cmd, err)
+
+	return owner.NewIDFromPublicKey(&key.PublicKey)
+}
+
+// settlementTransfers returns settlement transfers of the owner account
+// in the epoch range. Incoming transfers have positive amount.
+func settlementTransfers(cli *client.Client, nm *nmClient.Client, balance util.Uint160, ownerID *owner.ID, from, to uint64) ([]billing.Transfer, error) {
+	acc, err := neoaddress.StringToUint160(ownerID.String())
+	if err != nil {
+		return nil, fmt.Errorf("invalid owner address: %w", err)
+	}
+
+	start, stop, err := settlementTimeRange(cli, nm, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		res  []billing.Transfer
+		seen = make(map[util.Uint256]struct{})
+	)
+
+	for page := 0; ; page++ {
+		ts, err := cli.NEP17Transfers(acc, start, stop, reportTransfersPageSize, page)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range append(ts.Sent, ts.Received...) {
+			if _, ok := seen[t.TxHash]; ok || !t.Asset.Equals(balance) {
+				continue
+			}
+
+			seen[t.TxHash] = struct{}{}
+
+			txTransfers, err := txSettlementTransfers(cli, balance, acc, t.TxHash)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range txTransfers {
+				if txTransfers[i].Epoch >= from && txTransfers[i].Epoch <= to {
+					res = append(res, txTransfers[i])
+				}
+			}
+		}
+
+		if len(ts.Sent)+len(ts.Received) < reportTransfersPageSize {
+			return res, nil
+		}
+	}
+}
+
+// settlementTimeRange returns the time range (Unix timestamps in milliseconds)
+// of the settlement transfers made for the epochs. Transfers of the epoch are
+// made in the next epoch. Epoch start blocks are estimated from the last epoch
+// block and the epoch duration, so the range is extended by one epoch on both
+// sides.
+func settlementTimeRange(cli *client.Client, nm *nmClient.Client, from, to uint64) (start, stop uint64, err error) {
+	epoch, err := nm.Epoch()
+	if err != nil {
+		return 0, 0, fmt.Errorf("can't read current epoch: %w", err)
+	}
+
+	lastBlock, err := nm.LastEpochBlock()
+	if err != nil {
+		return 0, 0, fmt.Errorf("can't read last epoch block: %w", err)
+	}
+
+	duration, err := nm.EpochDuration()
+	if err != nil {
+		return 0, 0, fmt.Errorf("can't read epoch duration: %w", err)
+	}
+
+	blockTime := func(e uint64) (uint64, error) {
+		h := lastBlock
+
+		if diff := epoch - e; duration != 0 && diff > uint64(lastBlock)/duration {
+			h = 0
+		} else {
+			h -= uint32(diff * duration)
+		}
+
+		b, err := cli.Block(h)
+		if err != nil {
+			return 0, fmt.Errorf("can't get block %d: %w", h, err)
+		}
+
+		return b.Timestamp, nil
+	}
+
+	if from > epoch {
+		from = epoch
+	}
+
+	start, err = blockTime(from)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if to+3 > epoch || to+3 < to {
+		return start, uint64(time.Now().UnixNano() / int64(time.Millisecond)), nil
+	}
+
+	stop, err = blockTime(to + 3)
+
+	return start, stop, err
+}
+
+// applicationLogSource is a part of the side chain client which
+// provides application logs of the transactions.
+type applicationLogSource interface {
+	ApplicationLog(util.Uint256) (*result.ApplicationLog, error)
+}
+
+// txSettlementTransfers parses TransferX notifications of the balance contract
+// in the transaction which involve the account.
+func txSettlementTransfers(cli applicationLogSource, balance, acc util.Uint160, h util.Uint256) ([]billing.Transfer, error) {
+	log, err := cli.ApplicationLog(h)
+	if err != nil {
+		return nil, fmt.Errorf("can't get application log of %s: %w", h.StringLE(), err)
+	}
+
+	if len(log.Executions) == 0 || !log.Executions[0].VMState.HasFlag(vm.HaltState) {
+		return nil, nil
+	}
+
+	var res []billing.Transfer
+
+	for _, n := range log.Executions[0].Events {
+		if !n.ScriptHash.Equals(balance) || n.Name != "TransferX" {
+			continue
+		}
+
+		ev, err := balanceEvent.ParseTransferX(&subscriptions.NotificationEvent{
+			Container:         h,
+			NotificationEvent: n,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("can't parse transfer in %s: %w", h.StringLE(), err)
+		}
+
+		transfer := ev.(balanceEvent.TransferX)
+
+		typ, epoch, err := common.ParseDetails(transfer.Details())
+		if err != nil {
+			// not a settlement transfer
+			continue
+		}
+
+		amount := big.NewInt(transfer.Amount())
+
+		switch {
+		case transfer.From().Equals(acc):
+			amount.Neg(amount)
+		case !transfer.To().Equals(acc):
+			continue
+		}
+
+		res = append(res, billing.Transfer{
+			Type:   typ,
+			Epoch:  epoch,
+			Amount: amount,
+		})
+	}
+
+	return res, nil
+}
+
+func formatReportAmount(amount *big.Int, decimals uint32) string {
+	return fixedn.ToString(precision.Convert(decimals, 8, amount), 8)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/state"
+	"github.com/nspcc-dev/neo-go/pkg/rpc/response/result"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/billing"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/common"
+	"github.com/stretchr/testify/require"
+)
+
+type testApplicationLogs map[util.Uint256]*result.ApplicationLog
+
+func (x testApplicationLogs) ApplicationLog(h util.Uint256) (*result.ApplicationLog, error) {
+	log, ok := x[h]
+	if !ok {
+		return nil, errors.New("application log not found")
+	}
+
+	return log, nil
+}
+
+func transferXEvent(balance, from, to util.Uint160, amount int64, details []byte) state.NotificationEvent {
+	return state.NotificationEvent{
+		ScriptHash: balance,
+		Name:       "TransferX",
+		Item: stackitem.NewArray([]stackitem.Item{
+			stackitem.NewByteArray(from.BytesBE()),
+			stackitem.NewByteArray(to.BytesBE()),
+			stackitem.NewBigInteger(big.NewInt(amount)),
+			stackitem.NewByteArray(details),
+		}),
+	}
+}
+
+func TestTxSettlementTransfers(t *testing.T) {
+	var (
+		balance = util.Uint160{1}
+		acc     = util.Uint160{2}
+		bank    = util.Uint160{3}
+		other   = util.Uint160{4}
+
+		halt  = util.Uint256{1}
+		fault = util.Uint256{2}
+	)
+
+	logs := testApplicationLogs{
+		halt: {
+			Container: halt,
+			Executions: []state.Execution{{
+				VMState: vm.HaltState,
+				Events: []state.NotificationEvent{
+					transferXEvent(balance, acc, bank, 10, common.BasicIncomeCollectionDetails(5)),
+					transferXEvent(balance, bank, acc, 3, common.BasicIncomeDistributionDetails(5)),
+					transferXEvent(balance, acc, bank, 1, common.AuditSettlementDetails(6)),
+					// not a settlement transfer
+					transferXEvent(balance, acc, bank, 100, []byte("withdraw")),
+					// foreign account
+					transferXEvent(balance, other, bank, 20, common.BasicIncomeCollectionDetails(5)),
+					// foreign contract
+					transferXEvent(other, acc, bank, 30, common.BasicIncomeCollectionDetails(5)),
+				},
+			}},
+		},
+		fault: {
+			Container: fault,
+			Executions: []state.Execution{{
+				VMState: vm.FaultState,
+				Events: []state.NotificationEvent{
+					transferXEvent(balance, acc, bank, 10, common.BasicIncomeCollectionDetails(5)),
+				},
+			}},
+		},
+	}
+
+	res, err := txSettlementTransfers(logs, balance, acc, halt)
+	require.NoError(t, err)
+	require.Equal(t, []billing.Transfer{
+		{Type: common.BasicIncomeCollection, Epoch: 5, Amount: big.NewInt(-10)},
+		{Type: common.BasicIncomeDistribution, Epoch: 5, Amount: big.NewInt(3)},
+		{Type: common.AuditSettlement, Epoch: 6, Amount: big.NewInt(-1)},
+	}, res)
+
+	res, err = txSettlementTransfers(logs, balance, acc, fault)
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	_, err = txSettlementTransfers(logs, balance, acc, util.Uint256{3})
+	require.Error(t, err)
+}
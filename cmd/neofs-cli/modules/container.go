@@ -11,8 +11,12 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	containerCore "github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/version"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
+	nmClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/acl"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -75,6 +79,9 @@ var (
 	containerNoTimestamp bool
 	containerSubnet      string
 
+	containerDryRun        bool
+	containerMorphEndpoint string
+
 	containerID string
 
 	containerPathFrom string
@@ -149,6 +156,11 @@ It will be stored in sidechain when inner ring will accepts it.`,
 
 		placementPolicy.SetSubnetID(subnetID)
 
+		if containerDryRun {
+			checkContainerPlacement(cmd, placementPolicy)
+			return
+		}
+
 		attributes, err := parseAttributes(containerAttributes)
 		exitOnErr(cmd, err)
 
@@ -278,21 +290,7 @@ var listContainerObjectsCmd = &cobra.Command{
 		filters := new(object.SearchFilters)
 		filters.AddRootFilter() // search only user created objects
 
-		var prm internalclient.SearchObjectsPrm
-
-		prepareSessionPrm(cmd, &prm)
-		prepareObjectPrm(cmd, &prm)
-		prm.SetContainerID(id)
-		prm.SetFilters(*filters)
-
-		res, err := internalclient.SearchObjects(prm)
-		exitOnErr(cmd, errf("rpc error: %w", err))
-
-		objectIDs := res.IDList()
-
-		for i := range objectIDs {
-			cmd.Println(objectIDs[i])
-		}
+		streamSearchResults(cmd, id, *filters, false)
 	},
 }
 
@@ -482,6 +480,8 @@ func initContainerCreateCmd() {
 	flags.StringVar(&containerName, "name", "", "container name attribute")
 	flags.BoolVar(&containerNoTimestamp, "disable-timestamp", false, "disable timestamp container attribute")
 	flags.StringVar(&containerSubnet, "subnet", "", "string representation of container subnetwork")
+	flags.BoolVar(&containerDryRun, "dry-run", false, "check placement policy against the network map without creating the container")
+	flags.StringVar(&containerMorphEndpoint, "morph-endpoint", "", "side chain RPC endpoint to read the network map from for --dry-run")
 }
 
 func initContainerDeleteCmd() {
@@ -499,6 +499,8 @@ func initContainerListObjectsCmd() {
 	flags := listContainerObjectsCmd.Flags()
 
 	flags.StringVar(&containerID, "cid", "", "container ID")
+
+	initSearchOutputFlags(listContainerObjectsCmd)
 }
 
 func initContainerInfoCmd() {
@@ -540,6 +542,7 @@ func init() {
 		getContainerInfoCmd,
 		getExtendedACLCmd,
 		setExtendedACLCmd,
+		syncContainerCmd,
 	}
 
 	rootCmd.AddCommand(containerCmd)
@@ -563,6 +566,7 @@ func init() {
 	initContainerInfoCmd()
 	initContainerGetEACLCmd()
 	initContainerSetEACLCmd()
+	initContainerSyncCmd()
 
 	for _, containerCommand := range containerChildCommand {
 		flags := containerCommand.Flags()
@@ -827,6 +831,39 @@ func prettyPrintEACL(cmd *cobra.Command, table *eacl.Table) {
 	printJSONMarshaler(cmd, table, "eACL")
 }
 
+// checkContainerPlacement checks that the placement policy can be
+// satisfied by the current network map read from the side chain.
+func checkContainerPlacement(cmd *cobra.Command, p *netmap.PlacementPolicy) {
+	if containerMorphEndpoint == "" {
+		exitOnErr(cmd, errors.New("side chain RPC endpoint must be specified with --morph-endpoint for dry run"))
+	}
+
+	// the key is used for reading only
+	key, err := keys.NewPrivateKey()
+	exitOnErr(cmd, errf("can't generate key to sign requests: %w", err))
+
+	cli, err := client.New(key, containerMorphEndpoint)
+	exitOnErr(cmd, errf("can't create side chain client: %w", err))
+
+	nmHash, err := cli.NNSContractAddress(client.NNSNetmapContractName)
+	exitOnErr(cmd, errf("can't resolve netmap contract address: %w", err))
+
+	nmWrapper, err := nmClient.NewFromMorph(cli, nmHash, 0)
+	exitOnErr(cmd, errf("can't create netmap contract client: %w", err))
+
+	epoch, err := nmWrapper.Epoch()
+	exitOnErr(cmd, errf("can't read current epoch: %w", err))
+
+	nm, err := nmWrapper.Snapshot()
+	exitOnErr(cmd, errf("can't read network map: %w", err))
+
+	err = containerCore.CheckPlacement(container.New(container.WithPolicy(p)), nm)
+	exitOnErr(cmd, err)
+
+	cmd.Printf("placement policy can be satisfied by the network map of epoch %d (%d nodes)\n",
+		epoch, len(nm.Nodes))
+}
+
 func printJSONMarshaler(cmd *cobra.Command, j json.Marshaler, entity string) {
 	data, err := j.MarshalJSON()
 	if err != nil {
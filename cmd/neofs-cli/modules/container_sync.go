@@ -0,0 +1,473 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/dirsync"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/owner"
+	"github.com/spf13/cobra"
+)
+
+const (
+	syncDownloadFlag = "download"
+	syncDeleteFlag   = "delete"
+	syncWorkersFlag  = "workers"
+	syncDryRunFlag   = "dry-run"
+)
+
+var syncContainerCmd = &cobra.Command{
+	Use:   "sync <dir>",
+	Short: "Synchronize local directory with container",
+	Long: `Synchronize local directory with container.
+
+By default, uploads all files from the directory to the container. Relative file
+paths are stored in the FilePath attribute, files with the same path and payload
+hash in the container are skipped. With --download, objects having FilePath
+attribute are downloaded to the directory instead, the most recent object is
+taken for each path.
+
+With --delete, objects (or files for --download) missing in the source are removed.`,
+	Args: cobra.ExactArgs(1),
+	Run:  syncContainer,
+}
+
+func initContainerSyncCmd() {
+	initCommonFlags(syncContainerCmd)
+
+	flags := syncContainerCmd.Flags()
+
+	flags.StringVar(&containerID, "cid", "", "container ID")
+	flags.Bool(syncDownloadFlag, false, "download objects from the container to the directory")
+	flags.Bool(syncDeleteFlag, false, "remove objects or files which are missing in the source")
+	flags.Uint(syncWorkersFlag, 4, "number of files processed in parallel")
+	flags.Bool(syncDryRunFlag, false, "print actions without performing them")
+	flags.String(bearerTokenFlag, "", "File with signed JSON or binary encoded bearer token")
+}
+
+// syncer groups parameters of the container synchronization
+// shared between the workers.
+type syncer struct {
+	cmd *cobra.Command
+
+	cnr *cid.ID
+
+	owner *owner.ID
+
+	root string
+
+	dryRun bool
+
+	workers int
+
+	searchPrm internalclient.SearchObjectsPrm
+	headPrm   internalclient.HeadObjectPrm
+	putPrm    internalclient.PutObjectPrm
+	getPrm    internalclient.GetObjectPrm
+	delPrm    internalclient.DeleteObjectPrm
+
+	mtx sync.Mutex
+}
+
+func syncContainer(cmd *cobra.Command, args []string) {
+	id, err := parseContainerID(containerID)
+	exitOnErr(cmd, err)
+
+	key, err := getKey()
+	exitOnErr(cmd, errf("can't fetch private key: %w", err))
+
+	ownerID, err := getOwnerID(key)
+	exitOnErr(cmd, err)
+
+	workers, _ := cmd.Flags().GetUint(syncWorkersFlag)
+	if workers == 0 {
+		workers = 1
+	}
+
+	s := &syncer{
+		cmd:     cmd,
+		cnr:     id,
+		owner:   ownerID,
+		root:    args[0],
+		workers: int(workers),
+	}
+
+	s.dryRun, _ = cmd.Flags().GetBool(syncDryRunFlag)
+
+	prepareSessionPrmWithOwner(cmd, key, ownerID, &s.searchPrm, &s.headPrm, &s.putPrm, &s.getPrm, &s.delPrm)
+	prepareObjectPrm(cmd, &s.searchPrm, &s.headPrm, &s.putPrm, &s.getPrm, &s.delPrm)
+
+	s.searchPrm.SetContainerID(id)
+
+	withDelete, _ := cmd.Flags().GetBool(syncDeleteFlag)
+
+	if download, _ := cmd.Flags().GetBool(syncDownloadFlag); download {
+		s.download(withDelete)
+	} else {
+		s.upload(withDelete)
+	}
+}
+
+func (s *syncer) printf(format string, args ...interface{}) {
+	s.mtx.Lock()
+	s.cmd.Printf(format, args...)
+	s.mtx.Unlock()
+}
+
+// parallel calls f for all indices in [0:n) using s.workers goroutines
+// and returns the number of failed calls.
+func (s *syncer) parallel(n int, f func(int) error) int {
+	var (
+		wg     sync.WaitGroup
+		failed int
+
+		ch = make(chan int)
+	)
+
+	for i := 0; i < s.workers; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for ind := range ch {
+				if err := f(ind); err != nil {
+					s.mtx.Lock()
+					failed++
+					s.cmd.PrintErrln(err)
+					s.mtx.Unlock()
+				}
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		ch <- i
+	}
+
+	close(ch)
+	wg.Wait()
+
+	return failed
+}
+
+func (s *syncer) searchFiles(filters object.SearchFilters) ([]*oidSDK.ID, error) {
+	filters.AddRootFilter()
+
+	prm := s.searchPrm
+	prm.SetFilters(filters)
+
+	res, err := internalclient.SearchObjects(prm)
+	if err != nil {
+		return nil, fmt.Errorf("rpc error: %w", err)
+	}
+
+	return res.IDList(), nil
+}
+
+func (s *syncer) address(id *oidSDK.ID) *addressSDK.Address {
+	addr := addressSDK.NewAddress()
+	addr.SetContainerID(s.cnr)
+	addr.SetObjectID(id)
+
+	return addr
+}
+
+// remoteObjects lists all user objects with FilePath attribute.
+func (s *syncer) remoteObjects() []dirsync.RemoteObject {
+	var filters object.SearchFilters
+	filters.AddFilter(dirsync.AttributeFilePath, "", object.MatchCommonPrefix)
+
+	ids, err := s.searchFiles(filters)
+	exitOnErr(s.cmd, err)
+
+	res := make([]dirsync.RemoteObject, len(ids))
+
+	failed := s.parallel(len(ids), func(i int) error {
+		prm := s.headPrm
+		prm.SetAddress(s.address(ids[i]))
+
+		hdr, err := internalclient.HeadObject(prm)
+		if err != nil {
+			return fmt.Errorf("can't get header of %s: %w", ids[i], err)
+		}
+
+		obj := dirsync.RemoteObject{
+			ID: ids[i].String(),
+		}
+
+		if cs := hdr.Header().PayloadChecksum(); cs != nil {
+			obj.Hash = cs.Sum()
+		}
+
+		for _, a := range hdr.Header().Attributes() {
+			switch a.Key() {
+			case dirsync.AttributeFilePath:
+				obj.Path = a.Value()
+			case object.AttributeTimestamp:
+				obj.Timestamp, _ = strconv.ParseInt(a.Value(), 10, 64)
+			}
+		}
+
+		res[i] = obj
+
+		return nil
+	})
+	if failed > 0 {
+		exitOnErr(s.cmd, fmt.Errorf("can't read %d object headers", failed))
+	}
+
+	return res
+}
+
+func (s *syncer) upload(withDelete bool) {
+	files, err := dirsync.Walk(s.root)
+	exitOnErr(s.cmd, err)
+
+	hashes := make([][]byte, len(files))
+
+	var uploaded, skipped int
+
+	failed := s.parallel(len(files), func(i int) error {
+		h, err := dirsync.HashFile(files[i].FullPath)
+		if err != nil {
+			return err
+		}
+
+		hashes[i] = h
+
+		var filters object.SearchFilters
+		filters.AddFilter(dirsync.AttributeFilePath, files[i].Path, object.MatchStringEqual)
+		filters.AddFilter(objectV2.FilterHeaderPayloadHash, hex.EncodeToString(h), object.MatchStringEqual)
+
+		ids, err := s.searchFiles(filters)
+		if err != nil {
+			return fmt.Errorf("can't search for %s: %w", files[i].Path, err)
+		}
+
+		if len(ids) > 0 {
+			s.mtx.Lock()
+			skipped++
+			s.mtx.Unlock()
+
+			return nil
+		}
+
+		if s.dryRun {
+			s.printf("upload %s\n", files[i].Path)
+			return nil
+		}
+
+		id, err := s.putFile(files[i])
+		if err != nil {
+			return fmt.Errorf("can't upload %s: %w", files[i].Path, err)
+		}
+
+		s.mtx.Lock()
+		uploaded++
+		s.mtx.Unlock()
+
+		s.printf("uploaded %s: %s\n", files[i].Path, id)
+
+		return nil
+	})
+
+	s.cmd.Printf("Uploaded: %d, unchanged: %d, failed: %d\n", uploaded, skipped, failed)
+
+	if failed > 0 {
+		exitOnErr(s.cmd, fmt.Errorf("can't upload %d files", failed))
+	}
+
+	if !withDelete {
+		return
+	}
+
+	local := make(map[string][]byte, len(files))
+	for i := range files {
+		local[files[i].Path] = hashes[i]
+	}
+
+	stale := dirsync.Stale(s.remoteObjects(), local)
+
+	failed = s.parallel(len(stale), func(i int) error {
+		if s.dryRun {
+			s.printf("delete %s (%s)\n", stale[i].ID, stale[i].Path)
+			return nil
+		}
+
+		id := oidSDK.NewID()
+		if err := id.Parse(stale[i].ID); err != nil {
+			return err
+		}
+
+		prm := s.delPrm
+		prm.SetAddress(s.address(id))
+
+		if _, err := internalclient.DeleteObject(prm); err != nil {
+			return fmt.Errorf("can't delete %s (%s): %w", stale[i].ID, stale[i].Path, err)
+		}
+
+		s.printf("deleted %s (%s)\n", stale[i].ID, stale[i].Path)
+
+		return nil
+	})
+
+	if failed > 0 {
+		exitOnErr(s.cmd, fmt.Errorf("can't delete %d objects", failed))
+	}
+}
+
+func (s *syncer) putFile(lf dirsync.LocalFile) (*oidSDK.ID, error) {
+	f, err := os.Open(lf.FullPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	attrs := make([]*object.Attribute, 3)
+	for i, kv := range [][2]string{
+		{dirsync.AttributeFilePath, lf.Path},
+		{object.AttributeFileName, filepath.Base(lf.FullPath)},
+		{object.AttributeTimestamp, strconv.FormatInt(time.Now().Unix(), 10)},
+	} {
+		attrs[i] = object.NewAttribute()
+		attrs[i].SetKey(kv[0])
+		attrs[i].SetValue(kv[1])
+	}
+
+	obj := object.NewRaw()
+	obj.SetContainerID(s.cnr)
+	obj.SetOwnerID(s.owner)
+	obj.SetAttributes(attrs...)
+
+	prm := s.putPrm
+	prm.SetHeader(obj.Object())
+	prm.SetPayloadReader(f)
+
+	res, err := internalclient.PutObject(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.ID(), nil
+}
+
+func (s *syncer) download(withDelete bool) {
+	latest := dirsync.Latest(s.remoteObjects())
+
+	objs := make([]dirsync.RemoteObject, 0, len(latest))
+	for _, obj := range latest {
+		objs = append(objs, obj)
+	}
+
+	var downloaded, skipped int
+
+	failed := s.parallel(len(objs), func(i int) error {
+		p, err := dirsync.LocalPath(s.root, objs[i].Path)
+		if err != nil {
+			return fmt.Errorf("object %s: %w", objs[i].ID, err)
+		}
+
+		if h, err := dirsync.HashFile(p); err == nil && string(h) == string(objs[i].Hash) {
+			s.mtx.Lock()
+			skipped++
+			s.mtx.Unlock()
+
+			return nil
+		}
+
+		if s.dryRun {
+			s.printf("download %s to %s\n", objs[i].ID, objs[i].Path)
+			return nil
+		}
+
+		if err := s.getFile(objs[i].ID, p); err != nil {
+			return fmt.Errorf("can't download %s to %s: %w", objs[i].ID, objs[i].Path, err)
+		}
+
+		s.mtx.Lock()
+		downloaded++
+		s.mtx.Unlock()
+
+		s.printf("downloaded %s: %s\n", objs[i].Path, objs[i].ID)
+
+		return nil
+	})
+
+	s.cmd.Printf("Downloaded: %d, unchanged: %d, failed: %d\n", downloaded, skipped, failed)
+
+	if failed > 0 {
+		exitOnErr(s.cmd, fmt.Errorf("can't download %d objects", failed))
+	}
+
+	if !withDelete {
+		return
+	}
+
+	files, err := dirsync.Walk(s.root)
+	exitOnErr(s.cmd, err)
+
+	for i := range files {
+		if _, ok := latest[files[i].Path]; ok {
+			continue
+		}
+
+		if s.dryRun {
+			s.cmd.Printf("delete %s\n", files[i].Path)
+			continue
+		}
+
+		exitOnErr(s.cmd, errf("can't remove local file: %w", os.Remove(files[i].FullPath)))
+
+		s.cmd.Printf("deleted %s\n", files[i].Path)
+	}
+}
+
+// getFile writes object payload to the temporary file
+// and replaces the file at path with it on success.
+func (s *syncer) getFile(strID, p string) error {
+	id := oidSDK.NewID()
+	if err := id.Parse(strID); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(p), "."+filepath.Base(p)+".*")
+	if err != nil {
+		return err
+	}
+
+	prm := s.getPrm
+	prm.SetAddress(s.address(id))
+	prm.SetPayloadWriter(f)
+
+	_, err = internalclient.GetObject(prm)
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err == nil {
+		err = os.Rename(f.Name(), p)
+	}
+
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+
+	return err
+}
@@ -192,6 +192,7 @@ func init() {
 		dropObjectsCmd,
 		snapshotCmd,
 		shardsCmd,
+		diagnoseCmd,
 	)
 
 	initControlHealthCheckCmd()
@@ -202,6 +203,7 @@ func init() {
 	initControlSetShardModeCmd()
 	initControlDumpShardCmd()
 	initControlRestoreShardCmd()
+	initControlDiagnoseCmd()
 }
 
 func healthCheck(cmd *cobra.Command, _ []string) {
@@ -0,0 +1,373 @@
+package cmd
+
+import (
+	"encoding/hex"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	rpcclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	ircontrol "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+	ircontrolsrv "github.com/nspcc-dev/neofs-node/pkg/services/control/ir/server"
+	"github.com/nspcc-dev/neofs-sdk-go/util/signature"
+	"github.com/spf13/cobra"
+)
+
+var irCmd = &cobra.Command{
+	Use:   "ir",
+	Short: "Operations with inner ring node",
+	Long:  "Operations with inner ring node",
+}
+
+var irEventsCmd = &cobra.Command{
+	Use:   "events",
+	Short: "List NeoFS contract events indexed by inner ring node",
+	Long: `List NeoFS contract events indexed by inner ring node.
+Filters are combined, the latest events are printed if the limit is set.
+Event index must be enabled in the inner ring node configuration.`,
+	Run: listIREvents,
+}
+
+var irProcessorsCmd = &cobra.Command{
+	Use:   "processors",
+	Short: "List event processors of inner ring node",
+	Long:  "List event processors of inner ring node with the number of the events being processed.",
+	Run:   listIRProcessors,
+}
+
+var irProcessorsPauseCmd = &cobra.Command{
+	Use:   "pause <name>...",
+	Short: "Pause event processors of inner ring node",
+	Long: `Pause event processors of inner ring node.
+Paused processor drops incoming events until it is resumed, dropped events
+are not processed after resume. Only audit and settlement processors can be paused.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		setIRProcessorState(cmd, args, true)
+	},
+}
+
+var irProcessorsResumeCmd = &cobra.Command{
+	Use:   "resume <name>...",
+	Short: "Resume event processors of inner ring node",
+	Long:  "Resume event processors of inner ring node",
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		setIRProcessorState(cmd, args, false)
+	},
+}
+
+var irStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Get status of inner ring node",
+	Long:  "Get current epoch, inner ring and alphabet positions and notary settings of inner ring node",
+	Run:   irNodeStatus,
+}
+
+var irTickEpochCmd = &cobra.Command{
+	Use:   "tick-epoch",
+	Short: "Request new epoch by inner ring node",
+	Long: `Request new epoch by inner ring node.
+Inner ring node must be an alphabet one. Command does not wait for the new epoch.
+With notary enabled in side chain, epoch is changed only when the alphabet
+quorum requests the same epoch.`,
+	Run: irTickEpoch,
+}
+
+var irNotaryRequestsCmd = &cobra.Command{
+	Use:   "notary-requests",
+	Short: "List pending notary requests of inner ring node",
+	Long:  "List notary requests received by inner ring node which main transactions have not been accepted yet",
+	Run:   listIRNotaryRequests,
+}
+
+const (
+	irEventsContainerFlag = "cid"
+	irEventsOwnerFlag     = "owner"
+	irEventsNodeFlag      = "node"
+	irEventsEpochFlag     = "epoch"
+	irEventsLimitFlag     = "limit"
+)
+
+func initControlIREventsCmd() {
+	initCommonFlagsWithoutRPC(irEventsCmd)
+
+	flags := irEventsCmd.Flags()
+
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+	flags.String(irEventsContainerFlag, "", "Container ID")
+	flags.String(irEventsOwnerFlag, "", "Owner ID (NEO3 wallet address)")
+	flags.String(irEventsNodeFlag, "", "Storage node public key in hex")
+	flags.Uint64(irEventsEpochFlag, 0, "NeoFS epoch (0 matches any)")
+	flags.Uint32(irEventsLimitFlag, 100, "Maximum number of the latest events (0 means no limit for filtered events)")
+}
+
+func initControlIRCmd(cmd *cobra.Command) {
+	initCommonFlagsWithoutRPC(cmd)
+
+	cmd.Flags().String(controlRPC, controlRPCDefault, controlRPCUsage)
+}
+
+func init() {
+	irProcessorsCmd.AddCommand(
+		irProcessorsPauseCmd,
+		irProcessorsResumeCmd,
+	)
+
+	irCmd.AddCommand(
+		irEventsCmd,
+		irProcessorsCmd,
+		irStatusCmd,
+		irTickEpochCmd,
+		irNotaryRequestsCmd,
+	)
+
+	controlCmd.AddCommand(irCmd)
+
+	initControlIREventsCmd()
+
+	for _, cmd := range []*cobra.Command{
+		irProcessorsCmd,
+		irProcessorsPauseCmd,
+		irProcessorsResumeCmd,
+		irStatusCmd,
+		irTickEpochCmd,
+		irNotaryRequestsCmd,
+	} {
+		initControlIRCmd(cmd)
+	}
+}
+
+func listIREvents(cmd *cobra.Command, _ []string) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	body := new(ircontrol.ListEventsRequest_Body)
+
+	if s, _ := cmd.Flags().GetString(irEventsContainerFlag); s != "" {
+		id, err := parseContainerID(s)
+		exitOnErr(cmd, err)
+
+		body.SetContainerID(id.ToV2().GetValue())
+	}
+
+	if s, _ := cmd.Flags().GetString(irEventsOwnerFlag); s != "" {
+		id, err := ownerFromString(s)
+		exitOnErr(cmd, err)
+
+		body.SetOwnerID(id.ToV2().GetValue())
+	}
+
+	if s, _ := cmd.Flags().GetString(irEventsNodeFlag); s != "" {
+		pub, err := keys.NewPublicKeyFromString(s)
+		exitOnErr(cmd, errf("invalid node public key: %w", err))
+
+		body.SetNodeKey(pub.Bytes())
+	}
+
+	epoch, _ := cmd.Flags().GetUint64(irEventsEpochFlag)
+	body.SetEpoch(epoch)
+
+	limit, _ := cmd.Flags().GetUint32(irEventsLimitFlag)
+	body.SetLimit(limit)
+
+	req := new(ircontrol.ListEventsRequest)
+	req.SetBody(body)
+
+	err = ircontrolsrv.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	resp, err := ircontrol.ListEvents(cli.Raw(), req)
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+
+	for _, e := range resp.GetBody().GetEvents() {
+		prettyPrintContractEvent(cmd, e)
+	}
+}
+
+func prettyPrintContractEvent(cmd *cobra.Command, e *ircontrol.ContractEvent) {
+	var tx, contract string
+
+	if h, err := util.Uint256DecodeBytesBE(e.GetTxHash()); err == nil {
+		tx = h.StringLE()
+	}
+
+	if h, err := util.Uint160DecodeBytesBE(e.GetContract()); err == nil {
+		contract = h.StringLE()
+	}
+
+	cmd.Printf("%s chain, block %d, epoch %d: %s\n", e.GetChain(), e.GetHeight(), e.GetEpoch(), e.GetType())
+	cmd.Printf("\ttx: %s\n", tx)
+	cmd.Printf("\tcontract: %s\n", contract)
+
+	if v := e.GetContainerId(); len(v) != 0 {
+		cmd.Printf("\tcontainer: %s\n", base58.Encode(v))
+	}
+
+	if v := e.GetOwnerId(); len(v) != 0 {
+		cmd.Printf("\towner: %s\n", base58.Encode(v))
+	}
+
+	if v := e.GetNodeKey(); len(v) != 0 {
+		cmd.Printf("\tnode: %s\n", hex.EncodeToString(v))
+	}
+
+	if v := e.GetDetails(); v != "" {
+		cmd.Printf("\tdetails: %s\n", v)
+	}
+}
+
+// sendIRRequest signs the request, executes the RPC and
+// verifies the signature of the response.
+func sendIRRequest(cmd *cobra.Command, req ircontrolsrv.SignedMessage,
+	rpc func(*rpcclient.Client) (ircontrolsrv.SignedMessage, error)) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	err = ircontrolsrv.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	resp, err := rpc(cli.Raw())
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+}
+
+func listIRProcessors(cmd *cobra.Command, _ []string) {
+	req := new(ircontrol.ListProcessorsRequest)
+	req.SetBody(new(ircontrol.ListProcessorsRequest_Body))
+
+	var resp *ircontrol.ListProcessorsResponse
+
+	sendIRRequest(cmd, req, func(cli *rpcclient.Client) (res ircontrolsrv.SignedMessage, err error) {
+		resp, err = ircontrol.ListProcessors(cli, req)
+		return resp, err
+	})
+
+	for _, p := range resp.GetBody().GetProcessors() {
+		var state string
+		if p.GetPaused() {
+			state = ", paused"
+		}
+
+		cmd.Printf("%s: %d/%d%s\n", p.GetName(), p.GetQueueLength(), p.GetCapacity(), state)
+	}
+}
+
+func setIRProcessorState(cmd *cobra.Command, names []string, paused bool) {
+	body := new(ircontrol.SetProcessorStateRequest_Body)
+	body.SetNames(names)
+	body.SetPaused(paused)
+
+	req := new(ircontrol.SetProcessorStateRequest)
+	req.SetBody(body)
+
+	sendIRRequest(cmd, req, func(cli *rpcclient.Client) (ircontrolsrv.SignedMessage, error) {
+		return ircontrol.SetProcessorState(cli, req)
+	})
+
+	cmd.Println("Processor state has been successfully changed.")
+}
+
+func irNodeStatus(cmd *cobra.Command, _ []string) {
+	req := new(ircontrol.NodeStatusRequest)
+	req.SetBody(new(ircontrol.NodeStatusRequest_Body))
+
+	var resp *ircontrol.NodeStatusResponse
+
+	sendIRRequest(cmd, req, func(cli *rpcclient.Client) (res ircontrolsrv.SignedMessage, err error) {
+		resp, err = ircontrol.NodeStatus(cli, req)
+		return resp, err
+	})
+
+	body := resp.GetBody()
+
+	cmd.Printf("Epoch: %d\n", body.GetEpoch())
+
+	if ind := body.GetInnerRingIndex(); ind >= 0 {
+		cmd.Printf("Inner ring: %d of %d\n", ind, body.GetInnerRingSize())
+	} else {
+		cmd.Println("Inner ring: not a member")
+	}
+
+	if ind := body.GetAlphabetIndex(); ind >= 0 {
+		cmd.Printf("Alphabet: %d\n", ind)
+	} else {
+		cmd.Println("Alphabet: not a member")
+	}
+
+	cmd.Printf("Side chain notary: %s\n", formatEnabled(body.GetSideNotary()))
+	cmd.Printf("Main chain notary: %s\n", formatEnabled(body.GetMainNotary()))
+}
+
+func formatEnabled(v bool) string {
+	if v {
+		return "enabled"
+	}
+
+	return "disabled"
+}
+
+func irTickEpoch(cmd *cobra.Command, _ []string) {
+	req := new(ircontrol.TickEpochRequest)
+	req.SetBody(new(ircontrol.TickEpochRequest_Body))
+
+	var resp *ircontrol.TickEpochResponse
+
+	sendIRRequest(cmd, req, func(cli *rpcclient.Client) (res ircontrolsrv.SignedMessage, err error) {
+		resp, err = ircontrol.TickEpoch(cli, req)
+		return resp, err
+	})
+
+	cmd.Printf("Tick of epoch %d has been requested.\n", resp.GetBody().GetEpoch())
+}
+
+func listIRNotaryRequests(cmd *cobra.Command, _ []string) {
+	req := new(ircontrol.ListNotaryRequestsRequest)
+	req.SetBody(new(ircontrol.ListNotaryRequestsRequest_Body))
+
+	var resp *ircontrol.ListNotaryRequestsResponse
+
+	sendIRRequest(cmd, req, func(cli *rpcclient.Client) (res ircontrolsrv.SignedMessage, err error) {
+		resp, err = ircontrol.ListNotaryRequests(cli, req)
+		return resp, err
+	})
+
+	for _, r := range resp.GetBody().GetRequests() {
+		var tx, contract string
+
+		if h, err := util.Uint256DecodeBytesBE(r.GetMainTxHash()); err == nil {
+			tx = h.StringLE()
+		}
+
+		if h, err := util.Uint160DecodeBytesBE(r.GetContract()); err == nil {
+			contract = h.StringLE()
+		}
+
+		cmd.Printf("%s: %s.%s, valid until block %d\n", tx, contract, r.GetMethod(), r.GetValidUntilBlock())
+	}
+}
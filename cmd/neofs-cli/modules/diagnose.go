@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
+	"github.com/nspcc-dev/neofs-sdk-go/util/signature"
+	"github.com/spf13/cobra"
+)
+
+var errDiagnosticsFailed = errors.New("some of the diagnostic checks failed")
+
+var diagnoseCmd = &cobra.Command{
+	Use:   "diagnose",
+	Short: "Perform self-test of the storage node",
+	Long: `Perform self-test of the storage node.
+
+Probes each shard with a test object, checks side chain RPC endpoints,
+node's presence in the network map, reachability of the announced addresses
+and time synchronization. Exits with non-zero code if any check failed.`,
+	Run: diagnose,
+}
+
+func diagnose(cmd *cobra.Command, _ []string) {
+	key, err := getKeyNoGenerate()
+	exitOnErr(cmd, err)
+
+	req := new(control.DiagnosticsRequest)
+	req.SetBody(new(control.DiagnosticsRequest_Body))
+
+	err = controlSvc.SignMessage(key, req)
+	exitOnErr(cmd, errf("could not sign request: %w", err))
+
+	cli, err := getControlSDKClient(key)
+	exitOnErr(cmd, err)
+
+	resp, err := control.Diagnostics(cli.Raw(), req)
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	sign := resp.GetSignature()
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	exitOnErr(cmd, errf("invalid response signature: %w", err))
+
+	var failed bool
+
+	for _, c := range resp.GetBody().GetChecks() {
+		var st string
+
+		switch c.GetStatus() {
+		case control.DiagnosticStatus_PASSED:
+			st = "PASSED"
+		case control.DiagnosticStatus_WARNING:
+			st = "WARNING"
+		case control.DiagnosticStatus_FAILED:
+			st = "FAILED"
+			failed = true
+		default:
+			st = "UNDEFINED"
+		}
+
+		cmd.Printf("[%s] %s %s", st, c.GetCategory(), c.GetSubject())
+
+		if details := c.GetDetails(); details != "" {
+			cmd.Printf(": %s", details)
+		}
+
+		cmd.Printf(" (%dms)\n", c.GetDuration())
+	}
+
+	if failed {
+		exitOnErr(cmd, errDiagnosticsFailed)
+	}
+}
+
+func initControlDiagnoseCmd() {
+	initCommonFlagsWithoutRPC(diagnoseCmd)
+
+	flags := diagnoseCmd.Flags()
+
+	flags.String(controlRPC, controlRPCDefault, controlRPCUsage)
+}
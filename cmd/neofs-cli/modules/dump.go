@@ -1,7 +1,13 @@
 package cmd
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
 	"github.com/mr-tron/base58"
+	rpcclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
 	"github.com/nspcc-dev/neofs-sdk-go/util/signature"
@@ -11,13 +17,23 @@ import (
 const (
 	dumpFilepathFlag     = "path"
 	dumpIgnoreErrorsFlag = "no-errors"
+	dumpContainersFlag   = "cid"
+	dumpFromEpochFlag    = "from-epoch"
+	dumpToEpochFlag      = "to-epoch"
+	dumpIncrementalFlag  = "incremental"
+	dumpSinceFlag        = "since"
+	dumpCompressFlag     = "compress"
+	dumpStreamFlag       = "stream"
 )
 
 var dumpShardCmd = &cobra.Command{
 	Use:   "dump",
 	Short: "Dump objects from shard",
-	Long:  "Dump objects from shard to a file",
-	Run:   dumpShard,
+	Long: `Dump objects from shard to a file.
+
+By default the file is written on the node's file system. With --stream flag
+the dump is transferred over the control connection and written to the local file.`,
+	Run: dumpShard,
 }
 
 func dumpShard(cmd *cobra.Command, _ []string) {
@@ -31,11 +47,41 @@ func dumpShard(cmd *cobra.Command, _ []string) {
 	body.SetShardID(rawID)
 
 	p, _ := cmd.Flags().GetString(dumpFilepathFlag)
-	body.SetFilepath(p)
+
+	stream, _ := cmd.Flags().GetBool(dumpStreamFlag)
+	if !stream {
+		body.SetFilepath(p)
+	}
 
 	ignore, _ := cmd.Flags().GetBool(dumpIgnoreErrorsFlag)
 	body.SetIgnoreErrors(ignore)
 
+	cnrs, _ := cmd.Flags().GetStringSlice(dumpContainersFlag)
+	if len(cnrs) != 0 {
+		rawCnrs := make([][]byte, 0, len(cnrs))
+
+		for i := range cnrs {
+			id, err := parseContainerID(cnrs[i])
+			exitOnErr(cmd, err)
+
+			rawCnrs = append(rawCnrs, id.ToV2().GetValue())
+		}
+
+		body.SetContainerId(rawCnrs)
+	}
+
+	from, _ := cmd.Flags().GetUint64(dumpFromEpochFlag)
+	to, _ := cmd.Flags().GetUint64(dumpToEpochFlag)
+	body.SetEpochRange(from, to)
+
+	if incremental, _ := cmd.Flags().GetBool(dumpIncrementalFlag); incremental {
+		since, _ := cmd.Flags().GetUint64(dumpSinceFlag)
+		body.SetIncremental(since)
+	}
+
+	compress, _ := cmd.Flags().GetBool(dumpCompressFlag)
+	body.SetCompress(compress)
+
 	req := new(control.DumpShardRequest)
 	req.SetBody(body)
 
@@ -45,6 +91,20 @@ func dumpShard(cmd *cobra.Command, _ []string) {
 	cli, err := getControlSDKClient(key)
 	exitOnErr(cmd, err)
 
+	if stream {
+		last, err := dumpShardToFile(cli.Raw(), req, p)
+		exitOnErr(cmd, err)
+
+		cmd.Println("Shard has been dumped successfully.")
+		cmd.Printf("Objects: %d\n", last.GetCount())
+
+		if body.GetIncremental() {
+			cmd.Printf("Watermark: %d\n", last.GetWatermark())
+		}
+
+		return
+	}
+
 	resp, err := control.DumpShard(cli.Raw(), req)
 	exitOnErr(cmd, errf("rpc error: %w", err))
 
@@ -59,6 +119,11 @@ func dumpShard(cmd *cobra.Command, _ []string) {
 	exitOnErr(cmd, errf("invalid response signature: %w", err))
 
 	cmd.Println("Shard has been dumped successfully.")
+	cmd.Printf("Objects: %d\n", resp.GetBody().GetCount())
+
+	if body.GetIncremental() {
+		cmd.Printf("Watermark: %d\n", resp.GetBody().GetWatermark())
+	}
 }
 
 func initControlDumpShardCmd() {
@@ -69,8 +134,74 @@ func initControlDumpShardCmd() {
 	flags.StringVarP(&shardID, shardIDFlag, "", "", "Shard ID in base58 encoding")
 	flags.String(dumpFilepathFlag, "", "File to write objects to")
 	flags.Bool(dumpIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.StringSlice(dumpContainersFlag, nil, "Dump only objects from the specified containers")
+	flags.Uint64(dumpFromEpochFlag, 0, "Dump only objects created since the specified epoch")
+	flags.Uint64(dumpToEpochFlag, 0, "Dump only objects created until the specified epoch (0 means no limit)")
+	flags.Bool(dumpIncrementalFlag, false, "Make incremental dump with the watermark")
+	flags.Uint64(dumpSinceFlag, 0, "Watermark of the previous dump to make increment since")
+	flags.Bool(dumpCompressFlag, false, "Compress the dump with zstd")
+	flags.Bool(dumpStreamFlag, false, "Stream the dump from the node and write it to the local file")
 
 	_ = dumpShardCmd.MarkFlagRequired(shardIDFlag)
 	_ = dumpShardCmd.MarkFlagRequired(dumpFilepathFlag)
 	_ = dumpShardCmd.MarkFlagRequired(controlRPC)
 }
+
+// dumpShardToFile writes the dump streamed by the node to the local file.
+// Returns the body of the last message which contains dump statistics.
+// The file is removed if the dump is incomplete.
+func dumpShardToFile(cli *rpcclient.Client, req *control.DumpShardRequest, path string) (res *control.DumpShardStreamResponse_Body, err error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640)
+	if err != nil {
+		return nil, fmt.Errorf("could not create dump file: %w", err)
+	}
+
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
+
+	r, err := control.DumpShardStream(cli, req)
+	if err != nil {
+		return nil, fmt.Errorf("rpc error: %w", err)
+	}
+
+	for {
+		resp, err := r.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("rpc error: %w", err)
+		}
+
+		sign := resp.GetSignature()
+
+		err = signature.VerifyDataWithSource(
+			resp,
+			func() ([]byte, []byte) {
+				return sign.GetKey(), sign.GetSign()
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid response signature: %w", err)
+		}
+
+		res = resp.GetBody()
+
+		if _, err := f.Write(res.GetChunk()); err != nil {
+			return nil, fmt.Errorf("could not write dump file: %w", err)
+		}
+	}
+
+	if res == nil || len(res.GetChunk()) != 0 {
+		return nil, errors.New("dump stream was interrupted")
+	}
+
+	return res, nil
+}
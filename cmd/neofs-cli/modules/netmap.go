@@ -146,6 +146,10 @@ func (x *netCfgWriter) WithdrawFee(v uint64) {
 	x.print("Withdraw fee", v, false)
 }
 
+func (x *netCfgWriter) MaintenanceDuration(v uint64) {
+	x.print("Maintenance duration", v, false)
+}
+
 var netInfoCmd = &cobra.Command{
 	Use:   "netinfo",
 	Short: "Get information about NeoFS network",
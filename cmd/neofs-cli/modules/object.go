@@ -3,6 +3,7 @@ package cmd
 import (
 	"crypto/ecdsa"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -10,12 +11,17 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/nspcc-dev/neo-go/pkg/encoding/bigint"
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	internalclient "github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/client"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-cli/internal/multipart"
+	objectCore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-sdk-go/checksum"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -123,6 +129,13 @@ const (
 
 const putExpiresOnFlag = "expires-on"
 
+const (
+	putMultipartFlag = "multipart"
+	putPartSizeFlag  = "part-size"
+	putWorkersFlag   = "workers"
+	putStateFlag     = "state"
+)
+
 var putExpiredOn uint64
 
 func initObjectPutCmd() {
@@ -141,6 +154,11 @@ func initObjectPutCmd() {
 	flags.Bool("disable-filename", false, "Do not set well-known filename attribute")
 	flags.Bool("disable-timestamp", false, "Do not set well-known timestamp attribute")
 	flags.Uint64VarP(&putExpiredOn, putExpiresOnFlag, "e", 0, "Last epoch in the life of the object")
+
+	flags.Bool(putMultipartFlag, false, "Upload payload in parallel parts which can be resumed after interruption")
+	flags.Uint64(putPartSizeFlag, 0, "Payload size of the single part in bytes (multipart only). Default: network max object size")
+	flags.Uint(putWorkersFlag, 4, "Number of parts uploaded in parallel (multipart only)")
+	flags.String(putStateFlag, "", "File to save upload progress to (multipart only). Default: <file>.upload")
 }
 
 func initObjectDeleteCmd() {
@@ -185,6 +203,8 @@ func initObjectSearchCmd() {
 	flags.Bool("root", false, "Search for user objects")
 	flags.Bool("phy", false, "Search physically stored objects")
 	flags.String(searchOIDFlag, "", "Search object by identifier")
+
+	initSearchOutputFlags(objectSearchCmd)
 }
 
 func initObjectHeadCmd() {
@@ -321,7 +341,10 @@ func prepareSessionPrmWithOwner(
 	ni, err := internalclient.NetworkInfo(netInfoPrm)
 	exitOnErr(cmd, errf("read network info: %w", err))
 
-	sessionPrm.SetExp(ni.NetworkInfo().CurrentEpoch() + sessionTokenLifetime)
+	curEpoch := ni.NetworkInfo().CurrentEpoch()
+	exp := curEpoch + sessionTokenLifetime
+
+	sessionPrm.SetExp(exp)
 
 	sessionRes, err := internalclient.CreateSession(sessionPrm)
 	exitOnErr(cmd, errf("open session: %w", err))
@@ -330,6 +353,9 @@ func prepareSessionPrmWithOwner(
 	tok.SetID(sessionRes.ID())
 	tok.SetSessionKey(sessionRes.SessionKey())
 	tok.SetOwnerID(ownerID)
+	tok.SetIat(curEpoch)
+	tok.SetNbf(curEpoch)
+	tok.SetExp(exp)
 
 	for i := range prms {
 		prms[i].SetSessionToken(tok)
@@ -404,6 +430,11 @@ func putObject(cmd *cobra.Command, _ []string) {
 	obj.SetOwnerID(ownerID)
 	obj.SetAttributes(attrs...)
 
+	if multipart, _ := cmd.Flags().GetBool(putMultipartFlag); multipart {
+		putObjectMultipart(cmd, key, obj, f)
+		return
+	}
+
 	var prm internalclient.PutObjectPrm
 
 	prepareSessionPrmWithOwner(cmd, key, ownerID, &prm)
@@ -418,6 +449,103 @@ func putObject(cmd *cobra.Command, _ []string) {
 	cmd.Printf("  ID: %s\n  CID: %s\n", res.ID(), cid)
 }
 
+// name of the network parameter with maximum object payload size.
+const maxObjectSizeParam = "MaxObjectSize"
+
+func putObjectMultipart(cmd *cobra.Command, key *ecdsa.PrivateKey, obj *object.RawObject, f *os.File) {
+	statePath, _ := cmd.Flags().GetString(putStateFlag)
+	if statePath == "" {
+		statePath = f.Name() + ".upload"
+	}
+
+	fi, err := f.Stat()
+	exitOnErr(cmd, errf("can't stat file: %w", err))
+
+	st, err := multipart.ReadState(statePath)
+	switch {
+	case err == nil:
+		exitOnErr(cmd, errf("can't resume upload: %w", st.CheckSource(fi)))
+
+		done, total := st.Done()
+		cmd.Printf("Resuming upload from %s: %d of %d objects are already stored\n", statePath, done, total)
+	case errors.Is(err, os.ErrNotExist):
+		var prm internalclient.NetworkInfoPrm
+
+		prepareAPIClientWithKey(cmd, key, &prm)
+
+		res, err := internalclient.NetworkInfo(prm)
+		exitOnErr(cmd, errf("read network info: %w", err))
+
+		netInfo := res.NetworkInfo()
+
+		partSize, _ := cmd.Flags().GetUint64(putPartSizeFlag)
+		if partSize == 0 {
+			netInfo.NetworkConfig().IterateParameters(func(p *netmap.NetworkParameter) bool {
+				if string(p.Key()) == maxObjectSizeParam {
+					partSize = bigint.FromBytes(p.Value()).Uint64()
+					return true
+				}
+
+				return false
+			})
+
+			if partSize == 0 {
+				exitOnErr(cmd, errors.New("can't determine part size: missing max object size in network config"))
+			}
+		}
+
+		st, err = multipart.Plan(f, multipart.PlanPrm{
+			Header:   objectCore.NewRawFrom(obj),
+			Key:      key,
+			PartSize: partSize,
+			Epoch:    netInfo.CurrentEpoch(),
+		})
+		exitOnErr(cmd, errf("can't prepare objects: %w", err))
+
+		exitOnErr(cmd, errf("can't save upload state: %w", st.WriteFile(statePath)))
+	default:
+		exitOnErr(cmd, errf("can't read upload state: %w", err))
+	}
+
+	var prm internalclient.PutObjectPrm
+
+	prepareAPIClientWithKey(cmd, key, &prm)
+	prepareObjectPrm(cmd, &prm)
+
+	workers, _ := cmd.Flags().GetUint(putWorkersFlag)
+
+	err = multipart.Upload(st, multipart.UploadPrm{
+		Source: f,
+		Put: func(hdr *object.Object, payload io.Reader) error {
+			partPrm := prm
+			partPrm.SetHeader(hdr)
+			partPrm.SetPayloadReader(payload)
+
+			_, err := internalclient.PutObject(partPrm)
+			return err
+		},
+		Workers: int(workers),
+		Progress: func(st *multipart.State) error {
+			return st.WriteFile(statePath)
+		},
+	})
+	if err != nil {
+		done, total := st.Done()
+		cmd.Printf("Upload interrupted: %d of %d objects are stored, run the same command to resume\n", done, total)
+	}
+	exitOnErr(cmd, errf("rpc error: %w", err))
+
+	id, err := st.ObjectID()
+	exitOnErr(cmd, err)
+
+	if err := os.Remove(statePath); err != nil {
+		cmd.PrintErrf("can't remove upload state file %s: %v\n", statePath, err)
+	}
+
+	cmd.Printf("[%s] Object successfully stored\n", f.Name())
+	cmd.Printf("  ID: %s\n  CID: %s\n", id, obj.ContainerID())
+}
+
 func deleteObject(cmd *cobra.Command, _ []string) {
 	objAddr, err := getObjectAddress(cmd)
 	exitOnErr(cmd, err)
@@ -517,22 +645,255 @@ func searchObject(cmd *cobra.Command, _ []string) {
 	sf, err := parseSearchFilters(cmd)
 	exitOnErr(cmd, err)
 
-	var prm internalclient.SearchObjectsPrm
+	streamSearchResults(cmd, cid, sf, true)
+}
 
-	prepareSessionPrm(cmd, &prm)
+const (
+	searchLimitFlag   = "limit"
+	searchCursorFlag  = "cursor"
+	searchJSONFlag    = "json"
+	searchHeadersFlag = "headers"
+	searchCountFlag   = "count"
+	searchWorkersFlag = "workers"
+)
+
+// initSearchOutputFlags registers flags of search results output.
+func initSearchOutputFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+
+	flags.Uint64(searchLimitFlag, 0, "Maximum number of objects to print (0 means no limit)")
+	flags.String(searchCursorFlag, "", "Print objects following the one with this ID (value printed after limited output). "+
+		"Objects are filtered on the client side, so pages are consistent only while search results stay unchanged")
+	flags.Bool(searchJSONFlag, false, "Print each object as a line with JSON object")
+	flags.StringSlice(searchHeadersFlag, nil, "Object headers to include in JSON output: attribute keys or $Object:* filter names")
+	flags.Bool(searchCountFlag, false, "Print the number of objects only")
+	flags.Uint(searchWorkersFlag, 8, "Number of concurrent HEAD requests for JSON output with headers")
+}
+
+// searchResultWriter prints search results as they come.
+type searchResultWriter struct {
+	cmd *cobra.Command
+
+	limit  uint64
+	cursor string
+
+	countOnly bool
+	json      bool
+
+	headers []string
+	headPrm internalclient.HeadObjectPrm
+	cnr     *cid.ID
+
+	mtx     sync.Mutex
+	wg      sync.WaitGroup
+	workers chan struct{}
+
+	skipping bool
+	n        uint64
+	last     *oidSDK.ID
+	limited  bool
+}
+
+// streamSearchResults prints identifiers of the objects matching the filters
+// according to the output flags. If summary is set, total number of objects
+// is printed at the end of plain output.
+func streamSearchResults(cmd *cobra.Command, cnr *cid.ID, filters object.SearchFilters, summary bool) {
+	key, err := getKey()
+	exitOnErr(cmd, errf("get private key: %w", err))
+
+	var prm internalclient.SearchObjectsStreamPrm
+
+	prepareSessionPrmWithKey(cmd, key, &prm)
 	prepareObjectPrm(cmd, &prm)
-	prm.SetContainerID(cid)
-	prm.SetFilters(sf)
+	prm.SetKey(key)
+	prm.SetContainerID(cnr)
+	prm.SetFilters(filters)
+
+	w := &searchResultWriter{
+		cmd: cmd,
+		cnr: cnr,
+	}
+
+	flags := cmd.Flags()
+	w.limit, _ = flags.GetUint64(searchLimitFlag)
+	w.cursor, _ = flags.GetString(searchCursorFlag)
+	w.countOnly, _ = flags.GetBool(searchCountFlag)
+	w.json, _ = flags.GetBool(searchJSONFlag)
+	w.headers, _ = flags.GetStringSlice(searchHeadersFlag)
+	w.skipping = w.cursor != ""
+
+	if w.json && len(w.headers) > 0 && !w.countOnly {
+		workers, _ := flags.GetUint(searchWorkersFlag)
+		if workers == 0 {
+			workers = 1
+		}
+
+		w.workers = make(chan struct{}, workers)
+
+		prepareAPIClientWithKey(cmd, key, &w.headPrm)
+		prepareObjectPrm(cmd, &w.headPrm)
+	}
+
+	prm.SetHandler(w.handle)
+
+	err = internalclient.SearchObjectsStream(prm)
+
+	w.wg.Wait()
 
-	res, err := internalclient.SearchObjects(prm)
 	exitOnErr(cmd, errf("rpc error: %w", err))
+	exitOnErr(cmd, w.finish(summary))
+}
+
+// finish prints the number of the objects and the cursor of the next
+// page if the output was limited. If summary is set, total number of
+// objects is printed at the end of plain output.
+//
+// Returns an error if the cursor object was not found in the search results.
+func (w *searchResultWriter) finish(summary bool) error {
+	// order of the search results is not guaranteed, so the cursor
+	// can be missing if the results have changed since the previous call
+	if w.skipping {
+		return fmt.Errorf("cursor object %s was not found in the search results", w.cursor)
+	}
+
+	switch {
+	case w.countOnly:
+		w.cmd.Println(w.n)
+	case summary && !w.json:
+		w.cmd.Printf("Found %d objects.\n", w.n)
+	}
 
-	ids := res.IDList()
+	if w.limited {
+		w.cmd.PrintErrf("Cursor: %s\n", w.last)
+	}
+
+	return nil
+}
 
-	cmd.Printf("Found %d objects.\n", len(ids))
+func (w *searchResultWriter) handle(ids []*oidSDK.ID) bool {
 	for _, id := range ids {
-		cmd.Println(id)
+		if w.skipping {
+			w.skipping = id.String() != w.cursor
+			continue
+		}
+
+		if w.limit > 0 && w.n == w.limit {
+			w.limited = true
+			return false
+		}
+
+		w.n++
+		w.last = id
+
+		switch {
+		case w.countOnly:
+		case w.workers != nil:
+			w.workers <- struct{}{}
+			w.wg.Add(1)
+
+			go func(id *oidSDK.ID) {
+				defer func() {
+					<-w.workers
+					w.wg.Done()
+				}()
+
+				hdrs, err := w.readHeaders(id)
+				w.printJSON(id, hdrs, err)
+			}(id)
+		case w.json:
+			w.printJSON(id, nil, nil)
+		default:
+			w.cmd.Println(id)
+		}
+	}
+
+	return true
+}
+
+// searchResultJSON is a JSON representation of the search result.
+type searchResultJSON struct {
+	ID      string            `json:"id"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Error   string            `json:"error,omitempty"`
+}
+
+func (w *searchResultWriter) readHeaders(id *oidSDK.ID) (map[string]string, error) {
+	addr := addressSDK.NewAddress()
+	addr.SetContainerID(w.cnr)
+	addr.SetObjectID(id)
+
+	prm := w.headPrm
+	prm.SetAddress(addr)
+
+	res, err := internalclient.HeadObject(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	hdrs := make(map[string]string, len(w.headers))
+
+	for _, key := range w.headers {
+		if val, ok := objectHeaderValue(res.Header(), key); ok {
+			hdrs[key] = val
+		}
+	}
+
+	return hdrs, nil
+}
+
+func (w *searchResultWriter) printJSON(id *oidSDK.ID, hdrs map[string]string, hdrErr error) {
+	res := searchResultJSON{
+		ID:      id.String(),
+		Headers: hdrs,
+	}
+
+	if hdrErr != nil {
+		res.Error = hdrErr.Error()
 	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		w.cmd.PrintErrln(err)
+		return
+	}
+
+	w.mtx.Lock()
+	w.cmd.Println(string(data))
+	w.mtx.Unlock()
+}
+
+// objectHeaderValue returns string value of the object header
+// by attribute key or search filter name of the system header.
+func objectHeaderValue(obj *object.Object, key string) (string, bool) {
+	switch key {
+	case objectV2.FilterHeaderOwnerID:
+		if obj.OwnerID() == nil {
+			return "", false
+		}
+
+		return obj.OwnerID().String(), true
+	case objectV2.FilterHeaderCreationEpoch:
+		return strconv.FormatUint(obj.CreationEpoch(), 10), true
+	case objectV2.FilterHeaderPayloadLength:
+		return strconv.FormatUint(obj.PayloadSize(), 10), true
+	case objectV2.FilterHeaderObjectType:
+		return obj.Type().String(), true
+	case objectV2.FilterHeaderPayloadHash:
+		cs := obj.PayloadChecksum()
+		if cs == nil {
+			return "", false
+		}
+
+		return hex.EncodeToString(cs.Sum()), true
+	}
+
+	for _, a := range obj.Attributes() {
+		if a.Key() == key {
+			return a.Value(), true
+		}
+	}
+
+	return "", false
 }
 
 func getObjectHash(cmd *cobra.Command, _ []string) {
@@ -0,0 +1,128 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	oidtest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSearchWriter(limit uint64, cursor string) (*searchResultWriter, *bytes.Buffer, *bytes.Buffer) {
+	var out, errOut bytes.Buffer
+
+	cmd := new(cobra.Command)
+	cmd.SetOut(&out)
+	cmd.SetErr(&errOut)
+
+	return &searchResultWriter{
+		cmd:      cmd,
+		limit:    limit,
+		cursor:   cursor,
+		skipping: cursor != "",
+	}, &out, &errOut
+}
+
+func lines(ids ...*oidSDK.ID) string {
+	var buf bytes.Buffer
+
+	for i := range ids {
+		buf.WriteString(ids[i].String() + "\n")
+	}
+
+	return buf.String()
+}
+
+func TestSearchResultWriter(t *testing.T) {
+	a, b, c, d := oidtest.ID(), oidtest.ID(), oidtest.ID(), oidtest.ID()
+
+	t.Run("plain", func(t *testing.T) {
+		w, out, errOut := newTestSearchWriter(0, "")
+
+		require.True(t, w.handle([]*oidSDK.ID{a, b}))
+		require.True(t, w.handle([]*oidSDK.ID{c}))
+		require.NoError(t, w.finish(true))
+
+		require.Equal(t, lines(a, b, c)+"Found 3 objects.\n", out.String())
+		require.Empty(t, errOut.String())
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		w, out, errOut := newTestSearchWriter(2, "")
+
+		require.True(t, w.handle([]*oidSDK.ID{a}))
+		require.False(t, w.handle([]*oidSDK.ID{b, c, d}), "stream must be closed after the page")
+		require.NoError(t, w.finish(true))
+
+		require.Equal(t, lines(a, b)+"Found 2 objects.\n", out.String())
+		require.Equal(t, "Cursor: "+b.String()+"\n", errOut.String())
+	})
+
+	t.Run("limit of the last page", func(t *testing.T) {
+		w, out, errOut := newTestSearchWriter(2, "")
+
+		require.True(t, w.handle([]*oidSDK.ID{a, b}))
+		require.NoError(t, w.finish(false))
+
+		// there is no next page, so the cursor is not printed
+		require.Equal(t, lines(a, b), out.String())
+		require.Empty(t, errOut.String())
+	})
+
+	t.Run("cursor", func(t *testing.T) {
+		w, out, errOut := newTestSearchWriter(1, b.String())
+
+		require.True(t, w.handle([]*oidSDK.ID{a, b}))
+		require.False(t, w.handle([]*oidSDK.ID{c, d}))
+		require.NoError(t, w.finish(false))
+
+		require.Equal(t, lines(c), out.String())
+		require.Equal(t, "Cursor: "+c.String()+"\n", errOut.String())
+
+		// the next page is resumed from the printed cursor
+		w, out, errOut = newTestSearchWriter(1, c.String())
+
+		require.True(t, w.handle([]*oidSDK.ID{a, b, c, d}))
+		require.NoError(t, w.finish(false))
+
+		require.Equal(t, lines(d), out.String())
+		require.Empty(t, errOut.String())
+	})
+
+	t.Run("missing cursor", func(t *testing.T) {
+		w, out, _ := newTestSearchWriter(0, d.String())
+
+		require.True(t, w.handle([]*oidSDK.ID{a, b, c}))
+		require.Error(t, w.finish(true))
+		require.Empty(t, out.String())
+	})
+
+	t.Run("count", func(t *testing.T) {
+		w, out, _ := newTestSearchWriter(0, "")
+		w.countOnly = true
+
+		require.True(t, w.handle([]*oidSDK.ID{a, b, c}))
+		require.NoError(t, w.finish(true))
+
+		require.Equal(t, "3\n", out.String())
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		w, out, _ := newTestSearchWriter(0, "")
+		w.json = true
+
+		require.True(t, w.handle([]*oidSDK.ID{a}))
+		w.printJSON(b, map[string]string{"FileName": "cat.jpg"}, nil)
+		w.printJSON(c, nil, errors.New("object not found"))
+		require.NoError(t, w.finish(true))
+
+		require.Equal(t, fmt.Sprintf(`{"id":"%s"}
+{"id":"%s","headers":{"FileName":"cat.jpg"}}
+{"id":"%s","error":"object not found"}
+`, a, b, c), out.String())
+	})
+}
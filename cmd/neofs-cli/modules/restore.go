@@ -1,7 +1,14 @@
 package cmd
 
 import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
 	"github.com/mr-tron/base58"
+	rpcclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
 	controlSvc "github.com/nspcc-dev/neofs-node/pkg/services/control/server"
 	"github.com/nspcc-dev/neofs-sdk-go/util/signature"
@@ -9,15 +16,24 @@ import (
 )
 
 const (
-	restoreFilepathFlag     = "path"
-	restoreIgnoreErrorsFlag = "no-errors"
+	restoreFilepathFlag      = "path"
+	restoreIgnoreErrorsFlag  = "no-errors"
+	restoreBaseWatermarkFlag = "base-watermark"
+	restoreStreamFlag        = "stream"
 )
 
+// restoreStreamChunkSize is a maximum size of the dump portion
+// in the single RestoreShardStream message.
+const restoreStreamChunkSize = 1 << 20
+
 var restoreShardCmd = &cobra.Command{
 	Use:   "restore",
 	Short: "Restore objects from shard",
-	Long:  "Restore objects from shard to a file",
-	Run:   restoreShard,
+	Long: `Restore objects from shard to a file.
+
+By default the file is read from the node's file system. With --stream flag
+the local file is transferred to the node over the control connection.`,
+	Run: restoreShard,
 }
 
 func restoreShard(cmd *cobra.Command, _ []string) {
@@ -36,6 +52,22 @@ func restoreShard(cmd *cobra.Command, _ []string) {
 	ignore, _ := cmd.Flags().GetBool(restoreIgnoreErrorsFlag)
 	body.SetIgnoreErrors(ignore)
 
+	if cmd.Flags().Changed(restoreBaseWatermarkFlag) {
+		w, _ := cmd.Flags().GetUint64(restoreBaseWatermarkFlag)
+		body.SetBaseWatermark(w)
+	}
+
+	if stream, _ := cmd.Flags().GetBool(restoreStreamFlag); stream {
+		cli, err := getControlSDKClient(key)
+		exitOnErr(cmd, err)
+
+		resp, err := restoreShardFromFile(cli.Raw(), key, body, p)
+		exitOnErr(cmd, err)
+
+		printRestoreShardResponse(cmd, resp)
+		return
+	}
+
 	req := new(control.RestoreShardRequest)
 	req.SetBody(body)
 
@@ -58,7 +90,89 @@ func restoreShard(cmd *cobra.Command, _ []string) {
 	)
 	exitOnErr(cmd, errf("invalid response signature: %w", err))
 
+	printRestoreShardResponse(cmd, resp)
+}
+
+func printRestoreShardResponse(cmd *cobra.Command, resp *control.RestoreShardResponse) {
 	cmd.Println("Shard has been restored successfully.")
+	cmd.Printf("Objects: %d, skipped: %d\n", resp.GetBody().GetCount(), resp.GetBody().GetFailed())
+
+	if w := resp.GetBody().GetWatermark(); w != 0 {
+		cmd.Printf("Watermark: %d\n", w)
+	}
+}
+
+// restoreShardFromFile streams the local dump file to the node. Parameters
+// from the request body are sent in the first message.
+func restoreShardFromFile(cli *rpcclient.Client, key *ecdsa.PrivateKey, reqBody *control.RestoreShardRequest_Body, path string) (*control.RestoreShardResponse, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open dump file: %w", err)
+	}
+	defer f.Close()
+
+	w, err := control.RestoreShardStream(cli)
+	if err != nil {
+		return nil, fmt.Errorf("rpc error: %w", err)
+	}
+
+	body := new(control.RestoreShardStreamRequest_Body)
+	body.SetShardID(reqBody.GetShard_ID())
+	body.SetIgnoreErrors(reqBody.GetIgnoreErrors())
+
+	if reqBody.GetCheckWatermark() {
+		body.SetBaseWatermark(reqBody.GetBaseWatermark())
+	}
+
+	buf := make([]byte, restoreStreamChunkSize)
+
+	for {
+		n, err := io.ReadFull(f, buf)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("could not read dump file: %w", err)
+		}
+
+		body.SetChunk(buf[:n])
+
+		req := new(control.RestoreShardStreamRequest)
+		req.SetBody(body)
+
+		if err := controlSvc.SignMessage(key, req); err != nil {
+			return nil, fmt.Errorf("could not sign request: %w", err)
+		}
+
+		if err := w.Write(req); err != nil {
+			if errors.Is(err, io.EOF) {
+				break // stream was closed by the server, error is returned on Close
+			}
+			return nil, fmt.Errorf("rpc error: %w", err)
+		}
+
+		// parameters are sent in the first message only
+		body = new(control.RestoreShardStreamRequest_Body)
+	}
+
+	resp, err := w.Close()
+	if err != nil {
+		return nil, fmt.Errorf("rpc error: %w", err)
+	}
+
+	sign := resp.GetSignature()
+
+	err = signature.VerifyDataWithSource(
+		resp,
+		func() ([]byte, []byte) {
+			return sign.GetKey(), sign.GetSign()
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response signature: %w", err)
+	}
+
+	return resp, nil
 }
 
 func initControlRestoreShardCmd() {
@@ -69,6 +183,8 @@ func initControlRestoreShardCmd() {
 	flags.StringVarP(&shardID, shardIDFlag, "", "", "Shard ID in base58 encoding")
 	flags.String(restoreFilepathFlag, "", "File to read objects from")
 	flags.Bool(restoreIgnoreErrorsFlag, false, "Skip invalid/unreadable objects")
+	flags.Bool(restoreStreamFlag, false, "Stream the local dump file to the node")
+	flags.Uint64(restoreBaseWatermarkFlag, 0, "Watermark of the previously restored dump, incremental dump must not start after it")
 
 	_ = restoreShardCmd.MarkFlagRequired(shardIDFlag)
 	_ = restoreShardCmd.MarkFlagRequired(restoreFilepathFlag)
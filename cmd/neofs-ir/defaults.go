@@ -79,6 +79,7 @@ func defaultConfiguration(cfg *viper.Viper) {
 	cfg.SetDefault("timers.distribute_basic_income.div", 4)
 
 	cfg.SetDefault("workers.netmap", "10")
+	cfg.SetDefault("workers.netmap_validation", "10")
 	cfg.SetDefault("workers.balance", "10")
 	cfg.SetDefault("workers.neofs", "10")
 	cfg.SetDefault("workers.container", "10")
@@ -89,6 +90,10 @@ func defaultConfiguration(cfg *viper.Viper) {
 	cfg.SetDefault("netmap_cleaner.enabled", true)
 	cfg.SetDefault("netmap_cleaner.threshold", 3)
 
+	cfg.SetDefault("netmap_validation.reachability.enabled", false)
+	cfg.SetDefault("netmap_validation.reachability.dial_timeout", "5s")
+	cfg.SetDefault("netmap_validation.reachability.request_timeout", "10s")
+
 	cfg.SetDefault("emit.storage.amount", 0)
 	cfg.SetDefault("emit.mint.cache_size", 1000)
 	cfg.SetDefault("emit.mint.threshold", 1)
@@ -110,6 +115,11 @@ func defaultConfiguration(cfg *viper.Viper) {
 
 	cfg.SetDefault("indexer.cache_timeout", 15*time.Second)
 
+	cfg.SetDefault("event_index.enabled", false)
+	cfg.SetDefault("event_index.path", ".neofs-ir-events")
+	cfg.SetDefault("event_index.backfill.side_chain", 0)
+	cfg.SetDefault("event_index.backfill.main_chain", 0)
+
 	cfg.SetDefault("locode.db.path", "")
 
 	// extra fee values for working mode without notary contract
@@ -117,6 +127,8 @@ func defaultConfiguration(cfg *viper.Viper) {
 	cfg.SetDefault("fee.side_chain", 2_0000_0000)                // 2.0 Fixed8
 	cfg.SetDefault("fee.named_container_register", 25_0000_0000) // 25.0 Fixed8
 
+	cfg.SetDefault("container.check_placement", false)
+
 	cfg.SetDefault("control.authorized_keys", []string{})
 	cfg.SetDefault("control.grpc.endpoint", "")
 
@@ -0,0 +1,167 @@
+package fsck
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	engineconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine"
+	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/spf13/cobra"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+const (
+	flagConfig = "shard-config"
+	flagShard  = "shard"
+	flagRepair = "repair"
+)
+
+var (
+	vConfig string
+	vShard  uint64
+	vRepair bool
+)
+
+// Command contains `fsck` command definition.
+var Command = &cobra.Command{
+	Use:   "fsck",
+	Short: "Shard consistency check",
+	Long: `Check that metabase and BLOB storage of the shard agree with each other,
+verify checksums and signatures of the stored objects and detect orphaned
+blobovniczas. Storage node must be stopped and write-cache must be flushed
+before the check.`,
+	Run: fsck,
+}
+
+func init() {
+	Command.Flags().StringVar(&vConfig, flagConfig, "",
+		"Path to storage node configuration file with shard settings")
+	_ = Command.MarkFlagFilename(flagConfig)
+	_ = Command.MarkFlagRequired(flagConfig)
+
+	Command.Flags().Uint64Var(&vShard, flagShard, 0, "Index of the shard in configuration file")
+	Command.Flags().BoolVar(&vRepair, flagRepair, false,
+		"Index orphaned objects, remove dangling metabase records and fix blobovnicza IDs")
+}
+
+func fsck(cmd *cobra.Command, _ []string) {
+	sh := openShard(cmd)
+	defer sh.Close()
+
+	prm := new(shard.FsckPrm).
+		WithRepair(vRepair).
+		WithIssueHandler(func(i *shard.FsckIssue) {
+			printIssue(cmd, i)
+		})
+
+	res, err := sh.Fsck(prm)
+	common.ExitOnErr(cmd, common.Errf("consistency check failure: %w", err))
+
+	cmd.Printf("Checked %d objects and %d metabase records, found %d issues, repaired %d.\n",
+		res.Objects(), res.Records(), res.Issues(), res.Repaired())
+
+	if res.Issues() > res.Repaired() {
+		common.ExitOnErr(cmd, fmt.Errorf("shard has %d unrepaired issues", res.Issues()-res.Repaired()))
+	}
+}
+
+func printIssue(cmd *cobra.Command, i *shard.FsckIssue) {
+	target := "-"
+	if addr := i.Address(); addr != nil {
+		target = addr.String()
+	}
+
+	cmd.Print(i.Type(), " ", target)
+
+	if p := i.Path(); p != "" {
+		cmd.Print(" blobovnicza=", p)
+	}
+
+	if err := i.Error(); err != nil {
+		cmd.Print(" error=\"", err, "\"")
+	}
+
+	if i.Repaired() {
+		cmd.Print(" (repaired)")
+	}
+
+	cmd.Println()
+}
+
+// openShard opens and initializes the shard from the configuration file.
+// Write-cache is not opened, GC does not remove objects during the check.
+// Without repair metabase is opened in read-only mode and is not migrated.
+func openShard(cmd *cobra.Command) *shard.Shard {
+	var sc *shardconfig.Config
+
+	appCfg := config.New(config.Prm{}, config.WithConfigFile(vConfig))
+
+	var ind uint64
+
+	engineconfig.IterateShards(appCfg, false, func(c *shardconfig.Config) {
+		if ind == vShard {
+			sc = c
+		}
+
+		ind++
+	})
+
+	if sc == nil {
+		common.ExitOnErr(cmd, fmt.Errorf("shard #%d is not configured, total shards: %d", vShard, ind))
+	}
+
+	mode := shard.ModeReadOnly
+	if vRepair {
+		mode = shard.ModeReadWrite
+	}
+
+	blobStorCfg := sc.BlobStor()
+	blobovniczaCfg := blobStorCfg.Blobovnicza()
+	metabaseCfg := sc.Metabase()
+
+	log := zap.NewNop()
+
+	sh := shard.New(
+		shard.WithLogger(log),
+		shard.WithMode(mode),
+		shard.WithBlobStorOptions(
+			blobstor.WithRootPath(blobStorCfg.Path()),
+			blobstor.WithCompressObjects(blobStorCfg.Compress()),
+			blobstor.WithRootPerm(blobStorCfg.Perm()),
+			blobstor.WithShallowDepth(blobStorCfg.ShallowDepth()),
+			blobstor.WithSmallSizeLimit(blobStorCfg.SmallSizeLimit()),
+			blobstor.WithBlobovniczaSize(blobovniczaCfg.Size()),
+			blobstor.WithBlobovniczaShallowDepth(blobovniczaCfg.ShallowDepth()),
+			blobstor.WithBlobovniczaShallowWidth(blobovniczaCfg.ShallowWidth()),
+			blobstor.WithBlobovniczaOpenedCacheSize(blobovniczaCfg.OpenedCacheSize()),
+			blobstor.WithLogger(log),
+		),
+		shard.WithMetaBaseOptions(
+			meta.WithLogger(log),
+			meta.WithPath(metabaseCfg.Path()),
+			meta.WithPermissions(metabaseCfg.Perm()),
+			meta.WithBoltDBOptions(&bbolt.Options{
+				Timeout:  100 * time.Millisecond,
+				ReadOnly: !vRepair,
+			}),
+		),
+		shard.WithGCRemoverSleepInterval(math.MaxInt64),
+	)
+
+	common.ExitOnErr(cmd, common.Errf("could not open shard: %w", sh.Open()))
+
+	if vRepair {
+		common.ExitOnErr(cmd, common.Errf("could not initialize shard: %w", sh.Init()))
+	} else {
+		common.ExitOnErr(cmd, common.Errf("could not initialize shard: %w", sh.InitCheck()))
+	}
+
+	return sh
+}
@@ -18,6 +18,9 @@ const (
 	flagHeader     = "header"
 	flagOutFile    = "out"
 	flagWriteCache = "writecache"
+	flagFSTree     = "fstree"
+	flagDepth      = "depth"
+	flagWidth      = "width"
 )
 
 var (
@@ -26,6 +29,9 @@ var (
 	vPath       string
 	vOut        string
 	vWriteCache bool
+	vFSTree     bool
+	vDepth      int
+	vWidth      int
 )
 
 // Command contains `inspect` command definition.
@@ -52,6 +58,12 @@ func init() {
 	Command.Flags().BoolVar(&vHeader, flagHeader, false, "Inspect only header")
 	Command.Flags().BoolVar(&vWriteCache, flagWriteCache, false,
 		"Process write-cache")
+	Command.Flags().BoolVar(&vFSTree, flagFSTree, false,
+		"Process FSTree root directory")
+	Command.Flags().IntVar(&vDepth, flagDepth, common.FSTreeDepthDefault,
+		"Depth of FSTree nested directories")
+	Command.Flags().IntVar(&vWidth, flagWidth, common.FSTreeWidthDefault,
+		"Length of FSTree directory names")
 }
 
 func objectInspectCmd(cmd *cobra.Command, _ []string) {
@@ -76,6 +88,13 @@ func objectInspectCmd(cmd *cobra.Command, _ []string) {
 		return
 	}
 
+	if vFSTree {
+		data, err := common.FSTree(vPath, vDepth, vWidth).Get(addr)
+		common.ExitOnErr(cmd, common.Errf("could not fetch object: %w", err))
+		printObjectInfo(cmd, data)
+		return
+	}
+
 	blz := blobovnicza.New(
 		blobovnicza.WithPath(vPath),
 		blobovnicza.ReadOnly())
@@ -92,8 +111,11 @@ func objectInspectCmd(cmd *cobra.Command, _ []string) {
 }
 
 func printObjectInfo(cmd *cobra.Command, data []byte) {
+	data, err := common.Decompress(data)
+	common.ExitOnErr(cmd, common.Errf("can't decompress object: %w", err))
+
 	obj := object.New()
-	err := obj.Unmarshal(data)
+	err = obj.Unmarshal(data)
 	common.ExitOnErr(cmd, common.Errf("can't unmarshal object: %w", err))
 
 	if vHeader {
@@ -5,6 +5,7 @@ import (
 
 	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	"github.com/spf13/cobra"
@@ -13,11 +14,17 @@ import (
 const (
 	flagFile       = "path"
 	flagWriteCache = "writecache"
+	flagFSTree     = "fstree"
+	flagDepth      = "depth"
+	flagWidth      = "width"
 )
 
 var (
 	vPath       string
 	vWriteCache bool
+	vFSTree     bool
+	vDepth      int
+	vWidth      int
 )
 
 func init() {
@@ -30,6 +37,16 @@ func init() {
 	Command.Flags().BoolVar(&vWriteCache, flagWriteCache, false,
 		"Process write-cache",
 	)
+
+	Command.Flags().BoolVar(&vFSTree, flagFSTree, false,
+		"Process FSTree root directory",
+	)
+	Command.Flags().IntVar(&vDepth, flagDepth, common.FSTreeDepthDefault,
+		"Depth of FSTree nested directories",
+	)
+	Command.Flags().IntVar(&vWidth, flagWidth, common.FSTreeWidthDefault,
+		"Length of FSTree directory names",
+	)
 }
 
 var Command = &cobra.Command{
@@ -57,6 +74,19 @@ var Command = &cobra.Command{
 			return
 		}
 
+		if vFSTree {
+			fsTree := common.FSTree(vPath, vDepth, vWidth)
+
+			err := fsTree.Iterate(new(fstree.IterationPrm).WithLazyHandler(
+				func(addr *addressSDK.Address, _ func() ([]byte, error)) error {
+					return wAddr(addr)
+				},
+			))
+			common.ExitOnErr(cmd, common.Errf("FSTree iterator failure: %w", err))
+
+			return
+		}
+
 		blz := blobovnicza.New(
 			blobovnicza.WithPath(vPath),
 			blobovnicza.ReadOnly(),
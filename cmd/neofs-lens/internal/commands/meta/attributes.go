@@ -0,0 +1,63 @@
+package cmdmeta
+
+import (
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagCID = "cid"
+	flagKey = "key"
+)
+
+var (
+	vCID string
+	vKey string
+)
+
+var attributesCmd = &cobra.Command{
+	Use:   "attributes",
+	Short: "Attribute index listing",
+	Long:  `List attribute index entries of the container.`,
+	Run:   listAttributes,
+}
+
+func init() {
+	attributesCmd.Flags().StringVar(&vCID, flagCID, "", "Container ID")
+	_ = attributesCmd.MarkFlagRequired(flagCID)
+
+	attributesCmd.Flags().StringVar(&vKey, flagKey, "",
+		"Attribute key (all attributes if not set)")
+}
+
+type attributeJSON struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+	ID    string `json:"id"`
+}
+
+func listAttributes(cmd *cobra.Command, _ []string) {
+	id := cid.New()
+	err := id.Parse(vCID)
+	common.ExitOnErr(cmd, common.Errf("invalid container ID: %w", err))
+
+	db := openMeta(cmd, true)
+	defer db.Close()
+
+	err = db.IterateAttributes(id, vKey, func(key, val string, obj *oidSDK.ID) error {
+		if vJSON {
+			printJSON(cmd, attributeJSON{
+				Key:   key,
+				Value: val,
+				ID:    obj.String(),
+			})
+		} else {
+			cmd.Printf("%s: %s %s\n", key, val, obj)
+		}
+
+		return nil
+	})
+	common.ExitOnErr(cmd, common.Errf("attribute iterator failure: %w", err))
+}
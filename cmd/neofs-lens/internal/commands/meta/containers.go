@@ -0,0 +1,54 @@
+package cmdmeta
+
+import (
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	"github.com/spf13/cobra"
+)
+
+var containersCmd = &cobra.Command{
+	Use:   "containers",
+	Short: "Container listing",
+	Long:  `List containers stored in metabase with object counts.`,
+	Run:   listContainers,
+}
+
+type containerStatJSON struct {
+	ID            string `json:"id"`
+	Regular       uint64 `json:"regular"`
+	Tombstones    uint64 `json:"tombstones"`
+	StorageGroups uint64 `json:"storage_groups"`
+	Small         uint64 `json:"small"`
+	Parents       uint64 `json:"parents"`
+	Size          uint64 `json:"size"`
+}
+
+func listContainers(cmd *cobra.Command, _ []string) {
+	db := openMeta(cmd, true)
+	defer db.Close()
+
+	stats, err := db.ContainerStats()
+	common.ExitOnErr(cmd, common.Errf("could not list containers: %w", err))
+
+	for _, s := range stats {
+		res := containerStatJSON{
+			ID:            s.ID().String(),
+			Regular:       s.Regular(),
+			Tombstones:    s.Tombstones(),
+			StorageGroups: s.StorageGroups(),
+			Small:         s.Small(),
+			Parents:       s.Parents(),
+			Size:          s.Size(),
+		}
+
+		if vJSON {
+			printJSON(cmd, res)
+			continue
+		}
+
+		cmd.Println(res.ID)
+		cmd.Printf("  regular: %d, tombstones: %d, storage groups: %d\n",
+			res.Regular, res.Tombstones, res.StorageGroups)
+		cmd.Printf("  small: %d, parents: %d, size: %d\n",
+			res.Small, res.Parents, res.Size)
+	}
+}
@@ -0,0 +1,48 @@
+package cmdmeta
+
+import (
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/spf13/cobra"
+)
+
+var graveyardCmd = &cobra.Command{
+	Use:   "graveyard",
+	Short: "Graveyard listing",
+	Long:  `List removed objects with their tombstones or GC marks.`,
+	Run:   listGraveyard,
+}
+
+type graveJSON struct {
+	Address   string `json:"address"`
+	Tombstone string `json:"tombstone,omitempty"`
+	GCMark    bool   `json:"gc_mark"`
+}
+
+func listGraveyard(cmd *cobra.Command, _ []string) {
+	db := openMeta(cmd, true)
+	defer db.Close()
+
+	err := db.IterateOverGraveyard(func(g *meta.Grave) error {
+		res := graveJSON{
+			Address: g.Address().String(),
+			GCMark:  g.WithGCMark(),
+		}
+
+		if !res.GCMark {
+			res.Tombstone = g.Tombstone().String()
+		}
+
+		switch {
+		case vJSON:
+			printJSON(cmd, res)
+		case res.GCMark:
+			cmd.Printf("%s GC-marked\n", res.Address)
+		default:
+			cmd.Printf("%s inhumed by %s\n", res.Address, res.Tombstone)
+		}
+
+		return nil
+	})
+	common.ExitOnErr(cmd, common.Errf("graveyard iterator failure: %w", err))
+}
@@ -0,0 +1,50 @@
+package cmdmeta
+
+import (
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/spf13/cobra"
+)
+
+const flagDryRun = "dry-run"
+
+var vDryRun bool
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Metabase migration",
+	Long: `Upgrade metabase to the current schema version in place.
+Storage node must be stopped before the migration.`,
+	Run: migrate,
+}
+
+func init() {
+	migrateCmd.Flags().BoolVar(&vDryRun, flagDryRun, false,
+		"Only print pending migrations without changing the metabase")
+}
+
+func migrate(cmd *cobra.Command, _ []string) {
+	db := openMeta(cmd, vDryRun)
+	defer db.Close()
+
+	res, err := db.Migrate(new(meta.MigratePrm).WithDryRun(vDryRun))
+	common.ExitOnErr(cmd, common.Errf("migration failure: %w", err))
+
+	cmd.Printf("Metabase version: %d, current: %d\n", res.Version(), meta.Version)
+
+	migrations := res.Migrations()
+	if len(migrations) == 0 {
+		cmd.Println("Metabase is up to date.")
+		return
+	}
+
+	if vDryRun {
+		cmd.Println("Pending migrations:")
+	} else {
+		cmd.Println("Applied migrations:")
+	}
+
+	for i := range migrations {
+		cmd.Printf("  %d -> %d: %s\n", res.Version()+uint64(i), res.Version()+uint64(i)+1, migrations[i])
+	}
+}
@@ -0,0 +1,73 @@
+package cmdmeta
+
+import (
+	"encoding/json"
+	"os"
+
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/spf13/cobra"
+	"go.etcd.io/bbolt"
+)
+
+const (
+	flagPath = "path"
+	flagJSON = "json"
+)
+
+var (
+	vPath string
+	vJSON bool
+)
+
+// Command contains `meta` command definition.
+var Command = &cobra.Command{
+	Use:   "meta",
+	Short: "Metabase operations",
+	Long:  `Operations with the metabase of a storage engine shard.`,
+}
+
+func init() {
+	Command.PersistentFlags().StringVar(&vPath, flagPath, "", "Path to metabase")
+	_ = Command.MarkPersistentFlagFilename(flagPath)
+	_ = Command.MarkPersistentFlagRequired(flagPath)
+
+	for _, cmd := range []*cobra.Command{
+		statusCmd,
+		graveyardCmd,
+		containersCmd,
+		attributesCmd,
+	} {
+		cmd.Flags().BoolVar(&vJSON, flagJSON, false, "Print output in JSON format")
+		Command.AddCommand(cmd)
+	}
+
+	Command.AddCommand(migrateCmd)
+}
+
+// openMeta opens existing metabase located at the path from the flag.
+// Metabase is not initialized, so it is safe to open databases of any version.
+func openMeta(cmd *cobra.Command, readOnly bool) *meta.DB {
+	// bbolt creates missing database, so check it explicitly
+	_, err := os.Stat(vPath)
+	common.ExitOnErr(cmd, common.Errf("could not open metabase: %w", err))
+
+	db := meta.New(
+		meta.WithPath(vPath),
+		meta.WithBoltDBOptions(&bbolt.Options{
+			ReadOnly: readOnly,
+		}),
+	)
+
+	common.ExitOnErr(cmd, common.Errf("could not open metabase: %w", db.Open()))
+
+	return db
+}
+
+// printJSON prints v as a single line of JSON.
+func printJSON(cmd *cobra.Command, v interface{}) {
+	data, err := json.Marshal(v)
+	common.ExitOnErr(cmd, common.Errf("can't convert to JSON: %w", err))
+
+	cmd.Println(string(data))
+}
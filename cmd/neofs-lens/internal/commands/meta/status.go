@@ -0,0 +1,138 @@
+package cmdmeta
+
+import (
+	common "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/spf13/cobra"
+)
+
+const flagAddress = "address"
+
+var vAddress string
+
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Object status",
+	Long: `Print metabase records of the object: header, removal status,
+blobovnicza ID and split information.`,
+	Run: objectStatus,
+}
+
+func init() {
+	statusCmd.Flags().StringVar(&vAddress, flagAddress, "", "Object address")
+	_ = statusCmd.MarkFlagRequired(flagAddress)
+}
+
+type splitInfoJSON struct {
+	SplitID  string `json:"split_id,omitempty"`
+	LastPart string `json:"last_part,omitempty"`
+	Link     string `json:"link,omitempty"`
+}
+
+type objectStatusJSON struct {
+	Address       string         `json:"address"`
+	Status        string         `json:"status"`
+	Tombstone     string         `json:"tombstone,omitempty"`
+	Type          string         `json:"type,omitempty"`
+	PayloadSize   *uint64        `json:"payload_size,omitempty"`
+	Parent        string         `json:"parent,omitempty"`
+	BlobovniczaID string         `json:"blobovnicza_id,omitempty"`
+	SplitInfo     *splitInfoJSON `json:"split_info,omitempty"`
+	ToMoveIt      bool           `json:"to_move_it"`
+}
+
+func objectStatus(cmd *cobra.Command, _ []string) {
+	addr := addressSDK.NewAddress()
+	err := addr.Parse(vAddress)
+	common.ExitOnErr(cmd, common.Errf("invalid address argument: %w", err))
+
+	db := openMeta(cmd, true)
+	defer db.Close()
+
+	s, err := db.ObjectStatus(addr)
+	common.ExitOnErr(cmd, common.Errf("could not fetch object status: %w", err))
+
+	res := objectStatusJSON{
+		Address:  addr.String(),
+		Status:   "available",
+		ToMoveIt: s.ToMoveIt(),
+	}
+
+	if g := s.Grave(); g != nil {
+		if g.WithGCMark() {
+			res.Status = "GC-marked"
+		} else {
+			res.Status = "inhumed"
+			res.Tombstone = g.Tombstone().String()
+		}
+	}
+
+	if hdr := s.Header(); hdr != nil {
+		size := hdr.PayloadSize()
+
+		res.Type = hdr.Type().String()
+		res.PayloadSize = &size
+
+		if id := hdr.ParentID(); id != nil {
+			res.Parent = id.String()
+		}
+	}
+
+	if id := s.BlobovniczaID(); id != nil {
+		res.BlobovniczaID = id.String()
+	}
+
+	if si := s.SplitInfo(); si != nil {
+		res.SplitInfo = new(splitInfoJSON)
+
+		if si.SplitID() != nil {
+			res.SplitInfo.SplitID = si.SplitID().String()
+		}
+
+		if si.LastPart() != nil {
+			res.SplitInfo.LastPart = si.LastPart().String()
+		}
+
+		if si.Link() != nil {
+			res.SplitInfo.Link = si.Link().String()
+		}
+	}
+
+	if vJSON {
+		printJSON(cmd, res)
+		return
+	}
+
+	cmd.Println("Address:", res.Address)
+	cmd.Println("Status:", res.Status)
+
+	if res.Tombstone != "" {
+		cmd.Println("Tombstone:", res.Tombstone)
+	}
+
+	if res.PayloadSize != nil {
+		cmd.Println("Type:", res.Type)
+		cmd.Println("PayloadSize:", *res.PayloadSize)
+	} else {
+		cmd.Println("Header: not stored")
+	}
+
+	if res.Parent != "" {
+		cmd.Println("Parent:", res.Parent)
+	}
+
+	if res.BlobovniczaID != "" {
+		cmd.Println("Blobovnicza:", res.BlobovniczaID)
+	} else {
+		cmd.Println("Blobovnicza: none (big object)")
+	}
+
+	if res.SplitInfo != nil {
+		cmd.Println("Split info:")
+		cmd.Println("  Split ID:", res.SplitInfo.SplitID)
+		cmd.Println("  Last part:", res.SplitInfo.LastPart)
+		cmd.Println("  Link:", res.SplitInfo.Link)
+	}
+
+	cmd.Println("Marked to move:", res.ToMoveIt)
+}
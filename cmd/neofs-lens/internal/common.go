@@ -4,9 +4,21 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor/fstree"
 	"github.com/spf13/cobra"
 )
 
+const (
+	// FSTreeDepthDefault is a default depth of the FSTree nested directories
+	// used by BlobStor.
+	FSTreeDepthDefault = 4
+
+	// FSTreeWidthDefault is a default length of the FSTree directory names
+	// used by BlobStor.
+	FSTreeWidthDefault = 2
+)
+
 // Errf returns formatted error in errFmt format if err is not nil.
 func Errf(errFmt string, err error) error {
 	if err == nil {
@@ -29,3 +41,26 @@ func exitOnErrCode(cmd *cobra.Command, err error, code int) {
 		os.Exit(code)
 	}
 }
+
+// FSTree returns FSTree located at path with the specified depth of the
+// nested directories and length of the directory names.
+func FSTree(path string, depth, width int) *fstree.FSTree {
+	return &fstree.FSTree{
+		Info: fstree.Info{
+			RootPath: path,
+		},
+		Depth:      depth,
+		DirNameLen: width,
+	}
+}
+
+// Decompress returns decompressed object data stored in BlobStor.
+// Data stored without compression is returned as is.
+func Decompress(data []byte) ([]byte, error) {
+	decompress, err := blobstor.Decompressor()
+	if err != nil {
+		return nil, fmt.Errorf("could not create decompressor: %w", err)
+	}
+
+	return decompress(data)
+}
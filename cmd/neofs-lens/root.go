@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/commands/fsck"
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/commands/inspect"
 	cmdlist "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/commands/list"
+	cmdmeta "github.com/nspcc-dev/neofs-node/cmd/neofs-lens/internal/commands/meta"
 	"github.com/nspcc-dev/neofs-node/misc"
 	"github.com/spf13/cobra"
 )
@@ -36,6 +38,8 @@ func init() {
 	command.AddCommand(
 		cmdlist.Command,
 		inspect.Command,
+		cmdmeta.Command,
+		fsck.Command,
 	)
 }
 
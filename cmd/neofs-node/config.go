@@ -332,6 +332,8 @@ func initLocalStorage(c *cfg) {
 		engine.WithLogger(c.log),
 		engine.WithShardPoolSize(engineconfig.ShardPoolSize(c.appCfg)),
 		engine.WithErrorThreshold(engineconfig.ShardErrorThreshold(c.appCfg)),
+		engine.WithRebalanceInterval(engineconfig.RebalanceInterval(c.appCfg)),
+		engine.WithRebalanceBudget(engineconfig.RebalanceBudget(c.appCfg)),
 	}
 	if c.metricsCollector != nil {
 		engineOpts = append(engineOpts, engine.WithMetrics(c.metricsCollector))
@@ -401,6 +403,7 @@ func initShardOptions(c *cfg) {
 			shard.WithBlobStorOptions(
 				blobstor.WithRootPath(blobStorCfg.Path()),
 				blobstor.WithCompressObjects(blobStorCfg.Compress()),
+				blobstor.WithPayloadDeduplication(blobStorCfg.Deduplicate()),
 				blobstor.WithRootPerm(blobStorCfg.Perm()),
 				blobstor.WithShallowDepth(blobStorCfg.ShallowDepth()),
 				blobstor.WithSmallSizeLimit(blobStorCfg.SmallSizeLimit()),
@@ -468,6 +471,13 @@ func (c *cfg) LocalNodeInfo() (*netmapV2.NodeInfo, error) {
 	return c.cfgNodeInfo.localInfo.ToV2(), nil
 }
 
+// AnnouncedNodeInfo returns the information announced by the local node
+// to the network map. Inner Ring compares it with the announcement before
+// admitting the node to the network map.
+func (c *cfg) AnnouncedNodeInfo() (*netmapV2.NodeInfo, error) {
+	return c.cfgNodeInfo.localInfo.ToV2(), nil
+}
+
 // handleLocalNodeInfo rewrites local node info from netmap
 func (c *cfg) handleLocalNodeInfo(ni *netmap.NodeInfo) {
 	c.cfgNetmap.state.setNodeInfo(ni)
@@ -2,6 +2,7 @@ package engineconfig
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	shardconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/engine/shard"
@@ -65,3 +66,19 @@ func ShardPoolSize(c *config.Config) uint32 {
 func ShardErrorThreshold(c *config.Config) uint32 {
 	return config.Uint32Safe(c.Sub(subsection), "shard_ro_error_threshold")
 }
+
+// RebalanceInterval returns value of "interval" config parameter from
+// "storage.rebalance" section.
+//
+// Returns 0 if the value is not a positive duration, which disables rebalancer.
+func RebalanceInterval(c *config.Config) time.Duration {
+	return config.DurationSafe(c.Sub(subsection).Sub("rebalance"), "interval")
+}
+
+// RebalanceBudget returns value of "budget" config parameter from
+// "storage.rebalance" section.
+//
+// Returns 0 if the value is missing, which means no limit.
+func RebalanceBudget(c *config.Config) uint64 {
+	return config.SizeInBytesSafe(c.Sub(subsection).Sub("rebalance"), "budget")
+}
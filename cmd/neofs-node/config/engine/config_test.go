@@ -33,6 +33,8 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 0, engineconfig.ShardErrorThreshold(empty))
 		require.EqualValues(t, engineconfig.ShardPoolSizeDefault, engineconfig.ShardPoolSize(empty))
+		require.Zero(t, engineconfig.RebalanceInterval(empty))
+		require.Zero(t, engineconfig.RebalanceBudget(empty))
 		require.EqualValues(t, shard.ModeReadWrite, shardconfig.From(empty).Mode())
 	})
 
@@ -43,6 +45,8 @@ func TestEngineSection(t *testing.T) {
 
 		require.EqualValues(t, 100, engineconfig.ShardErrorThreshold(c))
 		require.EqualValues(t, 15, engineconfig.ShardPoolSize(c))
+		require.Equal(t, 10*time.Minute, engineconfig.RebalanceInterval(c))
+		require.EqualValues(t, 10*1024*1024, engineconfig.RebalanceBudget(c))
 
 		engineconfig.IterateShards(c, true, func(sc *shardconfig.Config) {
 			defer func() {
@@ -72,6 +76,7 @@ func TestEngineSection(t *testing.T) {
 				require.Equal(t, "tmp/0/blob", blob.Path())
 				require.EqualValues(t, 0644, blob.Perm())
 				require.Equal(t, true, blob.Compress())
+				require.Equal(t, false, blob.Deduplicate())
 				require.Equal(t, []string{"audio/*", "video/*"}, blob.UncompressableContentTypes())
 				require.EqualValues(t, 5, blob.ShallowDepth())
 				require.EqualValues(t, 102400, blob.SmallSizeLimit())
@@ -102,6 +107,7 @@ func TestEngineSection(t *testing.T) {
 				require.Equal(t, "tmp/1/blob", blob.Path())
 				require.EqualValues(t, 0644, blob.Perm())
 				require.Equal(t, false, blob.Compress())
+				require.Equal(t, true, blob.Deduplicate())
 				require.Equal(t, []string(nil), blob.UncompressableContentTypes())
 				require.EqualValues(t, 5, blob.ShallowDepth())
 				require.EqualValues(t, 102400, blob.SmallSizeLimit())
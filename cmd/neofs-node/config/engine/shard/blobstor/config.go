@@ -88,6 +88,16 @@ func (x *Config) Compress() bool {
 	)
 }
 
+// Deduplicate returns value of "deduplicate" config parameter.
+//
+// Returns false if value is not a valid bool.
+func (x *Config) Deduplicate() bool {
+	return config.BoolSafe(
+		(*config.Config)(x),
+		"deduplicate",
+	)
+}
+
 // UncompressableContentTypes returns value of "compress_skip_content_types" config parameter.
 //
 // Returns nil if a value is missing or is invalid.
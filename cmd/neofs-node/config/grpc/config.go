@@ -3,6 +3,7 @@ package grpcconfig
 import (
 	"errors"
 	"strconv"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 )
@@ -78,6 +79,27 @@ func (tls TLSConfig) CertificateFile() string {
 	return v
 }
 
+// ClientCAFile returns value of "client_ca" config parameter.
+//
+// Returns empty string if value is not set, which means
+// that client certificates are not required.
+func (tls TLSConfig) ClientCAFile() string {
+	return config.StringSafe(tls.cfg, "client_ca")
+}
+
+// ReloadInterval returns value of "reload_interval" config parameter.
+//
+// Returns 0 if value is not a positive duration, which means
+// that certificates are not reloaded.
+func (tls TLSConfig) ReloadInterval() time.Duration {
+	v := config.DurationSafe(tls.cfg, "reload_interval")
+	if v > 0 {
+		return v
+	}
+
+	return 0
+}
+
 // UseInsecureCrypto returns true if TLS 1.2 cipher suite should not be restricted.
 func (tls TLSConfig) UseInsecureCrypto() bool {
 	return config.BoolSafe(tls.cfg, "use_insecure_crypto")
@@ -2,6 +2,7 @@ package grpcconfig
 
 import (
 	"testing"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
 	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
@@ -34,6 +35,8 @@ func TestGRPCSection(t *testing.T) {
 				require.NotNil(t, tls)
 				require.Equal(t, "/path/to/cert", tls.CertificateFile())
 				require.Equal(t, "/path/to/key", tls.KeyFile())
+				require.Equal(t, "/path/to/ca", tls.ClientCAFile())
+				require.Equal(t, time.Minute, tls.ReloadInterval())
 				require.False(t, tls.UseInsecureCrypto())
 			case 1:
 				require.Equal(t, "s02.neofs.devenv:8080", sc.Endpoint())
@@ -42,6 +45,8 @@ func TestGRPCSection(t *testing.T) {
 				require.Equal(t, "s03.neofs.devenv:8080", sc.Endpoint())
 				require.NotNil(t, tls)
 				require.True(t, tls.UseInsecureCrypto())
+				require.Empty(t, tls.ClientCAFile())
+				require.Zero(t, tls.ReloadInterval())
 			}
 		})
 	}
@@ -0,0 +1,115 @@
+package tracingconfig
+
+import (
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+)
+
+const (
+	subsection = "tracing"
+
+	// ExporterOTLP is a value of "exporter" config parameter
+	// which selects OTLP/HTTP exporter.
+	ExporterOTLP = "otlp"
+
+	// ExporterFile is a value of "exporter" config parameter
+	// which selects local file exporter.
+	ExporterFile = "file"
+
+	// ExporterDefault is a default span exporter.
+	ExporterDefault = ExporterOTLP
+
+	// EndpointDefault is a default OTLP/HTTP traces endpoint.
+	EndpointDefault = "http://localhost:4318/v1/traces"
+
+	// TimeoutDefault is a default timeout of span export request.
+	TimeoutDefault = 10 * time.Second
+
+	// SamplingRatioDefault is a default fraction of sampled traces.
+	SamplingRatioDefault = 1.0
+
+	// ServiceNameDefault is a default service name of exported spans.
+	ServiceNameDefault = "neofs-node"
+)
+
+// Enabled returns value of "enabled" config parameter
+// from "tracing" section.
+//
+// Returns false if value is not set.
+func Enabled(c *config.Config) bool {
+	return config.BoolSafe(c.Sub(subsection), "enabled")
+}
+
+// Exporter returns value of "exporter" config parameter
+// from "tracing" section.
+//
+// Returns ExporterDefault if value is not set.
+func Exporter(c *config.Config) string {
+	v := config.StringSafe(c.Sub(subsection), "exporter")
+	if v != "" {
+		return v
+	}
+
+	return ExporterDefault
+}
+
+// Endpoint returns value of "endpoint" config parameter
+// from "tracing" section.
+//
+// Returns EndpointDefault if value is not set.
+func Endpoint(c *config.Config) string {
+	v := config.StringSafe(c.Sub(subsection), "endpoint")
+	if v != "" {
+		return v
+	}
+
+	return EndpointDefault
+}
+
+// Path returns value of "path" config parameter
+// from "tracing" section. It is used by file exporter.
+//
+// Returns empty string if value is not set.
+func Path(c *config.Config) string {
+	return config.StringSafe(c.Sub(subsection), "path")
+}
+
+// Timeout returns value of "timeout" config parameter
+// from "tracing" section.
+//
+// Returns TimeoutDefault if value is not positive duration.
+func Timeout(c *config.Config) time.Duration {
+	v := config.DurationSafe(c.Sub(subsection), "timeout")
+	if v > 0 {
+		return v
+	}
+
+	return TimeoutDefault
+}
+
+// SamplingRatio returns value of "sampling_ratio" config parameter
+// from "tracing" section.
+//
+// Returns SamplingRatioDefault if value is not positive.
+func SamplingRatio(c *config.Config) float64 {
+	v := config.FloatSafe(c.Sub(subsection), "sampling_ratio")
+	if v > 0 {
+		return v
+	}
+
+	return SamplingRatioDefault
+}
+
+// ServiceName returns value of "service_name" config parameter
+// from "tracing" section.
+//
+// Returns ServiceNameDefault if value is not set.
+func ServiceName(c *config.Config) string {
+	v := config.StringSafe(c.Sub(subsection), "service_name")
+	if v != "" {
+		return v
+	}
+
+	return ServiceNameDefault
+}
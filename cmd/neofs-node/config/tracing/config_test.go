@@ -0,0 +1,43 @@
+package tracingconfig_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/cmd/neofs-node/config"
+	configtest "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/test"
+	tracingconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tracing"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracingSection(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		empty := configtest.EmptyConfig()
+
+		require.False(t, tracingconfig.Enabled(empty))
+		require.Equal(t, tracingconfig.ExporterDefault, tracingconfig.Exporter(empty))
+		require.Equal(t, tracingconfig.EndpointDefault, tracingconfig.Endpoint(empty))
+		require.Empty(t, tracingconfig.Path(empty))
+		require.Equal(t, tracingconfig.TimeoutDefault, tracingconfig.Timeout(empty))
+		require.Equal(t, tracingconfig.SamplingRatioDefault, tracingconfig.SamplingRatio(empty))
+		require.Equal(t, tracingconfig.ServiceNameDefault, tracingconfig.ServiceName(empty))
+	})
+
+	const path = "../../../../config/example/node"
+
+	var fileConfigTest = func(c *config.Config) {
+		require.True(t, tracingconfig.Enabled(c))
+		require.Equal(t, tracingconfig.ExporterOTLP, tracingconfig.Exporter(c))
+		require.Equal(t, "http://localhost:4318/v1/traces", tracingconfig.Endpoint(c))
+		require.Equal(t, "/path/to/traces.json", tracingconfig.Path(c))
+		require.Equal(t, 5*time.Second, tracingconfig.Timeout(c))
+		require.Equal(t, 0.5, tracingconfig.SamplingRatio(c))
+		require.Equal(t, "neofs-node-s01", tracingconfig.ServiceName(c))
+	}
+
+	configtest.ForEachFileType(path, fileConfigTest)
+
+	t.Run("ENV", func(t *testing.T) {
+		configtest.ForEnvFileType(path, fileConfigTest)
+	})
+}
@@ -41,6 +41,7 @@ func initControlService(c *cfg) {
 			return err
 		}),
 		controlSvc.WithLocalStorage(c.cfgObject.cfgLocalStorage.localStorage),
+		controlSvc.WithDiagnostics(c),
 	)
 
 	lis, err := net.Listen("tcp", endpoint)
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"time"
+
+	rpcclient "github.com/nspcc-dev/neo-go/pkg/rpc/client"
+	morphconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/morph"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+)
+
+const (
+	diagnosticsMorphCategory   = "morph"
+	diagnosticsNetmapCategory  = "netmap"
+	diagnosticsAddressCategory = "address"
+	diagnosticsTimeCategory    = "time"
+
+	// maximum amount of blocks the RPC endpoint can be behind
+	// the highest known one before it is reported.
+	diagnosticsMaxBlockLag = 5
+
+	// maximum difference between local clock and the latest
+	// block timestamp which is not reported.
+	diagnosticsClockTolerance = 5 * time.Second
+
+	// is used if block interval can not be fetched from the chain.
+	diagnosticsDefaultBlockInterval = 15 * time.Second
+)
+
+// diagnosticsCheck is a helper to fill and time the single check.
+type diagnosticsCheck struct {
+	start time.Time
+
+	c *control.DiagnosticCheck
+}
+
+func newDiagnosticsCheck(category, subject string) *diagnosticsCheck {
+	c := new(control.DiagnosticCheck)
+	c.SetCategory(category)
+	c.SetSubject(subject)
+
+	return &diagnosticsCheck{
+		start: time.Now(),
+		c:     c,
+	}
+}
+
+func (x *diagnosticsCheck) finish(st control.DiagnosticStatus, details string) *control.DiagnosticCheck {
+	x.c.SetStatus(st)
+	x.c.SetDetails(details)
+	x.c.SetDuration(uint64(time.Since(x.start).Milliseconds()))
+
+	return x.c
+}
+
+// Diagnose performs self-test of the storage node. It checks
+// that side chain RPC endpoints respond and are synchronized,
+// that the node is present in the network map with the configured
+// attributes, that announced addresses are reachable and that
+// local clock and epoch correspond to the chain ones.
+func (c *cfg) Diagnose(ctx context.Context) []*control.DiagnosticCheck {
+	checks := c.diagnoseMorph(ctx)
+
+	checks = append(checks, c.diagnoseNetmap())
+	checks = append(checks, c.diagnoseAddresses()...)
+	checks = append(checks, c.diagnoseTime()...)
+
+	return checks
+}
+
+func (c *cfg) diagnoseMorph(ctx context.Context) []*control.DiagnosticCheck {
+	endpoints := morphconfig.RPCEndpoint(c.appCfg)
+	dialTimeout := morphconfig.DialTimeout(c.appCfg)
+
+	type endpointState struct {
+		check  *diagnosticsCheck
+		height uint32
+		err    error
+	}
+
+	states := make([]endpointState, len(endpoints))
+
+	var maxHeight uint32
+
+	for i := range endpoints {
+		states[i].check = newDiagnosticsCheck(diagnosticsMorphCategory, endpoints[i])
+		states[i].height, states[i].err = endpointHeight(ctx, endpoints[i], dialTimeout)
+
+		if states[i].err == nil && states[i].height > maxHeight {
+			maxHeight = states[i].height
+		}
+	}
+
+	checks := make([]*control.DiagnosticCheck, 0, len(states))
+
+	for i := range states {
+		var (
+			st      control.DiagnosticStatus
+			details string
+		)
+
+		switch lag := maxHeight - states[i].height; {
+		case states[i].err != nil:
+			st = control.DiagnosticStatus_FAILED
+			details = states[i].err.Error()
+		case lag > diagnosticsMaxBlockLag:
+			st = control.DiagnosticStatus_WARNING
+			details = fmt.Sprintf("height %d is %d blocks behind", states[i].height, lag)
+		default:
+			st = control.DiagnosticStatus_PASSED
+			details = fmt.Sprintf("height %d", states[i].height)
+		}
+
+		checks = append(checks, states[i].check.finish(st, details))
+	}
+
+	return checks
+}
+
+// endpointHeight returns the block count of the chain
+// served by the particular RPC endpoint.
+func endpointHeight(ctx context.Context, endpoint string, dialTimeout time.Duration) (uint32, error) {
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	cli, err := rpcclient.New(ctx, endpoint, rpcclient.Options{
+		DialTimeout: dialTimeout,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("could not create RPC client: %w", err)
+	}
+
+	height, err := cli.GetBlockCount()
+	if err != nil {
+		return 0, fmt.Errorf("could not get block count: %w", err)
+	}
+
+	return height, nil
+}
+
+func (c *cfg) diagnoseNetmap() *control.DiagnosticCheck {
+	check := newDiagnosticsCheck(diagnosticsNetmapCategory, hex.EncodeToString(c.key.PublicKey().Bytes()))
+
+	epoch, err := c.cfgNetmap.wrapper.Epoch()
+	if err != nil {
+		return check.finish(control.DiagnosticStatus_FAILED,
+			fmt.Sprintf("could not get current epoch: %v", err))
+	}
+
+	ni, err := c.netmapLocalNodeState(epoch)
+	if err != nil {
+		return check.finish(control.DiagnosticStatus_FAILED,
+			fmt.Sprintf("could not get network map: %v", err))
+	}
+
+	if ni == nil {
+		if !c.needBootstrap() || c.cfgNetmap.reBoostrapTurnedOff.Load() {
+			return check.finish(control.DiagnosticStatus_WARNING,
+				"node is not in the network map, bootstrap is turned off")
+		}
+
+		return check.finish(control.DiagnosticStatus_FAILED,
+			fmt.Sprintf("node is not in the network map of epoch %d", epoch))
+	}
+
+	attrs := make(map[string]string, len(ni.Attributes()))
+
+	for _, a := range ni.Attributes() {
+		attrs[a.Key()] = a.Value()
+	}
+
+	// Inner Ring can add attributes to the announced ones,
+	// so only configured attributes are compared
+	for _, a := range c.cfgNodeInfo.localInfo.Attributes() {
+		if val, ok := attrs[a.Key()]; !ok || val != a.Value() {
+			return check.finish(control.DiagnosticStatus_WARNING,
+				fmt.Sprintf("attribute %s differs from the configured one", a.Key()))
+		}
+	}
+
+	return check.finish(control.DiagnosticStatus_PASSED,
+		fmt.Sprintf("node is in the network map of epoch %d", epoch))
+}
+
+func (c *cfg) diagnoseAddresses() []*control.DiagnosticCheck {
+	dialTimeout := morphconfig.DialTimeout(c.appCfg)
+
+	checks := make([]*control.DiagnosticCheck, 0, c.localAddr.Len())
+
+	c.localAddr.IterateAddresses(func(addr network.Address) bool {
+		check := newDiagnosticsCheck(diagnosticsAddressCategory, addr.String())
+
+		conn, err := net.DialTimeout("tcp", addr.HostAddr(), dialTimeout)
+		if err != nil {
+			checks = append(checks, check.finish(control.DiagnosticStatus_FAILED, err.Error()))
+			return false
+		}
+
+		_ = conn.Close()
+
+		checks = append(checks, check.finish(control.DiagnosticStatus_PASSED, ""))
+
+		return false
+	})
+
+	return checks
+}
+
+func (c *cfg) diagnoseTime() []*control.DiagnosticCheck {
+	return []*control.DiagnosticCheck{
+		c.diagnoseClock(),
+		c.diagnoseEpoch(),
+	}
+}
+
+func (c *cfg) diagnoseClock() *control.DiagnosticCheck {
+	check := newDiagnosticsCheck(diagnosticsTimeCategory, "clock")
+
+	height, err := c.cfgMorph.client.BlockCount()
+	if err != nil {
+		return check.finish(control.DiagnosticStatus_FAILED,
+			fmt.Sprintf("could not get block count: %v", err))
+	}
+
+	b, err := c.cfgMorph.client.Block(height - 1)
+	if err != nil {
+		return check.finish(control.DiagnosticStatus_FAILED,
+			fmt.Sprintf("could not get latest block: %v", err))
+	}
+
+	interval := diagnosticsDefaultBlockInterval
+
+	if ms, err := c.cfgMorph.client.MsPerBlock(); err == nil && ms > 0 {
+		interval = time.Duration(ms) * time.Millisecond
+	}
+
+	// latest block is expected to be produced not later than
+	// one block interval ago
+	age := time.Since(time.Unix(0, int64(b.Timestamp)*int64(time.Millisecond)))
+
+	switch {
+	case age < -diagnosticsClockTolerance:
+		return check.finish(control.DiagnosticStatus_FAILED,
+			fmt.Sprintf("local clock is %s behind the latest block", -age))
+	case age > interval+diagnosticsClockTolerance:
+		return check.finish(control.DiagnosticStatus_WARNING,
+			fmt.Sprintf("latest block is %s old, chain is stalled or local clock is ahead", age))
+	default:
+		return check.finish(control.DiagnosticStatus_PASSED, "")
+	}
+}
+
+func (c *cfg) diagnoseEpoch() *control.DiagnosticCheck {
+	check := newDiagnosticsCheck(diagnosticsTimeCategory, "epoch")
+
+	chainEpoch, err := c.cfgNetmap.wrapper.Epoch()
+	if err != nil {
+		return check.finish(control.DiagnosticStatus_FAILED,
+			fmt.Sprintf("could not get current epoch: %v", err))
+	}
+
+	// new epoch notification can still be in process,
+	// so the mismatch is not considered as a failure
+	if localEpoch := c.cfgNetmap.state.CurrentEpoch(); localEpoch != chainEpoch {
+		return check.finish(control.DiagnosticStatus_WARNING,
+			fmt.Sprintf("local epoch %d differs from the chain one %d", localEpoch, chainEpoch))
+	}
+
+	return check.finish(control.DiagnosticStatus_PASSED, fmt.Sprintf("epoch %d", chainEpoch))
+}
@@ -1,12 +1,15 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net"
 
 	grpcconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/grpc"
+	"github.com/nspcc-dev/neofs-node/pkg/network/tlsutil"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -23,11 +26,28 @@ func initGRPC(c *cfg) {
 			grpc.MaxSendMsgSize(maxMsgSize),
 		}
 
+		if tracing.GlobalTracer() != nil {
+			serverOpts = append(serverOpts,
+				grpc.UnaryInterceptor(tracing.UnaryServerInterceptor()),
+				grpc.StreamInterceptor(tracing.StreamServerInterceptor()),
+			)
+		}
+
 		tlsCfg := sc.TLS()
 
 		if tlsCfg != nil {
-			cert, err := tls.LoadX509KeyPair(tlsCfg.CertificateFile(), tlsCfg.KeyFile())
-			fatalOnErrDetails("could not read certificate from file", err)
+			reloader, err := tlsutil.NewCertificateReloader(tlsutil.Prm{
+				CertificateFile: tlsCfg.CertificateFile(),
+				KeyFile:         tlsCfg.KeyFile(),
+				ClientCAFile:    tlsCfg.ClientCAFile(),
+			}, c.log)
+			fatalOnErrDetails("could not read TLS certificates", err)
+
+			if interval := tlsCfg.ReloadInterval(); interval > 0 {
+				c.workers = append(c.workers, newWorkerFromFunc(func(ctx context.Context) {
+					reloader.Watch(ctx, interval)
+				}))
+			}
 
 			var cipherSuites []uint16
 			if !tlsCfg.UseInsecureCrypto() {
@@ -44,11 +64,10 @@ func initGRPC(c *cfg) {
 					tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
 				}
 			}
-			creds := credentials.NewTLS(&tls.Config{
+			creds := credentials.NewTLS(reloader.Config(&tls.Config{
 				MinVersion:   tls.VersionTLS12,
 				CipherSuites: cipherSuites,
-				Certificates: []tls.Certificate{cert},
-			})
+			}))
 
 			serverOpts = append(serverOpts, grpc.Creds(creds))
 		}
@@ -69,6 +69,7 @@ func main() {
 func initApp(c *cfg) {
 	c.ctx, c.ctxCancel = signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
 
+	initTracing(c)
 	initGRPC(c)
 
 	initNetmapService(c)
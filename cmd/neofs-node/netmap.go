@@ -61,7 +61,11 @@ func (s *networkState) setNodeInfo(ni *netmapSDK.NodeInfo) {
 	default:
 		ctrlNetSt = control.NetmapStatus_STATUS_UNDEFINED
 	case netmapSDK.NodeStateOnline:
-		ctrlNetSt = control.NetmapStatus_ONLINE
+		if netmap.IsMaintenance(ni) {
+			ctrlNetSt = control.NetmapStatus_MAINTENANCE
+		} else {
+			ctrlNetSt = control.NetmapStatus_ONLINE
+		}
 	case netmapSDK.NodeStateOffline:
 		ctrlNetSt = control.NetmapStatus_OFFLINE
 	}
@@ -289,8 +293,25 @@ var errRelayBootstrap = errors.New("setting netmap status is forbidden in relay
 var errNodeMaintenance = errors.New("node is in maintenance mode")
 
 func (c *cfg) SetNetmapStatus(st control.NetmapStatus) error {
+	if !c.needBootstrap() {
+		return errRelayBootstrap
+	}
+
 	if st == control.NetmapStatus_MAINTENANCE {
-		return c.cfgObject.cfgLocalStorage.localStorage.BlockExecution(errNodeMaintenance)
+		err := c.cfgObject.cfgLocalStorage.localStorage.BlockExecution(errNodeMaintenance)
+		if err != nil {
+			return err
+		}
+
+		// announce the maintenance to the network, the mark is kept
+		// in the local node info and re-sent on each re-bootstrap;
+		// repeated requests must not prolong the maintenance
+		if !netmap.IsMaintenance(&c.cfgNodeInfo.localInfo) {
+			netmap.SetMaintenance(&c.cfgNodeInfo.localInfo, c.cfgNetmap.state.CurrentEpoch())
+		}
+
+		c.cfgNetmap.reBoostrapTurnedOff.Store(false)
+		return c.bootstrap()
 	}
 
 	err := c.cfgObject.cfgLocalStorage.localStorage.ResumeExecution()
@@ -300,9 +321,7 @@ func (c *cfg) SetNetmapStatus(st control.NetmapStatus) error {
 		)
 	}
 
-	if !c.needBootstrap() {
-		return errRelayBootstrap
-	}
+	netmap.ResetMaintenance(&c.cfgNodeInfo.localInfo)
 
 	if st == control.NetmapStatus_ONLINE {
 		c.cfgNetmap.reBoostrapTurnedOff.Store(false)
@@ -21,6 +21,7 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/acl"
 	deletesvc "github.com/nspcc-dev/neofs-node/pkg/services/object/delete"
 	deletesvcV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/delete/v2"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	getsvcV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/get/v2"
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
@@ -233,6 +234,13 @@ func initObjectService(c *cfg) {
 		policer.WithRemoteHeader(
 			headsvc.NewRemoteHeader(keyStorage, clientConstructor),
 		),
+		policer.WithErasureStorage(
+			erasure.NewRemoteStorage(keyStorage, clientConstructor),
+		),
+		policer.WithKeyStorage(keyStorage),
+		policer.WithPutTimeout(
+			replicatorconfig.PutTimeout(c.appCfg),
+		),
 		policer.WithNetmapKeys(c),
 		policer.WithHeadTimeout(
 			policerconfig.HeadTimeout(c.appCfg),
@@ -305,6 +313,7 @@ func initObjectService(c *cfg) {
 		),
 		getsvc.WithNetMapSource(c.cfgNetmap.wrapper),
 		getsvc.WithKeyStorage(keyStorage),
+		getsvc.WithContainerSource(c.cfgObject.cnrSource),
 	)
 
 	sGetV2 := getsvcV2.NewService(
@@ -317,6 +326,7 @@ func initObjectService(c *cfg) {
 		deletesvc.WithHeadService(sGet),
 		deletesvc.WithSearchService(sSearch),
 		deletesvc.WithPutService(sPut),
+		deletesvc.WithContainerSource(c.cfgObject.cnrSource),
 		deletesvc.WithNetworkInfo(&delNetInfo{
 			State:      c.cfgNetmap.state,
 			tsLifetime: 5,
@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	tracingconfig "github.com/nspcc-dev/neofs-node/cmd/neofs-node/config/tracing"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+	"go.uber.org/zap"
+)
+
+func initTracing(c *cfg) {
+	if !tracingconfig.Enabled(c.appCfg) {
+		return
+	}
+
+	var (
+		exp     tracing.Exporter
+		err     error
+		service = tracingconfig.ServiceName(c.appCfg)
+	)
+
+	switch e := tracingconfig.Exporter(c.appCfg); e {
+	case tracingconfig.ExporterOTLP:
+		exp = tracing.NewOTLPExporter(
+			tracingconfig.Endpoint(c.appCfg),
+			service,
+			tracingconfig.Timeout(c.appCfg),
+		)
+	case tracingconfig.ExporterFile:
+		exp, err = tracing.NewFileExporter(tracingconfig.Path(c.appCfg), service)
+		fatalOnErrDetails("could not init tracing file exporter", err)
+	default:
+		fatalOnErr(fmt.Errorf("unknown tracing exporter: %s", e))
+	}
+
+	tracer := tracing.NewTracer(exp,
+		tracing.WithLogger(c.log),
+		tracing.WithSamplingRatio(tracingconfig.SamplingRatio(c.appCfg)),
+	)
+
+	tracer.Start()
+	tracing.SetGlobalTracer(tracer)
+
+	c.onShutdown(func() {
+		c.log.Debug("shutting down tracing exporter")
+
+		tracing.SetGlobalTracer(nil)
+
+		ctx, cancel := context.WithTimeout(context.Background(), tracingconfig.Timeout(c.appCfg))
+		defer cancel()
+
+		if err := tracer.Stop(ctx); err != nil {
+			c.log.Debug("could not shutdown tracing exporter",
+				zap.String("error", err.Error()),
+			)
+		}
+
+		c.log.Debug("tracing exporter has been stopped")
+	})
+}
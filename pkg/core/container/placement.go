@@ -0,0 +1,59 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
+// ErrUnsatisfiablePlacement is returned when the placement policy
+// of the container can not be satisfied by the network map.
+var ErrUnsatisfiablePlacement = errors.New("placement policy can not be satisfied")
+
+// CheckPlacement checks that the network map has enough nodes to store
+// all the replicas of the container objects according to its placement
+// policy. Nodes in maintenance are not taken into account.
+func CheckPlacement(cnr *container.Container, nm *netmap.Netmap) error {
+	policy := cnr.PlacementPolicy()
+	if policy == nil {
+		return errors.New("missing placement policy")
+	}
+
+	infos := make([]netmap.NodeInfo, 0, len(nm.Nodes))
+
+	for i := range nm.Nodes {
+		if !netmapcore.IsMaintenance(nm.Nodes[i].NodeInfo) {
+			infos = append(infos, *nm.Nodes[i].NodeInfo)
+		}
+	}
+
+	active, err := netmap.NewNetmap(netmap.NodesFromInfo(infos))
+	if err != nil {
+		return fmt.Errorf("could not build network map: %w", err)
+	}
+
+	cnrNodes, err := active.GetContainerNodes(policy, container.CalculateID(cnr).ToV2().GetValue())
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnsatisfiablePlacement, err)
+	}
+
+	rs := policy.Replicas()
+	vs := cnrNodes.Replicas()
+
+	for i := range rs {
+		var n int
+		if i < len(vs) {
+			n = len(vs[i])
+		}
+
+		if uint32(n) < rs[i].Count() {
+			return fmt.Errorf("%w: replica #%d requires %d nodes, %d available",
+				ErrUnsatisfiablePlacement, i, rs[i].Count(), n)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package container
+
+import (
+	"strconv"
+	"testing"
+
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/policy"
+	"github.com/stretchr/testify/require"
+)
+
+func testNetmap(t *testing.T, countries ...string) *netmap.Netmap {
+	infos := make([]netmap.NodeInfo, len(countries))
+
+	for i := range countries {
+		a := netmap.NewNodeAttribute()
+		a.SetKey("Country")
+		a.SetValue(countries[i])
+
+		infos[i].SetPublicKey([]byte(strconv.Itoa(i)))
+		infos[i].SetAttributes(a)
+	}
+
+	nm, err := netmap.NewNetmap(netmap.NodesFromInfo(infos))
+	require.NoError(t, err)
+
+	return nm
+}
+
+func testContainer(t *testing.T, s string) *container.Container {
+	p, err := policy.Parse(s)
+	require.NoError(t, err)
+
+	return container.New(container.WithPolicy(p))
+}
+
+func TestCheckPlacement(t *testing.T) {
+	nm := testNetmap(t, "RU", "RU", "SE", "DE")
+
+	t.Run("satisfiable", func(t *testing.T) {
+		require.NoError(t, CheckPlacement(testContainer(t, "REP 3"), nm))
+		require.NoError(t, CheckPlacement(testContainer(t,
+			`REP 2 IN X
+			SELECT 2 FROM RU AS X
+			FILTER Country EQ RU AS RU`), nm))
+	})
+
+	t.Run("not enough nodes", func(t *testing.T) {
+		err := CheckPlacement(testContainer(t, "REP 5"), nm)
+		require.ErrorIs(t, err, ErrUnsatisfiablePlacement)
+	})
+
+	t.Run("not enough filtered nodes", func(t *testing.T) {
+		err := CheckPlacement(testContainer(t,
+			`REP 3 IN X
+			SELECT 3 FROM SE AS X
+			FILTER Country EQ SE AS SE`), nm)
+		require.ErrorIs(t, err, ErrUnsatisfiablePlacement)
+	})
+
+	t.Run("maintenance", func(t *testing.T) {
+		netmapcore.SetMaintenance(nm.Nodes[0].NodeInfo, 1)
+
+		err := CheckPlacement(testContainer(t,
+			`REP 2 IN X
+			SELECT 2 FROM RU AS X
+			FILTER Country EQ RU AS RU`), nm)
+		require.ErrorIs(t, err, ErrUnsatisfiablePlacement)
+	})
+}
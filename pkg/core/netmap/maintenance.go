@@ -0,0 +1,78 @@
+package netmap
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
+// AttrMaintenance is a reserved node attribute which marks storage node
+// in maintenance mode. Value is a decimal number of the epoch in which
+// the maintenance has been started.
+//
+// Nodes in maintenance are skipped by other nodes for object reads and
+// writes, but the object replicas stored on them are considered present
+// by the policers. Inner Ring removes the node from the network map if
+// it stays in maintenance longer than the network-wide limit.
+const AttrMaintenance = "__NEOFS__MAINTENANCE"
+
+// MaintenanceEpoch returns the number of the epoch in which the node
+// has entered maintenance mode. Second value is false if the node
+// is not in maintenance.
+func MaintenanceEpoch(ni *netmap.NodeInfo) (uint64, bool, error) {
+	for _, a := range ni.Attributes() {
+		if a.Key() != AttrMaintenance {
+			continue
+		}
+
+		epoch, err := strconv.ParseUint(a.Value(), 10, 64)
+		if err != nil {
+			return 0, true, fmt.Errorf("invalid value of %s attribute: %w", AttrMaintenance, err)
+		}
+
+		return epoch, true, nil
+	}
+
+	return 0, false, nil
+}
+
+// IsMaintenance checks if the node is in maintenance mode.
+//
+// Nodes with invalid attribute value are considered to be in maintenance.
+func IsMaintenance(ni *netmap.NodeInfo) bool {
+	_, ok, _ := MaintenanceEpoch(ni)
+	return ok
+}
+
+// MaintenanceExpired checks if the maintenance started in the specified
+// epoch lasts longer than duration epochs in the current epoch.
+func MaintenanceExpired(start, current, duration uint64) bool {
+	return current > start && current-start > duration
+}
+
+// SetMaintenance marks the node as being in maintenance since the specified epoch.
+func SetMaintenance(ni *netmap.NodeInfo, epoch uint64) {
+	a := netmap.NewNodeAttribute()
+	a.SetKey(AttrMaintenance)
+	a.SetValue(strconv.FormatUint(epoch, 10))
+
+	ni.SetAttributes(append(withoutMaintenance(ni.Attributes()), a)...)
+}
+
+// ResetMaintenance removes the maintenance mark from the node.
+func ResetMaintenance(ni *netmap.NodeInfo) {
+	ni.SetAttributes(withoutMaintenance(ni.Attributes())...)
+}
+
+func withoutMaintenance(attrs []*netmap.NodeAttribute) []*netmap.NodeAttribute {
+	res := make([]*netmap.NodeAttribute, 0, len(attrs))
+
+	for i := range attrs {
+		if attrs[i].Key() != AttrMaintenance {
+			res = append(res, attrs[i])
+		}
+	}
+
+	return res
+}
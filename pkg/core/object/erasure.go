@@ -0,0 +1,85 @@
+package object
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// Attributes of the erasure code parts.
+const (
+	// AttributeECParent contains identifier of the erasure-coded object.
+	AttributeECParent = "__NEOFS__EC_PARENT"
+
+	// AttributeECIndex contains index of the part, data parts go first.
+	AttributeECIndex = "__NEOFS__EC_INDEX"
+
+	// AttributeECScheme contains erasure code scheme in "<data>/<parity>" format.
+	AttributeECScheme = "__NEOFS__EC_SCHEME"
+
+	// AttributeECHeader contains base64-encoded header of the erasure-coded object.
+	AttributeECHeader = "__NEOFS__EC_HEADER"
+)
+
+// IsErasureCodeAttribute checks if the attribute key is reserved
+// for the erasure code parts.
+func IsErasureCodeAttribute(key string) bool {
+	switch key {
+	case AttributeECParent, AttributeECIndex, AttributeECScheme, AttributeECHeader:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsErasureCodePart checks if the object is a part of the erasure-coded object.
+func IsErasureCodePart(obj *Object) bool {
+	for _, a := range obj.Attributes() {
+		if a.Key() == AttributeECParent {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ErasureCodeParent decodes the header of the erasure-coded object from
+// the part attributes. The header is checked to match the parent identifier,
+// its signature is not verified.
+//
+// Returns nil, nil if the object is not a part of the erasure-coded object.
+func ErasureCodeParent(obj *Object) (*Object, error) {
+	var parent, header string
+
+	for _, a := range obj.Attributes() {
+		switch a.Key() {
+		case AttributeECParent:
+			parent = a.Value()
+		case AttributeECHeader:
+			header = a.Value()
+		}
+	}
+
+	if parent == "" {
+		return nil, nil
+	} else if header == "" {
+		return nil, errors.New("missing parent header")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(header)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode parent header: %w", err)
+	}
+
+	hdr := New()
+
+	if err := hdr.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("could not unmarshal parent header: %w", err)
+	}
+
+	if hdr.ID() == nil || hdr.ID().String() != parent {
+		return nil, errors.New("parent header does not match parent ID")
+	}
+
+	return hdr, nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	"github.com/nspcc-dev/neofs-sdk-go/owner"
@@ -29,6 +30,8 @@ type cfg struct {
 	deleteHandler DeleteHandler
 
 	netState netmap.State
+
+	cnrNodes ContainerNodes
 }
 
 // DeleteHandler is an interface of delete queue processor.
@@ -36,6 +39,13 @@ type DeleteHandler interface {
 	DeleteObjects(*addressSDK.Address, ...*addressSDK.Address)
 }
 
+// ContainerNodes is an interface of the container nodes checker.
+type ContainerNodes interface {
+	// IsContainerNode checks if the node with the public key
+	// stores the objects of the container.
+	IsContainerNode(key []byte, cnr *cid.ID) (bool, error)
+}
+
 var errNilObject = errors.New("object is nil")
 
 var errNilID = errors.New("missing identifier")
@@ -46,6 +56,8 @@ var errNoExpirationEpoch = errors.New("missing expiration epoch attribute")
 
 var errTombstoneExpiration = errors.New("tombstone body and header contain different expiration values")
 
+var errReservedAttribute = errors.New("reserved erasure code attribute in regular object")
+
 func defaultCfg() *cfg {
 	return new(cfg)
 }
@@ -108,6 +120,10 @@ func (v *FormatValidator) validateSignatureKey(obj *Object) error {
 	token := obj.SessionToken()
 	key := obj.Signature().Key()
 
+	if IsErasureCodePart(obj) {
+		return v.checkErasureCodeOwner(obj)
+	}
+
 	if token == nil || !bytes.Equal(token.SessionKey(), key) {
 		return v.checkOwnerKey(obj.OwnerID(), obj.Signature().Key())
 	}
@@ -117,6 +133,40 @@ func (v *FormatValidator) validateSignatureKey(obj *Object) error {
 	return nil
 }
 
+// checkErasureCodeOwner checks that the part of the erasure-coded object
+// is owned by the owner of the object. Parts are signed by the container
+// nodes on behalf of the object owner, so the signature key must belong
+// to the container node and the parent header must be signed by the owner.
+func (v *FormatValidator) checkErasureCodeOwner(obj *Object) error {
+	parent, err := ErasureCodeParent(obj)
+	if err != nil {
+		return err
+	}
+
+	if err := object.CheckHeaderVerificationFields(parent.SDK()); err != nil {
+		return fmt.Errorf("(%T) invalid erasure code parent header: %w", v, err)
+	}
+
+	if !parent.ContainerID().Equal(obj.ContainerID()) {
+		return fmt.Errorf("(%T) erasure code part container differs from the object container", v)
+	} else if !parent.OwnerID().Equal(obj.OwnerID()) {
+		return fmt.Errorf("(%T) erasure code part owner differs from the object owner", v)
+	}
+
+	if v.cnrNodes == nil {
+		return fmt.Errorf("(%T) erasure code parts are not accepted", v)
+	}
+
+	ok, err := v.cnrNodes.IsContainerNode(obj.Signature().Key(), obj.ContainerID())
+	if err != nil {
+		return fmt.Errorf("(%T) could not check container node: %w", v, err)
+	} else if !ok {
+		return fmt.Errorf("(%T) erasure code part is not signed by the container node", v)
+	}
+
+	return nil
+}
+
 func (v *FormatValidator) checkOwnerKey(id *owner.ID, key []byte) error {
 	pub, err := keys.NewPublicKeyFromBytes(key, elliptic.P256())
 	if err != nil {
@@ -254,6 +304,15 @@ func (v *FormatValidator) checkAttributes(obj *Object) error {
 		mUnique[key] = struct{}{}
 	}
 
+	// parts are checked to be signed by the container nodes
+	if _, ok := mUnique[AttributeECParent]; !ok {
+		for key := range mUnique {
+			if IsErasureCodeAttribute(key) {
+				return errReservedAttribute
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -275,6 +334,14 @@ func WithNetState(netState netmap.State) FormatValidatorOption {
 	}
 }
 
+// WithContainerNodes returns option to set container nodes checker.
+// Erasure code parts are not accepted if the checker is not set.
+func WithContainerNodes(v ContainerNodes) FormatValidatorOption {
+	return func(c *cfg) {
+		c.cnrNodes = v
+	}
+}
+
 // WithDeleteHandler returns option to set delete queue processor.
 func WithDeleteHandler(v DeleteHandler) FormatValidatorOption {
 	return func(c *cfg) {
@@ -4,12 +4,14 @@ import (
 	"crypto/ecdsa"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"errors"
 	"strconv"
 	"testing"
 
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -51,13 +53,23 @@ func (s testNetState) CurrentEpoch() uint64 {
 	return s.epoch
 }
 
+type testContainerNodes map[string]struct{}
+
+func (x testContainerNodes) IsContainerNode(key []byte, _ *cid.ID) (bool, error) {
+	_, ok := x[string(key)]
+	return ok, nil
+}
+
 func TestFormatValidator_Validate(t *testing.T) {
 	const curEpoch = 13
 
+	cnrNodes := make(testContainerNodes)
+
 	v := NewFormatValidator(
 		WithNetState(testNetState{
 			epoch: curEpoch,
 		}),
+		WithContainerNodes(cnrNodes),
 	)
 
 	ownerKey, err := keys.NewPrivateKey()
@@ -112,6 +124,79 @@ func TestFormatValidator_Validate(t *testing.T) {
 		require.NoError(t, v.Validate(obj.Object()))
 	})
 
+	t.Run("erasure code part", func(t *testing.T) {
+		parent := blankValidObject(&ownerKey.PrivateKey)
+
+		require.NoError(t, object.SetIDWithSignature(&ownerKey.PrivateKey, parent.SDK()))
+
+		hdr, err := parent.Marshal()
+		require.NoError(t, err)
+
+		nodeKey, err := keys.NewPrivateKey()
+		require.NoError(t, err)
+
+		newPart := func(ownerID *owner.ID, hdr []byte) *RawObject {
+			a1 := object.NewAttribute()
+			a1.SetKey(AttributeECParent)
+			a1.SetValue(parent.ID().String())
+
+			a2 := object.NewAttribute()
+			a2.SetKey(AttributeECHeader)
+			a2.SetValue(base64.StdEncoding.EncodeToString(hdr))
+
+			part := NewRaw()
+			part.SetContainerID(parent.ContainerID())
+			part.SetOwnerID(ownerID)
+			part.SetAttributes(a1, a2)
+
+			require.NoError(t, object.SetIDWithSignature(&nodeKey.PrivateKey, part.SDK()))
+
+			return part
+		}
+
+		// signer is not a container node
+		require.Error(t, v.Validate(newPart(parent.OwnerID(), hdr).Object()))
+
+		cnrNodes[string(nodeKey.PublicKey().Bytes())] = struct{}{}
+
+		// signed by the node on behalf of the object owner
+		require.NoError(t, v.Validate(newPart(parent.OwnerID(), hdr).Object()))
+
+		// parts are not accepted without container nodes checker
+		require.Error(t, NewFormatValidator().Validate(newPart(parent.OwnerID(), hdr).Object()))
+
+		nodeID := owner.NewIDFromPublicKey((*ecdsa.PublicKey)(nodeKey.PublicKey()))
+		require.Error(t, v.Validate(newPart(nodeID, hdr).Object()))
+
+		// parent header is forged by the node
+		forged := blankValidObject(&ownerKey.PrivateKey)
+		forged.SetContainerID(parent.ContainerID())
+		forged.SetID(parent.ID())
+		forged.SetSignature(parent.Signature())
+		forged.SetCreationEpoch(curEpoch)
+
+		forgedHdr, err := forged.Marshal()
+		require.NoError(t, err)
+
+		require.Error(t, v.Validate(newPart(parent.OwnerID(), forgedHdr).Object()))
+	})
+
+	t.Run("reserved attribute", func(t *testing.T) {
+		for _, key := range []string{AttributeECIndex, AttributeECScheme, AttributeECHeader} {
+			obj := blankValidObject(&ownerKey.PrivateKey)
+
+			a := object.NewAttribute()
+			a.SetKey(key)
+			a.SetValue("0")
+
+			obj.SetAttributes(a)
+
+			require.NoError(t, object.SetIDWithSignature(&ownerKey.PrivateKey, obj.SDK()))
+
+			require.ErrorIs(t, v.Validate(obj.Object()), errReservedAttribute, key)
+		}
+	})
+
 	t.Run("tombstone content", func(t *testing.T) {
 		obj := NewRaw()
 		obj.SetType(object.TypeTombstone)
@@ -13,10 +13,11 @@ type (
 		ListenerNotaryParsers() []event.NotaryParserInfo
 		ListenerNotaryHandlers() []event.NotaryHandlerInfo
 		TimersHandlers() []event.NotificationHandlerInfo
+		PoolState() (running, capacity int)
 	}
 )
 
-func connectListenerWithProcessor(l event.Listener, p ContractProcessor) {
+func connectListenerWithProcessor(l event.Listener, p ContractProcessor, nr *notaryRequests) {
 	// register notification parsers
 	for _, parser := range p.ListenerNotificationParsers() {
 		l.SetNotificationParser(parser)
@@ -34,18 +35,27 @@ func connectListenerWithProcessor(l event.Listener, p ContractProcessor) {
 
 	// register notary handlers
 	for _, notaryHandler := range p.ListenerNotaryHandlers() {
+		notaryHandler.SetHandler(nr.trackHandler(
+			notaryHandler.ScriptHash(),
+			notaryHandler.RequestType(),
+			notaryHandler.Handler(),
+		))
 		l.RegisterNotaryHandler(notaryHandler)
 	}
 }
 
-// bindMorphProcessor connects morph chain listener handlers.
-func bindMorphProcessor(proc ContractProcessor, s *Server) error {
-	connectListenerWithProcessor(s.morphListener, proc)
+// bindMorphProcessor connects morph chain listener handlers and
+// registers the processor under the name.
+func bindMorphProcessor(name string, proc ContractProcessor, s *Server) error {
+	s.registerProcessor(name, proc, false)
+	connectListenerWithProcessor(s.morphListener, proc, s.sideNotaryRequests)
 	return nil
 }
 
-// bindMainnetProcessor connects mainnet chain listener handlers.
-func bindMainnetProcessor(proc ContractProcessor, s *Server) error {
-	connectListenerWithProcessor(s.mainnetListener, proc)
+// bindMainnetProcessor connects mainnet chain listener handlers and
+// registers the processor under the name.
+func bindMainnetProcessor(name string, proc ContractProcessor, s *Server) error {
+	s.registerProcessor(name, proc, false)
+	connectListenerWithProcessor(s.mainnetListener, proc, s.mainNotaryRequests)
 	return nil
 }
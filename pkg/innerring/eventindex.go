@@ -0,0 +1,84 @@
+package innerring
+
+import (
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/eventindex"
+	balanceEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/balance"
+	containerEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/container"
+	neofsEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/neofs"
+	netmapEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/netmap"
+	"github.com/spf13/viper"
+)
+
+// initEventIndex creates local index of contract notifications and
+// attaches it to the chain listeners. Returns nil if the index is disabled.
+func (s *Server) initEventIndex(cfg *viper.Viper) (*eventindex.Index, error) {
+	if !cfg.GetBool("event_index.enabled") {
+		return nil, nil
+	}
+
+	idx, err := eventindex.New(&eventindex.Params{
+		Log:  s.log,
+		Path: cfg.GetString("event_index.path"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.registerCloser(idx.Close)
+
+	sideParsers := []eventindex.Parser{
+		{Contract: s.contracts.netmap, Type: "NewEpoch", Parse: netmapEvent.ParseNewEpoch},
+		{Contract: s.contracts.netmap, Type: "AddPeer", Parse: netmapEvent.ParseAddPeer},
+		{Contract: s.contracts.netmap, Type: "UpdateState", Parse: netmapEvent.ParseUpdatePeer},
+		{Contract: s.contracts.container, Type: "containerPut", Parse: containerEvent.ParsePut},
+		{Contract: s.contracts.container, Type: "containerDelete", Parse: containerEvent.ParseDelete},
+		{Contract: s.contracts.container, Type: "setEACL", Parse: containerEvent.ParseSetEACL},
+		{Contract: s.contracts.balance, Type: "Lock", Parse: balanceEvent.ParseLock},
+	}
+
+	// in notary-enabled side chain contracts do not notify about the
+	// requested operations, so the executed requests are indexed instead
+	var sideCallParsers []eventindex.CallParser
+
+	if !s.sideNotaryConfig.disabled {
+		sideCallParsers = []eventindex.CallParser{
+			{Contract: s.contracts.netmap, Method: netmapEvent.AddPeerNotaryEvent, Parse: netmapEvent.ParseAddPeerNotary},
+			{Contract: s.contracts.netmap, Method: netmapEvent.UpdateStateNotaryEvent, Parse: netmapEvent.ParseUpdatePeerNotary},
+			{Contract: s.contracts.container, Method: containerEvent.PutNotaryEvent, Parse: containerEvent.ParsePutNotary},
+			{Contract: s.contracts.container, Method: containerEvent.PutNamedNotaryEvent, Parse: containerEvent.ParsePutNamedNotary},
+			{Contract: s.contracts.container, Method: containerEvent.DeleteNotaryEvent, Parse: containerEvent.ParseDeleteNotary},
+			{Contract: s.contracts.container, Method: containerEvent.SetEACLNotaryEvent, Parse: containerEvent.ParseSetEACLNotary},
+		}
+	}
+
+	s.morphListener.RegisterBlockHandler(idx.AddChain(eventindex.ChainPrm{
+		Name:        "side",
+		Client:      s.morphClient,
+		From:        cfg.GetUint32("event_index.backfill.side_chain"),
+		TrackEpoch:  true,
+		Parsers:     sideParsers,
+		CallParsers: sideCallParsers,
+	}))
+
+	if !s.withoutMainNet {
+		mainParsers := []eventindex.Parser{
+			{Contract: s.contracts.neofs, Type: "Deposit", Parse: neofsEvent.ParseDeposit},
+			{Contract: s.contracts.neofs, Type: "Withdraw", Parse: neofsEvent.ParseWithdraw},
+			{Contract: s.contracts.neofs, Type: "Cheque", Parse: neofsEvent.ParseCheque},
+			{Contract: s.contracts.neofs, Type: "Bind", Parse: neofsEvent.ParseBind},
+			{Contract: s.contracts.neofs, Type: "Unbind", Parse: neofsEvent.ParseUnbind},
+			{Contract: s.contracts.neofs, Type: "SetConfig", Parse: neofsEvent.ParseConfig},
+		}
+
+		s.mainnetListener.RegisterBlockHandler(idx.AddChain(eventindex.ChainPrm{
+			Name:    "main",
+			Client:  s.mainnetClient,
+			From:    cfg.GetUint32("event_index.backfill.main_chain"),
+			Parsers: mainParsers,
+		}))
+	}
+
+	s.workers = append(s.workers, idx.Run)
+
+	return idx, nil
+}
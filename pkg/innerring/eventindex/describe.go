@@ -0,0 +1,134 @@
+package eventindex
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/mr-tron/base58"
+	"github.com/nspcc-dev/neo-go/pkg/encoding/address"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	balanceEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/balance"
+	containerEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/container"
+	neofsEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/neofs"
+	netmapEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/netmap"
+	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
+	"github.com/nspcc-dev/neofs-sdk-go/eacl"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
+// describedEvent is an index record extracted from the parsed event.
+type describedEvent struct {
+	Event
+
+	// epoch is a new epoch number for NewEpoch event.
+	epoch uint64
+}
+
+// describe fills index record fields with the parsed event parameters.
+// Unknown events are saved without indexed fields.
+func describe(ev event.Event) describedEvent {
+	var res describedEvent
+
+	switch e := ev.(type) {
+	case netmapEvent.NewEpoch:
+		res.epoch = e.EpochNumber()
+		res.Details = fmt.Sprintf("epoch=%d", e.EpochNumber())
+	case netmapEvent.AddPeer:
+		info := netmap.NewNodeInfo()
+		if err := info.Unmarshal(e.Node()); err == nil {
+			res.Node = info.PublicKey()
+
+			var addrs []string
+			netmap.IterateAllAddresses(info, func(s string) {
+				addrs = append(addrs, s)
+			})
+			res.Details = "addresses=" + strings.Join(addrs, ",")
+		}
+	case netmapEvent.UpdatePeer:
+		if key := e.PublicKey(); key != nil {
+			res.Node = key.Bytes()
+		}
+		res.Details = "state=" + e.Status().String()
+	case containerEvent.Put:
+		res.Container, res.Owner = describeContainer(e.Container())
+	case containerEvent.PutNamed:
+		res.Container, res.Owner = describeContainer(e.Container())
+		res.Details = fmt.Sprintf("name=%s zone=%s", e.Name(), e.Zone())
+	case containerEvent.Delete:
+		res.Container = e.ContainerID()
+	case containerEvent.SetEACL:
+		table := eacl.NewTable()
+		if err := table.Unmarshal(e.Table()); err == nil {
+			if id := table.CID(); id != nil {
+				res.Container = id.ToV2().GetValue()
+			}
+		}
+	case balanceEvent.Lock:
+		res.Owner = ownerFromScriptHash(e.User())
+		res.Details = fmt.Sprintf("amount=%d lock=%s until=%d",
+			e.Amount(), address.Uint160ToString(e.LockAccount()), e.Until())
+	case neofsEvent.Deposit:
+		res.Owner = ownerFromScriptHash(e.To())
+		res.Details = fmt.Sprintf("amount=%d from=%s",
+			e.Amount(), address.Uint160ToString(e.From()))
+	case neofsEvent.Withdraw:
+		res.Owner = ownerFromScriptHash(e.User())
+		res.Details = fmt.Sprintf("amount=%d", e.Amount())
+	case neofsEvent.Cheque:
+		res.Owner = ownerFromScriptHash(e.User())
+		res.Details = fmt.Sprintf("amount=%d lock=%s",
+			e.Amount(), address.Uint160ToString(e.LockAccount()))
+	case neofsEvent.Bind:
+		res.Owner, res.Details = describeBind(e.User(), e.Keys())
+	case neofsEvent.Unbind:
+		res.Owner, res.Details = describeBind(e.User(), e.Keys())
+	case neofsEvent.Config:
+		res.Details = fmt.Sprintf("%s=%s", e.Key(), hex.EncodeToString(e.Value()))
+	}
+
+	return res
+}
+
+// describeContainer returns binary ID and owner of the container.
+func describeContainer(data []byte) (id, owner []byte) {
+	sum := sha256.Sum256(data)
+
+	cnr := containerSDK.New()
+	if err := cnr.Unmarshal(data); err == nil {
+		if ownerID := cnr.OwnerID(); ownerID != nil {
+			owner = ownerID.ToV2().GetValue()
+		}
+	}
+
+	return sum[:], owner
+}
+
+func describeBind(user []byte, keys [][]byte) ([]byte, string) {
+	hexKeys := make([]string, len(keys))
+	for i := range keys {
+		hexKeys[i] = hex.EncodeToString(keys[i])
+	}
+
+	details := "keys=" + strings.Join(hexKeys, ",")
+
+	u, err := util.Uint160DecodeBytesBE(user)
+	if err != nil {
+		return nil, details
+	}
+
+	return ownerFromScriptHash(u), details
+}
+
+// ownerFromScriptHash returns binary NeoFS user ID of the account.
+func ownerFromScriptHash(u util.Uint160) []byte {
+	// user ID is a decoded Neo3 address
+	id, err := base58.Decode(address.Uint160ToString(u))
+	if err != nil {
+		return nil
+	}
+
+	return id
+}
@@ -0,0 +1,276 @@
+package eventindex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/rpc/response/result/subscriptions"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	"go.uber.org/zap"
+)
+
+type (
+	// Parser groups notification parser with the contract
+	// and the notification name it is applied to.
+	Parser struct {
+		Contract util.Uint160
+		Type     string
+		Parse    event.NotificationParser
+	}
+
+	// CallParser groups notary request parser with the contract
+	// and the method it is applied to. It is used to index the
+	// executed main transactions of the notary requests.
+	CallParser struct {
+		Contract util.Uint160
+		Method   string
+		Parse    event.NotaryParser
+	}
+
+	// ChainPrm groups parameters of the chain followed by the index.
+	ChainPrm struct {
+		// Name is a chain name saved in the index records.
+		Name string
+
+		Client *client.Client
+
+		// From is a height of the first block to index.
+		From uint32
+
+		// TrackEpoch marks the chain as a source of NewEpoch notifications
+		// which define epoch of the indexed events.
+		TrackEpoch bool
+
+		Parsers []Parser
+
+		// CallParsers are applied to the scripts of the successfully
+		// executed transactions. Should be set for notary-enabled
+		// chains only, otherwise contract calls are indexed together
+		// with the notifications they produce.
+		CallParsers []CallParser
+	}
+
+	chain struct {
+		name        string
+		cli         *client.Client
+		from        uint32
+		epochSource bool
+
+		parsers     map[util.Uint160]map[string]event.NotificationParser
+		callParsers map[util.Uint160]map[string]event.NotaryParser
+
+		// notify is signaled on each new block of the chain
+		notify chan struct{}
+	}
+)
+
+// AddChain registers the chain to be followed by the index. Returned block
+// handler must be registered in the listener of the chain, new blocks are
+// indexed after the handler is called.
+//
+// Must not be called after Run.
+func (x *Index) AddChain(p ChainPrm) event.BlockHandler {
+	ch := &chain{
+		name:        p.Name,
+		cli:         p.Client,
+		from:        p.From,
+		epochSource: p.TrackEpoch,
+		parsers:     make(map[util.Uint160]map[string]event.NotificationParser),
+		callParsers: make(map[util.Uint160]map[string]event.NotaryParser),
+		notify:      make(chan struct{}, 1),
+	}
+
+	for _, prs := range p.Parsers {
+		m, ok := ch.parsers[prs.Contract]
+		if !ok {
+			m = make(map[string]event.NotificationParser)
+			ch.parsers[prs.Contract] = m
+		}
+
+		m[prs.Type] = prs.Parse
+	}
+
+	for _, prs := range p.CallParsers {
+		m, ok := ch.callParsers[prs.Contract]
+		if !ok {
+			m = make(map[string]event.NotaryParser)
+			ch.callParsers[prs.Contract] = m
+		}
+
+		m[prs.Method] = prs.Parse
+	}
+
+	x.chains = append(x.chains, ch)
+
+	return func(*block.Block) {
+		select {
+		case ch.notify <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Run indexes blocks of the registered chains starting from the configured
+// heights and follows new blocks until the context is done.
+func (x *Index) Run(ctx context.Context) {
+	for _, ch := range x.chains {
+		go x.follow(ctx, ch)
+	}
+
+	<-ctx.Done()
+}
+
+func (x *Index) follow(ctx context.Context, ch *chain) {
+	for {
+		if err := x.sync(ctx, ch); err != nil {
+			x.log.Warn("can't index chain blocks",
+				zap.String("chain", ch.name),
+				zap.String("error", err.Error()),
+			)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ch.notify:
+		}
+	}
+}
+
+// sync indexes chain blocks up to the current height.
+func (x *Index) sync(ctx context.Context, ch *chain) error {
+	next, err := x.nextHeight(ch)
+	if err != nil {
+		return err
+	}
+
+	if next < ch.from {
+		next = ch.from
+	}
+
+	count, err := ch.cli.BlockCount()
+	if err != nil {
+		return fmt.Errorf("can't get block count: %w", err)
+	}
+
+	for h := next; h < count; h++ {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		b, err := ch.cli.Block(h)
+		if err != nil {
+			return fmt.Errorf("can't get block %d: %w", h, err)
+		}
+
+		events, err := x.blockEvents(ch, b)
+		if err != nil {
+			return fmt.Errorf("block %d: %w", h, err)
+		}
+
+		if err := x.put(ch, h, events); err != nil {
+			return fmt.Errorf("can't index block %d: %w", h, err)
+		}
+	}
+
+	return nil
+}
+
+// blockEvents parses notifications and contract calls of the successfully
+// executed block transactions.
+func (x *Index) blockEvents(ch *chain, b *block.Block) ([]describedEvent, error) {
+	var res []describedEvent
+
+	for _, tx := range b.Transactions {
+		log, err := ch.cli.ApplicationLog(tx.Hash())
+		if err != nil {
+			return nil, fmt.Errorf("can't get application log of %s: %w", tx.Hash().StringLE(), err)
+		}
+
+		if len(log.Executions) == 0 || !log.Executions[0].VMState.HasFlag(vm.HaltState) {
+			continue
+		}
+
+		if d, ok := x.callEvent(ch, tx); ok {
+			res = append(res, d)
+		}
+
+		for _, n := range log.Executions[0].Events {
+			parse, ok := ch.parsers[n.ScriptHash][n.Name]
+			if !ok {
+				continue
+			}
+
+			ev, err := parse(&subscriptions.NotificationEvent{
+				Container:         tx.Hash(),
+				NotificationEvent: n,
+			})
+			if err != nil {
+				x.log.Debug("can't parse indexed notification",
+					zap.String("chain", ch.name),
+					zap.String("tx", tx.Hash().StringLE()),
+					zap.String("type", n.Name),
+					zap.String("error", err.Error()),
+				)
+
+				continue
+			}
+
+			d := describe(ev)
+			d.TxHash = tx.Hash()
+			d.Contract = n.ScriptHash
+			d.Type = n.Name
+
+			res = append(res, d)
+		}
+	}
+
+	return res, nil
+}
+
+// callEvent parses contract call of the transaction. Returns false if the
+// transaction does not call any method with the registered parser.
+func (x *Index) callEvent(ch *chain, tx *transaction.Transaction) (describedEvent, bool) {
+	if len(ch.callParsers) == 0 {
+		return describedEvent{}, false
+	}
+
+	ne, err := event.ContractCallEvent(tx)
+	if err != nil {
+		// most transactions are not single contract calls
+		return describedEvent{}, false
+	}
+
+	parse, ok := ch.callParsers[ne.ScriptHash()][ne.Type().String()]
+	if !ok {
+		return describedEvent{}, false
+	}
+
+	ev, err := parse(ne)
+	if err != nil {
+		x.log.Debug("can't parse indexed contract call",
+			zap.String("chain", ch.name),
+			zap.String("tx", tx.Hash().StringLE()),
+			zap.String("method", ne.Type().String()),
+			zap.String("error", err.Error()),
+		)
+
+		return describedEvent{}, false
+	}
+
+	d := describe(ev)
+	d.TxHash = tx.Hash()
+	d.Contract = ne.ScriptHash()
+	d.Type = ne.Type().String()
+
+	return d, true
+}
+
+func (ch *chain) heightKey() []byte {
+	return []byte(ch.name + "_height")
+}
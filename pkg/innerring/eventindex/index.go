@@ -0,0 +1,376 @@
+// Package eventindex implements local index of NeoFS contract notifications.
+//
+// Notifications are parsed with the parsers of the event package and saved
+// to the bbolt database with indexes by container, owner, storage node and
+// epoch. In notary-enabled side chain contracts do not notify about the
+// requested operations, so the executed main transactions of the notary
+// requests are parsed with the notary parsers and indexed by the called
+// method name.
+package eventindex
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+type (
+	// Event is an indexed contract notification.
+	Event struct {
+		// Chain is a name of the chain the notification was produced in.
+		Chain string `json:"chain"`
+		// Height is an index of the block with the notification.
+		Height uint32 `json:"height"`
+		// TxHash is a hash of the transaction with the notification.
+		TxHash util.Uint256 `json:"tx"`
+		// Contract is a script hash of the notification emitter.
+		Contract util.Uint160 `json:"contract"`
+		// Type is a notification name.
+		Type string `json:"type"`
+		// Epoch is a NeoFS epoch at the moment of notification. Set for
+		// side chain notifications only.
+		Epoch uint64 `json:"epoch,omitempty"`
+
+		// Container is a binary ID of the related container.
+		Container []byte `json:"container,omitempty"`
+		// Owner is a binary ID of the related NeoFS user.
+		Owner []byte `json:"owner,omitempty"`
+		// Node is a public key of the related storage node.
+		Node []byte `json:"node,omitempty"`
+
+		// Details is a human-readable description of the event parameters.
+		Details string `json:"details,omitempty"`
+	}
+
+	// Filter groups conditions of the index query. Conditions are combined
+	// with AND, empty fields match any value.
+	Filter struct {
+		Container []byte
+		Owner     []byte
+		Node      []byte
+		// Epoch is a NeoFS epoch of the event, 0 matches any epoch.
+		Epoch uint64
+		// Limit is a maximum number of the latest events to return.
+		// 0 means no limit if any other condition is set and
+		// DefaultLimit otherwise.
+		Limit uint32
+	}
+
+	// Index is a local storage of NeoFS contract notifications. It follows
+	// registered chains and serves historical queries.
+	Index struct {
+		log *zap.Logger
+		db  *bbolt.DB
+
+		chains []*chain
+	}
+
+	// Params of the index constructor.
+	Params struct {
+		Log  *zap.Logger
+		Path string
+	}
+)
+
+var (
+	eventsBucket    = []byte("events")
+	containerBucket = []byte("container")
+	ownerBucket     = []byte("owner")
+	nodeBucket      = []byte("node")
+	epochBucket     = []byte("epoch")
+	stateBucket     = []byte("state")
+
+	epochStateKey = []byte("epoch")
+)
+
+var buckets = [][]byte{
+	eventsBucket,
+	containerBucket,
+	ownerBucket,
+	nodeBucket,
+	epochBucket,
+	stateBucket,
+}
+
+const seqSize = 8
+
+// DefaultLimit is a maximum number of the latest events returned by
+// Select if neither conditions nor the limit are set.
+const DefaultLimit = 1000
+
+// New creates event index instance and opens underlying database.
+func New(p *Params) (*Index, error) {
+	switch {
+	case p.Log == nil:
+		return nil, errors.New("ir/eventindex: logger is not set")
+	case p.Path == "":
+		return nil, errors.New("ir/eventindex: database path is not set")
+	}
+
+	db, err := bbolt.Open(p.Path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ir/eventindex: can't open bbolt at %s: %w", p.Path, err)
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		for i := range buckets {
+			if _, err := tx.CreateBucketIfNotExists(buckets[i]); err != nil {
+				return fmt.Errorf("can't create %s bucket: %w", buckets[i], err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("ir/eventindex: %w", err)
+	}
+
+	return &Index{
+		log: p.Log,
+		db:  db,
+	}, nil
+}
+
+// Close closes underlying database.
+func (x *Index) Close() error {
+	return x.db.Close()
+}
+
+// Select returns indexed events matching the filter in chronological order.
+// If the limit is set, only the latest events are returned. Filter without
+// conditions is limited to DefaultLimit events.
+func (x *Index) Select(f Filter) ([]Event, error) {
+	var res []Event
+
+	if f.Limit == 0 && f.empty() {
+		f.Limit = DefaultLimit
+	}
+
+	err := x.db.View(func(tx *bbolt.Tx) error {
+		events := tx.Bucket(eventsBucket)
+
+		var (
+			bkt    *bbolt.Bucket
+			prefix []byte
+		)
+
+		switch {
+		case len(f.Container) != 0:
+			bkt, prefix = tx.Bucket(containerBucket), f.Container
+		case len(f.Owner) != 0:
+			bkt, prefix = tx.Bucket(ownerBucket), f.Owner
+		case len(f.Node) != 0:
+			bkt, prefix = tx.Bucket(nodeBucket), f.Node
+		case f.Epoch != 0:
+			bkt, prefix = tx.Bucket(epochBucket), epochKey(f.Epoch)
+		default:
+			bkt = events
+		}
+
+		return iterateBackward(bkt.Cursor(), prefix, func(k []byte) (bool, error) {
+			seq := k[len(prefix):]
+			if len(seq) != seqSize {
+				return false, nil
+			}
+
+			data := events.Get(seq)
+			if data == nil {
+				return false, nil
+			}
+
+			var e Event
+			if err := json.Unmarshal(data, &e); err != nil {
+				return false, fmt.Errorf("can't decode event #%d: %w", binary.BigEndian.Uint64(seq), err)
+			}
+
+			if !f.match(e) {
+				return false, nil
+			}
+
+			res = append(res, e)
+
+			return f.Limit != 0 && uint32(len(res)) >= f.Limit, nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// events are collected from the latest one
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+
+	return res, nil
+}
+
+func (f Filter) empty() bool {
+	return len(f.Container) == 0 && len(f.Owner) == 0 && len(f.Node) == 0 && f.Epoch == 0
+}
+
+func (f Filter) match(e Event) bool {
+	return (len(f.Container) == 0 || bytes.Equal(f.Container, e.Container)) &&
+		(len(f.Owner) == 0 || bytes.Equal(f.Owner, e.Owner)) &&
+		(len(f.Node) == 0 || bytes.Equal(f.Node, e.Node)) &&
+		(f.Epoch == 0 || f.Epoch == e.Epoch)
+}
+
+// iterateBackward passes keys with the given prefix to f from the last one
+// until f returns true or an error.
+func iterateBackward(c *bbolt.Cursor, prefix []byte, f func([]byte) (bool, error)) error {
+	var k []byte
+
+	if len(prefix) == 0 {
+		k, _ = c.Last()
+	} else {
+		upper := append(append(make([]byte, 0, len(prefix)+seqSize), prefix...),
+			0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff)
+
+		if k, _ = c.Seek(upper); k == nil {
+			k, _ = c.Last()
+		} else if !bytes.Equal(k, upper) {
+			k, _ = c.Prev()
+		}
+	}
+
+	for ; k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Prev() {
+		stop, err := f(k)
+		if err != nil || stop {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// put saves events of the chain block and moves the chain to the
+// next height. Epoch of the side chain events is tracked by NewEpoch
+// notifications.
+func (x *Index) put(ch *chain, height uint32, events []describedEvent) error {
+	return x.db.Update(func(tx *bbolt.Tx) error {
+		var (
+			bEvents = tx.Bucket(eventsBucket)
+			bState  = tx.Bucket(stateBucket)
+			epoch   uint64
+		)
+
+		if v := bState.Get(epochStateKey); len(v) == 8 {
+			epoch = binary.BigEndian.Uint64(v)
+		}
+
+		for i := range events {
+			e := events[i].Event
+
+			if events[i].epoch != 0 {
+				epoch = events[i].epoch
+			}
+
+			e.Chain = ch.name
+			e.Height = height
+			if ch.epochSource {
+				e.Epoch = epoch
+			}
+
+			// owner of the container is known from its creation only
+			if len(e.Owner) == 0 && len(e.Container) != 0 {
+				e.Owner = containerOwner(tx, e.Container)
+			}
+
+			data, err := json.Marshal(e)
+			if err != nil {
+				return fmt.Errorf("can't encode event: %w", err)
+			}
+
+			n, err := bEvents.NextSequence()
+			if err != nil {
+				return fmt.Errorf("can't get next event number: %w", err)
+			}
+
+			seq := make([]byte, seqSize)
+			binary.BigEndian.PutUint64(seq, n)
+
+			if err := bEvents.Put(seq, data); err != nil {
+				return fmt.Errorf("can't save event: %w", err)
+			}
+
+			for _, idx := range []struct {
+				bucket []byte
+				value  []byte
+			}{
+				{containerBucket, e.Container},
+				{ownerBucket, e.Owner},
+				{nodeBucket, e.Node},
+				{epochBucket, epochKey(e.Epoch)},
+			} {
+				if len(idx.value) == 0 || e.Epoch == 0 && bytes.Equal(idx.bucket, epochBucket) {
+					continue
+				}
+
+				k := make([]byte, 0, len(idx.value)+seqSize)
+				k = append(append(k, idx.value...), seq...)
+
+				if err := tx.Bucket(idx.bucket).Put(k, nil); err != nil {
+					return fmt.Errorf("can't update %s index: %w", idx.bucket, err)
+				}
+			}
+		}
+
+		if ch.epochSource {
+			v := make([]byte, 8)
+			binary.BigEndian.PutUint64(v, epoch)
+
+			if err := bState.Put(epochStateKey, v); err != nil {
+				return fmt.Errorf("can't save epoch: %w", err)
+			}
+		}
+
+		h := make([]byte, 4)
+		binary.BigEndian.PutUint32(h, height+1)
+
+		return bState.Put(ch.heightKey(), h)
+	})
+}
+
+// nextHeight returns height of the next block of the chain to be indexed.
+func (x *Index) nextHeight(ch *chain) (res uint32, err error) {
+	err = x.db.View(func(tx *bbolt.Tx) error {
+		if v := tx.Bucket(stateBucket).Get(ch.heightKey()); len(v) == 4 {
+			res = binary.BigEndian.Uint32(v)
+		}
+		return nil
+	})
+
+	return
+}
+
+// containerOwner returns owner of the container from its indexed creation
+// event.
+func containerOwner(tx *bbolt.Tx, cid []byte) (res []byte) {
+	events := tx.Bucket(eventsBucket)
+
+	_ = iterateBackward(tx.Bucket(containerBucket).Cursor(), cid, func(k []byte) (bool, error) {
+		var e Event
+
+		if data := events.Get(k[len(cid):]); data != nil && json.Unmarshal(data, &e) == nil && len(e.Owner) != 0 {
+			res = e.Owner
+			return true, nil
+		}
+
+		return false, nil
+	})
+
+	return
+}
+
+func epochKey(epoch uint64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, epoch)
+
+	return k
+}
@@ -0,0 +1,217 @@
+package eventindex
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/io"
+	"github.com/nspcc-dev/neo-go/pkg/smartcontract/callflag"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/emit"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	netmapEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestIndex(t *testing.T) *Index {
+	x, err := New(&Params{
+		Log:  zap.NewNop(),
+		Path: filepath.Join(t.TempDir(), "index.db"),
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, x.Close()) })
+
+	return x
+}
+
+func testEvent(typ string, cnr, owner, node []byte) describedEvent {
+	return describedEvent{Event: Event{
+		TxHash:    util.Uint256{1},
+		Contract:  util.Uint160{2},
+		Type:      typ,
+		Container: cnr,
+		Owner:     owner,
+		Node:      node,
+	}}
+}
+
+func TestIndex_Select(t *testing.T) {
+	x := newTestIndex(t)
+
+	side := &chain{name: "side", epochSource: true}
+	main := &chain{name: "main"}
+
+	var (
+		cnr   = []byte{1, 1, 1}
+		owner = []byte{2, 2, 2}
+		node  = []byte{3, 3, 3}
+	)
+
+	epoch := testEvent("NewEpoch", nil, nil, nil)
+	epoch.epoch = 10
+
+	require.NoError(t, x.put(side, 5, []describedEvent{
+		epoch,
+		testEvent("containerPut", cnr, owner, nil),
+		testEvent("AddPeer", nil, nil, node),
+	}))
+	require.NoError(t, x.put(main, 100, []describedEvent{
+		testEvent("Deposit", nil, owner, nil),
+	}))
+
+	epoch.epoch = 11
+
+	require.NoError(t, x.put(side, 6, []describedEvent{
+		epoch,
+		testEvent("containerDelete", cnr, nil, nil),
+		testEvent("UpdateState", nil, nil, node),
+	}))
+
+	types := func(events []Event) []string {
+		res := make([]string, len(events))
+		for i := range events {
+			res[i] = events[i].Type
+		}
+		return res
+	}
+
+	t.Run("container", func(t *testing.T) {
+		res, err := x.Select(Filter{Container: cnr})
+		require.NoError(t, err)
+		require.Equal(t, []string{"containerPut", "containerDelete"}, types(res))
+
+		// owner is taken from the container creation event
+		require.Equal(t, owner, res[1].Owner)
+		require.EqualValues(t, 6, res[1].Height)
+		require.EqualValues(t, 11, res[1].Epoch)
+	})
+
+	t.Run("owner", func(t *testing.T) {
+		res, err := x.Select(Filter{Owner: owner})
+		require.NoError(t, err)
+		require.Equal(t, []string{"containerPut", "Deposit", "containerDelete"}, types(res))
+		require.Equal(t, "main", res[1].Chain)
+		require.Zero(t, res[1].Epoch)
+	})
+
+	t.Run("node", func(t *testing.T) {
+		res, err := x.Select(Filter{Node: node})
+		require.NoError(t, err)
+		require.Equal(t, []string{"AddPeer", "UpdateState"}, types(res))
+	})
+
+	t.Run("epoch", func(t *testing.T) {
+		res, err := x.Select(Filter{Epoch: 10})
+		require.NoError(t, err)
+		require.Equal(t, []string{"NewEpoch", "containerPut", "AddPeer"}, types(res))
+	})
+
+	t.Run("combined", func(t *testing.T) {
+		res, err := x.Select(Filter{Owner: owner, Epoch: 11})
+		require.NoError(t, err)
+		require.Equal(t, []string{"containerDelete"}, types(res))
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		res, err := x.Select(Filter{Limit: 2})
+		require.NoError(t, err)
+		require.Equal(t, []string{"containerDelete", "UpdateState"}, types(res))
+	})
+
+	t.Run("default limit", func(t *testing.T) {
+		x := newTestIndex(t)
+
+		events := make([]describedEvent, DefaultLimit+1)
+		for i := range events {
+			events[i] = testEvent("Deposit", nil, owner, nil)
+		}
+
+		require.NoError(t, x.put(main, 1, events))
+
+		res, err := x.Select(Filter{})
+		require.NoError(t, err)
+		require.Len(t, res, DefaultLimit)
+
+		res, err = x.Select(Filter{Owner: owner})
+		require.NoError(t, err)
+		require.Len(t, res, DefaultLimit+1)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		res, err := x.Select(Filter{Container: []byte{1, 1}})
+		require.NoError(t, err)
+		require.Empty(t, res)
+	})
+}
+
+func TestIndex_NextHeight(t *testing.T) {
+	x := newTestIndex(t)
+
+	side := &chain{name: "side"}
+	main := &chain{name: "main"}
+
+	h, err := x.nextHeight(side)
+	require.NoError(t, err)
+	require.Zero(t, h)
+
+	require.NoError(t, x.put(side, 10, nil))
+
+	h, err = x.nextHeight(side)
+	require.NoError(t, err)
+	require.EqualValues(t, 11, h)
+
+	h, err = x.nextHeight(main)
+	require.NoError(t, err)
+	require.Zero(t, h)
+}
+
+func TestIndex_CallEvent(t *testing.T) {
+	x := newTestIndex(t)
+
+	nmHash := util.Uint160{1, 2, 3}
+
+	ch := &chain{name: "side"}
+	ch.callParsers = map[util.Uint160]map[string]event.NotaryParser{
+		nmHash: {netmapEvent.AddPeerNotaryEvent: netmapEvent.ParseAddPeerNotary},
+	}
+
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	info := netmap.NewNodeInfo()
+	info.SetPublicKey(k.PublicKey().Bytes())
+
+	rawInfo, err := info.Marshal()
+	require.NoError(t, err)
+
+	callTx := func(h util.Uint160, method string) *transaction.Transaction {
+		bw := io.NewBufBinWriter()
+		emit.AppCall(bw.BinWriter, h, method, callflag.All, rawInfo)
+		require.NoError(t, bw.Err)
+
+		return transaction.New(bw.Bytes(), 0)
+	}
+
+	tx := callTx(nmHash, netmapEvent.AddPeerNotaryEvent)
+
+	d, ok := x.callEvent(ch, tx)
+	require.True(t, ok)
+	require.Equal(t, netmapEvent.AddPeerNotaryEvent, d.Type)
+	require.Equal(t, nmHash, d.Contract)
+	require.Equal(t, tx.Hash(), d.TxHash)
+	require.Equal(t, k.PublicKey().Bytes(), d.Node)
+
+	_, ok = x.callEvent(ch, callTx(nmHash, "newEpoch"))
+	require.False(t, ok, "method without parser must be skipped")
+
+	_, ok = x.callEvent(ch, callTx(util.Uint160{3, 2, 1}, netmapEvent.AddPeerNotaryEvent))
+	require.False(t, ok, "other contract must be skipped")
+
+	_, ok = x.callEvent(&chain{name: "main"}, tx)
+	require.False(t, ok, "contract calls must not be indexed without parsers")
+}
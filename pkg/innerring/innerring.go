@@ -22,6 +22,8 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/netmap"
 	nodevalidator "github.com/nspcc-dev/neofs-node/pkg/innerring/processors/netmap/nodevalidation"
 	addrvalidator "github.com/nspcc-dev/neofs-node/pkg/innerring/processors/netmap/nodevalidation/maddress"
+	maintenancevalidator "github.com/nspcc-dev/neofs-node/pkg/innerring/processors/netmap/nodevalidation/maintenance"
+	reachabilityvalidator "github.com/nspcc-dev/neofs-node/pkg/innerring/processors/netmap/nodevalidation/reachability"
 	subnetvalidator "github.com/nspcc-dev/neofs-node/pkg/innerring/processors/netmap/nodevalidation/subnet"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/reputation"
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement"
@@ -99,6 +101,13 @@ type (
 		// runtime processors
 		netmapProcessor *netmap.Processor
 
+		// event processors managed via Control service
+		processors []*eventProcessor
+
+		// notary requests received by the processors
+		sideNotaryRequests *notaryRequests
+		mainNotaryRequests *notaryRequests
+
 		workers []func(context.Context)
 
 		// Set of local resources that must be
@@ -229,6 +238,14 @@ func (s *Server) Start(ctx context.Context, intError chan<- error) (err error) {
 		}
 	}()
 
+	if !s.sideNotaryConfig.disabled {
+		s.morphListener.RegisterBlockHandler(s.sideNotaryRequests.handleBlock)
+	}
+
+	if !s.mainNotaryConfig.disabled {
+		s.mainnetListener.RegisterBlockHandler(s.mainNotaryRequests.handleBlock)
+	}
+
 	s.morphListener.RegisterBlockHandler(func(b *block.Block) {
 		s.log.Debug("new block",
 			zap.Uint32("index", b.Index),
@@ -315,7 +332,11 @@ func (s *Server) registerStarter(f func() error) {
 // New creates instance of inner ring sever structure.
 func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error) {
 	var err error
-	server := &Server{log: log}
+	server := &Server{
+		log:                log,
+		sideNotaryRequests: newNotaryRequests(),
+		mainNotaryRequests: newNotaryRequests(),
+	}
 
 	server.setHealthStatus(control.HealthStatus_HEALTH_STATUS_UNDEFINED)
 
@@ -642,6 +663,9 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 		settlement.WithLogger(server.log),
 	)
 
+	auditEvents := server.registerProcessor("audit", auditProcessor, true)
+	settlementEvents := server.registerProcessor("settlement", settlementProcessor, true)
+
 	locodeValidator, err := server.newLocodeValidator(cfg)
 	if err != nil {
 		return nil, err
@@ -656,6 +680,47 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 		return nil, err
 	}
 
+	maintenanceValidator, err := maintenancevalidator.New(
+		maintenancevalidator.Prm{
+			EpochState:    server,
+			NetworkConfig: server.netmapClient,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		nodeValidators     []netmap.NodeValidator
+		validationPoolSize int
+	)
+
+	if cfg.GetBool("netmap_validation.reachability.enabled") {
+		reachabilityValidator, err := reachabilityvalidator.New(
+			reachabilityvalidator.Prm{
+				Key:            &server.key.PrivateKey,
+				DialTimeout:    cfg.GetDuration("netmap_validation.reachability.dial_timeout"),
+				RequestTimeout: cfg.GetDuration("netmap_validation.reachability.request_timeout"),
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// must go first to verify the attributes before they are changed
+		nodeValidators = append(nodeValidators, reachabilityValidator)
+
+		// candidates are dialed, so they are validated out of the main pool
+		validationPoolSize = cfg.GetInt("workers.netmap_validation")
+	}
+
+	nodeValidators = append(nodeValidators,
+		addrvalidator.New(),
+		locodeValidator,
+		subnetValidator,
+		maintenanceValidator,
+	)
+
 	var alphaSync event.Handler
 
 	if server.withoutMainNet || cfg.GetBool("governance.disable") {
@@ -681,7 +746,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 		}
 
 		alphaSync = governanceProcessor.HandleAlphabetSync
-		err = bindMainnetProcessor(governanceProcessor, server)
+		err = bindMainnetProcessor("governance", governanceProcessor, server)
 		if err != nil {
 			return nil, err
 		}
@@ -699,28 +764,25 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 		CleanupThreshold: cfg.GetUint64("netmap_cleaner.threshold"),
 		ContainerWrapper: cnrClient,
 		HandleAudit: server.onlyActiveEventHandler(
-			auditProcessor.StartAuditHandler(),
+			auditEvents.wrapHandler(auditProcessor.StartAuditHandler()),
 		),
 		NotaryDepositHandler: server.onlyAlphabetEventHandler(
 			server.notaryHandler,
 		),
 		AuditSettlementsHandler: server.onlyAlphabetEventHandler(
-			settlementProcessor.HandleAuditEvent,
+			settlementEvents.wrapHandler(settlementProcessor.HandleAuditEvent),
 		),
 		AlphabetSyncHandler: alphaSync,
-		NodeValidator: nodevalidator.New(
-			addrvalidator.New(),
-			locodeValidator,
-			subnetValidator,
-		),
-		NotaryDisabled: server.sideNotaryConfig.disabled,
-		SubnetContract: &server.contracts.subnet,
+		NodeValidator:       nodevalidator.New(nodeValidators...),
+		ValidationPoolSize:  validationPoolSize,
+		NotaryDisabled:      server.sideNotaryConfig.disabled,
+		SubnetContract:      &server.contracts.subnet,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = bindMorphProcessor(server.netmapProcessor, server)
+	err = bindMorphProcessor("netmap", server.netmapProcessor, server)
 	if err != nil {
 		return nil, err
 	}
@@ -735,12 +797,13 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 		NetworkState:    server.netmapClient,
 		NotaryDisabled:  server.sideNotaryConfig.disabled,
 		SubnetClient:    subnetClient,
+		CheckPlacement:  cfg.GetBool("container.check_placement"),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = bindMorphProcessor(containerProcessor, server)
+	err = bindMorphProcessor("container", containerProcessor, server)
 	if err != nil {
 		return nil, err
 	}
@@ -757,7 +820,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 		return nil, err
 	}
 
-	err = bindMorphProcessor(balanceProcessor, server)
+	err = bindMorphProcessor("balance", balanceProcessor, server)
 	if err != nil {
 		return nil, err
 	}
@@ -784,7 +847,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 			return nil, err
 		}
 
-		err = bindMainnetProcessor(neofsProcessor, server)
+		err = bindMainnetProcessor("neofs", neofsProcessor, server)
 		if err != nil {
 			return nil, err
 		}
@@ -804,7 +867,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 		return nil, err
 	}
 
-	err = bindMorphProcessor(alphabetProcessor, server)
+	err = bindMorphProcessor("alphabet", alphabetProcessor, server)
 	if err != nil {
 		return nil, err
 	}
@@ -827,7 +890,7 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 		return nil, err
 	}
 
-	err = bindMorphProcessor(reputationProcessor, server)
+	err = bindMorphProcessor("reputation", reputationProcessor, server)
 	if err != nil {
 		return nil, err
 	}
@@ -841,12 +904,12 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 		stopEstimationDMul: cfg.GetUint32("timers.stop_estimation.mul"),
 		stopEstimationDDiv: cfg.GetUint32("timers.stop_estimation.div"),
 		collectBasicIncome: subEpochEventHandler{
-			handler:     settlementProcessor.HandleIncomeCollectionEvent,
+			handler:     settlementEvents.wrapHandler(settlementProcessor.HandleIncomeCollectionEvent),
 			durationMul: cfg.GetUint32("timers.collect_basic_income.mul"),
 			durationDiv: cfg.GetUint32("timers.collect_basic_income.div"),
 		},
 		distributeBasicIncome: subEpochEventHandler{
-			handler:     settlementProcessor.HandleIncomeDistributionEvent,
+			handler:     settlementEvents.wrapHandler(settlementProcessor.HandleIncomeDistributionEvent),
 			durationMul: cfg.GetUint32("timers.distribute_basic_income.mul"),
 			durationDiv: cfg.GetUint32("timers.distribute_basic_income.div"),
 		},
@@ -862,6 +925,11 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 
 	server.addBlockTimer(emissionTimer)
 
+	eventIndex, err := server.initEventIndex(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	controlSvcEndpoint := cfg.GetString("control.grpc.endpoint")
 	if controlSvcEndpoint != "" {
 		authKeysStr := cfg.GetStringSlice("control.authorized_keys")
@@ -883,10 +951,18 @@ func New(ctx context.Context, log *zap.Logger, cfg *viper.Viper) (*Server, error
 
 		p.SetPrivateKey(*server.key)
 		p.SetHealthChecker(server)
+		p.SetProcessors(server)
+		p.SetNodeState(server)
 
-		controlSvc := controlsrv.New(p,
+		controlOpts := []controlsrv.Option{
 			controlsrv.WithAllowedKeys(authKeys),
-		)
+		}
+
+		if eventIndex != nil {
+			controlOpts = append(controlOpts, controlsrv.WithEventIndex(eventIndex))
+		}
+
+		controlSvc := controlsrv.New(p, controlOpts...)
 
 		grpcControlSrv := grpc.NewServer()
 		control.RegisterControlServiceServer(grpcControlSrv, controlSvc)
@@ -3,10 +3,14 @@ package innerring
 import (
 	"context"
 	"fmt"
+	"sync"
 
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
+	"github.com/nspcc-dev/neo-go/pkg/network/payload"
 	"github.com/nspcc-dev/neo-go/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	controlsrv "github.com/nspcc-dev/neofs-node/pkg/services/control/ir/server"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
 )
@@ -125,3 +129,85 @@ func parseNotaryConfigs(cfg *viper.Viper, withSideNotary, withMainNotary bool) (
 
 	return
 }
+
+// notaryRequests tracks notary requests received by the IR node
+// until their main transactions are accepted or expired.
+type notaryRequests struct {
+	mtx sync.RWMutex
+
+	pending map[util.Uint256]controlsrv.NotaryRequest
+}
+
+func newNotaryRequests() *notaryRequests {
+	return &notaryRequests{
+		pending: make(map[util.Uint256]controlsrv.NotaryRequest),
+	}
+}
+
+// trackHandler returns notary event handler which remembers the
+// notary request of the event and passes the event to h.
+func (x *notaryRequests) trackHandler(contract util.Uint160, method event.NotaryType, h event.Handler) event.Handler {
+	return func(e event.Event) {
+		if ne, ok := e.(interface {
+			NotaryRequest() *payload.P2PNotaryRequest
+		}); ok {
+			if nr := ne.NotaryRequest(); nr != nil && nr.MainTransaction != nil {
+				mainTx := nr.MainTransaction.Hash()
+
+				x.mtx.Lock()
+				x.pending[mainTx] = controlsrv.NotaryRequest{
+					MainTx:          mainTx,
+					Contract:        contract,
+					Method:          method.String(),
+					ValidUntilBlock: nr.MainTransaction.ValidUntilBlock,
+				}
+				x.mtx.Unlock()
+			}
+		}
+
+		h(e)
+	}
+}
+
+// handleBlock forgets notary requests whose main transactions
+// are included in the block or can no longer be accepted.
+func (x *notaryRequests) handleBlock(b *block.Block) {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	for _, tx := range b.Transactions {
+		delete(x.pending, tx.Hash())
+	}
+
+	for h, nr := range x.pending {
+		if nr.ValidUntilBlock <= b.Index {
+			delete(x.pending, h)
+		}
+	}
+}
+
+// list returns all pending notary requests.
+func (x *notaryRequests) list() []controlsrv.NotaryRequest {
+	x.mtx.RLock()
+	defer x.mtx.RUnlock()
+
+	res := make([]controlsrv.NotaryRequest, 0, len(x.pending))
+
+	for _, nr := range x.pending {
+		res = append(res, nr)
+	}
+
+	return res
+}
+
+// PendingNotaryRequests returns notary requests received by the node
+// from both chains which have not been completed yet.
+func (s *Server) PendingNotaryRequests() []controlsrv.NotaryRequest {
+	return append(s.sideNotaryRequests.list(), s.mainNotaryRequests.list()...)
+}
+
+// NotaryEnabled returns flags of the enabled notary
+// in the side and main chains.
+func (s *Server) NotaryEnabled() (side, main bool) {
+	return !s.sideNotaryConfig.disabled, !s.mainNotaryConfig.disabled
+}
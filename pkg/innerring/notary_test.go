@@ -0,0 +1,78 @@
+package innerring
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
+	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
+	"github.com/nspcc-dev/neo-go/pkg/network/payload"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	controlsrv "github.com/nspcc-dev/neofs-node/pkg/services/control/ir/server"
+	"github.com/stretchr/testify/require"
+)
+
+type testNotaryEvent struct {
+	nr *payload.P2PNotaryRequest
+}
+
+func (testNotaryEvent) MorphEvent() {}
+
+func (x testNotaryEvent) NotaryRequest() *payload.P2PNotaryRequest {
+	return x.nr
+}
+
+func testNotaryRequest(script []byte, validUntil uint32) *payload.P2PNotaryRequest {
+	tx := transaction.New(script, 0)
+	tx.ValidUntilBlock = validUntil
+
+	return &payload.P2PNotaryRequest{MainTransaction: tx}
+}
+
+func TestNotaryRequests(t *testing.T) {
+	var (
+		contract = util.Uint160{1, 2, 3}
+		method   = event.NotaryTypeFromString("newEpoch")
+
+		nr1 = testNotaryRequest([]byte{1}, 10)
+		nr2 = testNotaryRequest([]byte{2}, 20)
+		nr3 = testNotaryRequest([]byte{3}, 30)
+	)
+
+	x := newNotaryRequests()
+
+	var handled int
+
+	h := x.trackHandler(contract, method, func(event.Event) { handled++ })
+
+	h(testNotaryEvent{nr: nr1})
+	h(testNotaryEvent{nr: nr2})
+	h(testNotaryEvent{nr: nr3})
+	h(testNotaryEvent{}) // request without main transaction is not tracked
+	h(testEvent{})       // not a notary event
+	require.Equal(t, 5, handled)
+
+	require.ElementsMatch(t, []controlsrv.NotaryRequest{
+		{MainTx: nr1.MainTransaction.Hash(), Contract: contract, Method: "newEpoch", ValidUntilBlock: 10},
+		{MainTx: nr2.MainTransaction.Hash(), Contract: contract, Method: "newEpoch", ValidUntilBlock: 20},
+		{MainTx: nr3.MainTransaction.Hash(), Contract: contract, Method: "newEpoch", ValidUntilBlock: 30},
+	}, x.list())
+
+	// main transaction of the second request is accepted
+	x.handleBlock(&block.Block{
+		Header:       block.Header{Index: 5},
+		Transactions: []*transaction.Transaction{nr2.MainTransaction},
+	})
+
+	require.ElementsMatch(t, []controlsrv.NotaryRequest{
+		{MainTx: nr1.MainTransaction.Hash(), Contract: contract, Method: "newEpoch", ValidUntilBlock: 10},
+		{MainTx: nr3.MainTransaction.Hash(), Contract: contract, Method: "newEpoch", ValidUntilBlock: 30},
+	}, x.list())
+
+	// first request is expired
+	x.handleBlock(&block.Block{Header: block.Header{Index: 10}})
+
+	require.Equal(t, []controlsrv.NotaryRequest{
+		{MainTx: nr3.MainTransaction.Hash(), Contract: contract, Method: "newEpoch", ValidUntilBlock: 30},
+	}, x.list())
+}
@@ -0,0 +1,107 @@
+package innerring
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	controlsrv "github.com/nspcc-dev/neofs-node/pkg/services/control/ir/server"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+type (
+	// processorPool is an interface of the event processor
+	// which reports the state of its worker pool.
+	processorPool interface {
+		PoolState() (running, capacity int)
+	}
+
+	// eventProcessor is a named event processor of the
+	// IR node. Pausable processors can be paused by the operator.
+	eventProcessor struct {
+		log *zap.Logger
+
+		name string
+		pool processorPool
+
+		pausable bool
+		paused   atomic.Bool
+	}
+)
+
+// wrapHandler returns event handler which skips
+// the events while the processor is paused.
+//
+// Skipped events are not replayed on resume, so only the processors
+// which can miss the events without breaking the IR state (audit and
+// settlement of some epochs) must be pausable.
+func (p *eventProcessor) wrapHandler(h event.Handler) event.Handler {
+	return func(e event.Event) {
+		if p.paused.Load() {
+			p.log.Debug("event processor is paused, skip event",
+				zap.String("processor", p.name),
+			)
+
+			return
+		}
+
+		h(e)
+	}
+}
+
+// registerProcessor registers event processor under the name
+// in the list of the processors managed via Control service.
+func (s *Server) registerProcessor(name string, pool processorPool, pausable bool) *eventProcessor {
+	p := &eventProcessor{
+		log:      s.log,
+		name:     name,
+		pool:     pool,
+		pausable: pausable,
+	}
+
+	s.processors = append(s.processors, p)
+
+	return p
+}
+
+// ListProcessors returns states of all registered event processors.
+func (s *Server) ListProcessors() []controlsrv.ProcessorInfo {
+	res := make([]controlsrv.ProcessorInfo, 0, len(s.processors))
+
+	for _, p := range s.processors {
+		running, capacity := p.pool.PoolState()
+
+		res = append(res, controlsrv.ProcessorInfo{
+			Name:     p.name,
+			Running:  running,
+			Capacity: capacity,
+			Pausable: p.pausable,
+			Paused:   p.paused.Load(),
+		})
+	}
+
+	return res
+}
+
+// SetProcessorPaused pauses or resumes event processing
+// by the processor with the given name.
+func (s *Server) SetProcessorPaused(name string, paused bool) error {
+	for _, p := range s.processors {
+		if p.name == name {
+			if !p.pausable {
+				return fmt.Errorf("%w: %s", controlsrv.ErrNotPausableProcessor, name)
+			}
+
+			p.paused.Store(paused)
+
+			s.log.Info("event processor state changed",
+				zap.String("processor", name),
+				zap.Bool("paused", paused),
+			)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s", controlsrv.ErrUnknownProcessor, name)
+}
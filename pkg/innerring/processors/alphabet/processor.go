@@ -106,3 +106,9 @@ func (ap *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (ap *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns number of the running workers
+// and capacity of the processor's worker pool.
+func (ap *Processor) PoolState() (running, capacity int) {
+	return ap.pool.Running(), ap.pool.Cap()
+}
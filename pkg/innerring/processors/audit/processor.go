@@ -178,3 +178,9 @@ func (r *epochAuditReporter) WriteReport(rep *audit.Report) error {
 
 	return r.rep.WriteReport(rep)
 }
+
+// PoolState returns number of the running workers
+// and capacity of the processor's worker pool.
+func (ap *Processor) PoolState() (running, capacity int) {
+	return ap.pool.Running(), ap.pool.Cap()
+}
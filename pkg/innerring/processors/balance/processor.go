@@ -114,3 +114,9 @@ func (bp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (bp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns number of the running workers
+// and capacity of the processor's worker pool.
+func (bp *Processor) PoolState() (running, capacity int) {
+	return bp.pool.Running(), bp.pool.Cap()
+}
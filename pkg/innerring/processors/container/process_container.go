@@ -104,6 +104,19 @@ func (cp *Processor) checkPutContainer(ctx *putContainerContext) error {
 		return fmt.Errorf("incorrect container format: %w", err)
 	}
 
+	// check placement policy against the current network map
+	if cp.checkPlacement {
+		nm, err := cp.netState.Snapshot()
+		if err != nil {
+			return fmt.Errorf("could not get network map: %w", err)
+		}
+
+		err = container.CheckPlacement(cnr, nm)
+		if err != nil {
+			return fmt.Errorf("incorrect placement policy: %w", err)
+		}
+	}
+
 	// unmarshal session token if presented
 	tok, err := tokenFromEvent(e)
 	if err != nil {
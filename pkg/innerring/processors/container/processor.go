@@ -10,6 +10,7 @@ import (
 	morphsubnet "github.com/nspcc-dev/neofs-node/pkg/morph/client/subnet"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
 	containerEvent "github.com/nspcc-dev/neofs-node/pkg/morph/event/container"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
 )
@@ -30,6 +31,7 @@ type (
 		subnetClient   *morphsubnet.Client
 		netState       NetworkState
 		notaryDisabled bool
+		checkPlacement bool
 	}
 
 	// Params of the processor constructor.
@@ -42,6 +44,7 @@ type (
 		SubnetClient    *morphsubnet.Client
 		NetworkState    NetworkState
 		NotaryDisabled  bool
+		CheckPlacement  bool
 	}
 )
 
@@ -53,6 +56,12 @@ type NetworkState interface {
 	// Must return any error encountered
 	// which did not allow reading the value.
 	Epoch() (uint64, error)
+
+	// Snapshot must return current network map.
+	//
+	// Must return any error encountered
+	// which did not allow reading the value.
+	Snapshot() (*netmap.Netmap, error)
 }
 
 const (
@@ -95,6 +104,7 @@ func New(p *Params) (*Processor, error) {
 		netState:       p.NetworkState,
 		notaryDisabled: p.NotaryDisabled,
 		subnetClient:   p.SubnetClient,
+		checkPlacement: p.CheckPlacement,
 	}, nil
 }
 
@@ -233,3 +243,9 @@ func (cp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (cp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns number of the running workers
+// and capacity of the processor's worker pool.
+func (cp *Processor) PoolState() (running, capacity int) {
+	return cp.pool.Running(), cp.pool.Cap()
+}
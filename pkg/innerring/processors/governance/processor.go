@@ -169,3 +169,9 @@ func (gp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (gp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns number of the running workers
+// and capacity of the processor's worker pool.
+func (gp *Processor) PoolState() (running, capacity int) {
+	return gp.pool.Running(), gp.pool.Cap()
+}
@@ -229,3 +229,9 @@ func (np *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (np *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns number of the running workers
+// and capacity of the processor's worker pool.
+func (np *Processor) PoolState() (running, capacity int) {
+	return np.pool.Running(), np.pool.Cap()
+}
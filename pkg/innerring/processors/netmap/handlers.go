@@ -2,6 +2,7 @@ package netmap
 
 import (
 	"encoding/hex"
+	"fmt"
 
 	timerEvent "github.com/nspcc-dev/neofs-node/pkg/innerring/timers"
 	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
@@ -24,6 +25,35 @@ func (np *Processor) HandleNewEpochTick(ev event.Event) {
 	}
 }
 
+// TickEpoch requests new epoch by invoking new epoch method in network
+// map contract in the worker pool and returns the number of the requested
+// epoch. Returns an error if the pool is drained or the invocation fails.
+//
+// Method does not wait for the new epoch. In notary environment the epoch
+// is changed once the alphabet quorum requests the same epoch, so a tick
+// of a single node takes effect only along with the ticks of the other
+// alphabet nodes.
+func (np *Processor) TickEpoch() (uint64, error) {
+	type tickResult struct {
+		epoch uint64
+		err   error
+	}
+
+	res := make(chan tickResult, 1)
+
+	err := np.pool.Submit(func() {
+		epoch, err := np.tickEpoch()
+		res <- tickResult{epoch: epoch, err: err}
+	})
+	if err != nil {
+		return 0, fmt.Errorf("netmap worker pool drained: %w", err)
+	}
+
+	r := <-res
+
+	return r.epoch, r.err
+}
+
 func (np *Processor) handleNewEpoch(ev event.Event) {
 	epochEvent := ev.(netmapEvent.NewEpoch)
 	np.log.Info("notification",
@@ -0,0 +1,46 @@
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+)
+
+// ErrFutureMaintenance is returned when the node claims
+// maintenance started in one of the next epochs.
+var ErrFutureMaintenance = errors.New("maintenance started in the future epoch")
+
+// ErrMaintenanceExpired is returned when the node stays in maintenance
+// longer than allowed by the network configuration.
+var ErrMaintenanceExpired = errors.New("maintenance duration exceeded")
+
+// VerifyAndUpdate checks the maintenance mark of the node.
+// Nodes in maintenance are allowed to enter the network map
+// only during the number of epochs set in network configuration.
+func (v *Validator) VerifyAndUpdate(n *netmap.NodeInfo) error {
+	start, ok, err := netmapcore.MaintenanceEpoch(n)
+	if err != nil {
+		return err
+	} else if !ok {
+		return nil
+	}
+
+	epoch := v.epochState.EpochCounter()
+	if start > epoch {
+		return fmt.Errorf("%w: %d > %d", ErrFutureMaintenance, start, epoch)
+	}
+
+	dur, err := v.netCfg.MaintenanceDuration()
+	if err != nil {
+		return fmt.Errorf("could not get maintenance duration: %w", err)
+	}
+
+	if netmapcore.MaintenanceExpired(start, epoch, dur) {
+		return fmt.Errorf("%w: started in %d, current epoch %d, limit %d",
+			ErrMaintenanceExpired, start, epoch, dur)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package maintenance_test
+
+import (
+	"errors"
+	"testing"
+
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/netmap/nodevalidation/maintenance"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+type epochState uint64
+
+func (x epochState) EpochCounter() uint64 {
+	return uint64(x)
+}
+
+type netCfg struct {
+	dur uint64
+	err error
+}
+
+func (x netCfg) MaintenanceDuration() (uint64, error) {
+	return x.dur, x.err
+}
+
+func TestValidator_VerifyAndUpdate(t *testing.T) {
+	const (
+		epoch = 10
+		dur   = 3
+	)
+
+	v, err := maintenance.New(maintenance.Prm{
+		EpochState:    epochState(epoch),
+		NetworkConfig: netCfg{dur: dur},
+	})
+	require.NoError(t, err)
+
+	t.Run("no maintenance", func(t *testing.T) {
+		require.NoError(t, v.VerifyAndUpdate(netmap.NewNodeInfo()))
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		n := netmap.NewNodeInfo()
+
+		a := netmap.NewNodeAttribute()
+		a.SetKey(netmapcore.AttrMaintenance)
+		a.SetValue("not a number")
+		n.SetAttributes(a)
+
+		require.Error(t, v.VerifyAndUpdate(n))
+	})
+
+	for _, tc := range []struct {
+		name  string
+		start uint64
+		err   error
+	}{
+		{name: "current epoch", start: epoch},
+		{name: "within limit", start: epoch - dur},
+		{name: "future epoch", start: epoch + 1, err: maintenance.ErrFutureMaintenance},
+		{name: "expired", start: epoch - dur - 1, err: maintenance.ErrMaintenanceExpired},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			n := netmap.NewNodeInfo()
+			netmapcore.SetMaintenance(n, tc.start)
+
+			err := v.VerifyAndUpdate(n)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+
+	t.Run("network config failure", func(t *testing.T) {
+		v, err := maintenance.New(maintenance.Prm{
+			EpochState:    epochState(epoch),
+			NetworkConfig: netCfg{err: errors.New("any error")},
+		})
+		require.NoError(t, err)
+
+		n := netmap.NewNodeInfo()
+		netmapcore.SetMaintenance(n, epoch)
+
+		require.Error(t, v.VerifyAndUpdate(n))
+	})
+}
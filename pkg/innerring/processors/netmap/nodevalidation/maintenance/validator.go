@@ -0,0 +1,57 @@
+package maintenance
+
+import (
+	"errors"
+)
+
+// EpochState is an interface of the source of the current epoch number.
+type EpochState interface {
+	EpochCounter() uint64
+}
+
+// NetworkConfig is an interface of the source of the network-wide
+// maintenance duration limit.
+type NetworkConfig interface {
+	MaintenanceDuration() (uint64, error)
+}
+
+// Validator is an utility that verifies maintenance mark
+// of the storage node.
+//
+// For correct operation, Validator must be created
+// using the constructor (New). After successful creation,
+// the Validator is immediately ready to work through API.
+type Validator struct {
+	epochState EpochState
+
+	netCfg NetworkConfig
+}
+
+// Prm groups the required parameters of the Validator's constructor.
+//
+// All values must comply with the requirements imposed on them.
+// Passing incorrect parameter values will result in constructor
+// failure (error or panic depending on the implementation).
+type Prm struct {
+	EpochState EpochState
+
+	NetworkConfig NetworkConfig
+}
+
+// New creates a new instance of the Validator.
+//
+// The created Validator does not require additional
+// initialization and is completely ready for work.
+func New(prm Prm) (*Validator, error) {
+	switch {
+	case prm.EpochState == nil:
+		return nil, errors.New("ir/nodeValidator: epoch state is not set")
+	case prm.NetworkConfig == nil:
+		return nil, errors.New("ir/nodeValidator: network config is not set")
+	}
+
+	return &Validator{
+		epochState: prm.EpochState,
+		netCfg:     prm.NetworkConfig,
+	}, nil
+}
@@ -0,0 +1,158 @@
+package reachability
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	netmapV2 "github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	rpcapi "github.com/nspcc-dev/neofs-api-go/v2/rpc"
+	rpcclient "github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	"github.com/nspcc-dev/neofs-node/pkg/network"
+	netmapService "github.com/nspcc-dev/neofs-node/pkg/services/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/client"
+	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+)
+
+// ErrUnreachable is returned when the candidate can not be
+// reached through one of the announced addresses.
+var ErrUnreachable = errors.New("node is unreachable")
+
+// ErrInfoMismatch is returned when the information returned by
+// the candidate does not match the announced one.
+var ErrInfoMismatch = errors.New("node info mismatch")
+
+// VerifyAndUpdate dials all the announced addresses of the node and
+// requests the information the node announces to the network map.
+// Each response must be signed with the announced key, and must contain
+// the same public key and all the announced attributes with the same values.
+//
+// The whole verification is limited by the request timeout.
+//
+// Must be called before the validators which change the node attributes.
+func (v *Validator) VerifyAndUpdate(n *netmap.NodeInfo) error {
+	var ag network.AddressGroup
+
+	err := ag.FromIterator(n)
+	if err != nil {
+		return fmt.Errorf("could not parse network addresses: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), v.reqTimeout)
+	defer cancel()
+
+	ag.IterateAddresses(func(addr network.Address) bool {
+		err = v.verifyAddress(ctx, addr, n)
+		return err != nil
+	})
+
+	return err
+}
+
+func (v *Validator) verifyAddress(ctx context.Context, addr network.Address, n *netmap.NodeInfo) error {
+	opts := []client.Option{
+		client.WithAddress(addr.HostAddr()),
+		client.WithDialTimeout(v.dialTimeout),
+		client.WithDefaultPrivateKey(v.key),
+	}
+
+	if addr.TLSEnabled() {
+		opts = append(opts, client.WithTLSConfig(&tls.Config{}))
+	}
+
+	c, err := client.New(opts...)
+	if err != nil {
+		return fmt.Errorf("could not create client for %s: %w", addr, err)
+	}
+
+	defer func() {
+		if conn := c.Conn(); conn != nil {
+			_ = conn.Close()
+		}
+	}()
+
+	actual, err := v.announcedInfo(ctx, c, n.PublicKey())
+	if err != nil {
+		return fmt.Errorf("%s: %w", addr, err)
+	}
+
+	err = verifyNodeInfo(n, actual)
+	if err != nil {
+		return fmt.Errorf("%s: %w", addr, err)
+	}
+
+	return nil
+}
+
+// announcedInfo requests the information which the node announces to
+// the network map. Network map information returned by default can be
+// outdated if the node changed its attributes since the last epoch.
+func (v *Validator) announcedInfo(ctx context.Context, c *client.Client, key []byte) (*netmap.NodeInfo, error) {
+	xhdr := new(session.XHeader)
+	xhdr.SetKey(netmapService.XHeaderAnnouncedNodeInfo)
+	xhdr.SetValue("true")
+
+	meta := new(session.RequestMetaHeader)
+	meta.SetVersion(version.Current().ToV2())
+	meta.SetTTL(1)
+	meta.SetXHeaders([]*session.XHeader{xhdr})
+
+	req := new(netmapV2.LocalNodeInfoRequest)
+	req.SetBody(new(netmapV2.LocalNodeInfoRequestBody))
+	req.SetMetaHeader(meta)
+
+	if err := signature.SignServiceMessage(v.key, req); err != nil {
+		return nil, fmt.Errorf("could not sign request: %w", err)
+	}
+
+	resp, err := rpcapi.LocalNodeInfo(c.Raw(), req, rpcclient.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnreachable, err)
+	}
+
+	if err := signature.VerifyServiceMessage(resp); err != nil {
+		return nil, fmt.Errorf("%w: invalid response signature: %v", ErrInfoMismatch, err)
+	}
+
+	if !bytes.Equal(resp.GetVerificationHeader().GetBodySignature().GetKey(), key) {
+		return nil, fmt.Errorf("%w: response is signed with a different key", ErrInfoMismatch)
+	}
+
+	if err := apistatus.ErrFromStatus(apistatus.FromStatusV2(resp.GetMetaHeader().GetStatus())); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnreachable, err)
+	}
+
+	return netmap.NewNodeInfoFromV2(resp.GetBody().GetNodeInfo()), nil
+}
+
+// verifyNodeInfo checks that actual node information has the same public
+// key and contains all the announced attributes.
+func verifyNodeInfo(announced, actual *netmap.NodeInfo) error {
+	if actual == nil {
+		return fmt.Errorf("%w: missing node info", ErrInfoMismatch)
+	}
+
+	if !bytes.Equal(announced.PublicKey(), actual.PublicKey()) {
+		return fmt.Errorf("%w: public key", ErrInfoMismatch)
+	}
+
+	attrs := make(map[string]string, len(actual.Attributes()))
+
+	for _, a := range actual.Attributes() {
+		attrs[a.Key()] = a.Value()
+	}
+
+	for _, a := range announced.Attributes() {
+		if val, ok := attrs[a.Key()]; !ok || val != a.Value() {
+			return fmt.Errorf("%w: attribute %s", ErrInfoMismatch, a.Key())
+		}
+	}
+
+	return nil
+}
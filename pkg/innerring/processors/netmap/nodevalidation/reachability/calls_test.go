@@ -0,0 +1,139 @@
+package reachability_test
+
+import (
+	"crypto/ecdsa"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	netmapV2 "github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	netmapGRPC "github.com/nspcc-dev/neofs-api-go/v2/netmap/grpc"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/netmap/nodevalidation/reachability"
+	netmapTransportGRPC "github.com/nspcc-dev/neofs-node/pkg/network/transport/netmap/grpc"
+	netmapService "github.com/nspcc-dev/neofs-node/pkg/services/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// standIn serves NeoFS API Netmap service of the storage node.
+// Information from the network map is always outdated.
+type standIn struct {
+	info *netmap.NodeInfo
+}
+
+func (x standIn) LocalNodeInfo() (*netmapV2.NodeInfo, error) {
+	return new(netmapV2.NodeInfo), nil
+}
+
+func (x standIn) AnnouncedNodeInfo() (*netmapV2.NodeInfo, error) {
+	return x.info.ToV2(), nil
+}
+
+func (x standIn) Dump(*refs.Version) (*netmapV2.NetworkInfo, error) {
+	return new(netmapV2.NetworkInfo), nil
+}
+
+// startStandIn starts local gRPC server which signs the responses
+// with the given key and returns node info with the given attributes.
+// Returns announced network address of the server.
+func startStandIn(t *testing.T, key *ecdsa.PrivateKey, info *netmap.NodeInfo) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := grpc.NewServer()
+
+	netmapGRPC.RegisterNetmapServiceServer(srv, netmapTransportGRPC.New(
+		netmapService.NewSignService(key,
+			netmapService.NewExecutionService(standIn{info: info}, version.Current(), standIn{}),
+		),
+	))
+
+	go func() { _ = srv.Serve(lis) }()
+
+	t.Cleanup(srv.Stop)
+
+	return "/ip4/127.0.0.1/tcp/" + strconv.Itoa(lis.Addr().(*net.TCPAddr).Port)
+}
+
+func newKey(t *testing.T) *keys.PrivateKey {
+	k, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	return k
+}
+
+func nodeInfo(key *keys.PrivateKey, addr string, attrs ...string) *netmap.NodeInfo {
+	n := netmap.NewNodeInfo()
+	n.SetPublicKey(key.PublicKey().Bytes())
+
+	if addr != "" {
+		n.SetAddresses(addr)
+	}
+
+	as := make([]*netmap.NodeAttribute, 0, len(attrs)/2)
+
+	for i := 0; i < len(attrs); i += 2 {
+		a := netmap.NewNodeAttribute()
+		a.SetKey(attrs[i])
+		a.SetValue(attrs[i+1])
+
+		as = append(as, a)
+	}
+
+	n.SetAttributes(as...)
+
+	return n
+}
+
+func TestValidator_VerifyAndUpdate(t *testing.T) {
+	v, err := reachability.New(reachability.Prm{
+		Key:            &newKey(t).PrivateKey,
+		DialTimeout:    time.Second,
+		RequestTimeout: time.Second,
+	})
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		key := newKey(t)
+		addr := startStandIn(t, &key.PrivateKey, nodeInfo(key, "", "Price", "10", "Capacity", "100"))
+
+		require.NoError(t, v.VerifyAndUpdate(nodeInfo(key, addr, "Price", "10")))
+	})
+
+	t.Run("different key", func(t *testing.T) {
+		key, other := newKey(t), newKey(t)
+		addr := startStandIn(t, &other.PrivateKey, nodeInfo(other, ""))
+
+		err := v.VerifyAndUpdate(nodeInfo(key, addr))
+		require.ErrorIs(t, err, reachability.ErrInfoMismatch)
+	})
+
+	t.Run("different attributes", func(t *testing.T) {
+		key := newKey(t)
+		addr := startStandIn(t, &key.PrivateKey, nodeInfo(key, "", "Price", "10"))
+
+		err := v.VerifyAndUpdate(nodeInfo(key, addr, "Price", "20"))
+		require.ErrorIs(t, err, reachability.ErrInfoMismatch)
+
+		err = v.VerifyAndUpdate(nodeInfo(key, addr, "Price", "10", "Capacity", "100"))
+		require.ErrorIs(t, err, reachability.ErrInfoMismatch)
+	})
+
+	t.Run("unreachable", func(t *testing.T) {
+		key := newKey(t)
+
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+
+		addr := "/ip4/127.0.0.1/tcp/" + strconv.Itoa(lis.Addr().(*net.TCPAddr).Port)
+		require.NoError(t, lis.Close())
+
+		err = v.VerifyAndUpdate(nodeInfo(key, addr))
+		require.ErrorIs(t, err, reachability.ErrUnreachable)
+	})
+}
@@ -0,0 +1,77 @@
+package reachability
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultDialTimeout is a default timeout of the connection
+	// establishment with the candidate.
+	DefaultDialTimeout = 5 * time.Second
+
+	// DefaultRequestTimeout is a default timeout of the candidate
+	// verification through all the announced addresses.
+	DefaultRequestTimeout = 10 * time.Second
+)
+
+// Validator is an utility that verifies the network map candidate
+// is reachable through all the announced addresses and is served
+// with the announced key.
+//
+// For correct operation, Validator must be created
+// using the constructor (New). After successful creation,
+// the Validator is immediately ready to work through API.
+type Validator struct {
+	key *ecdsa.PrivateKey
+
+	dialTimeout time.Duration
+
+	reqTimeout time.Duration
+}
+
+// Prm groups the required parameters of the Validator's constructor.
+//
+// All values must comply with the requirements imposed on them.
+// Passing incorrect parameter values will result in constructor
+// failure (error or panic depending on the implementation).
+type Prm struct {
+	// Key to sign the requests.
+	Key *ecdsa.PrivateKey
+
+	// DialTimeout is a timeout of the connection establishment.
+	// DefaultDialTimeout is used if not positive.
+	DialTimeout time.Duration
+
+	// RequestTimeout is a timeout of the whole candidate verification.
+	// DefaultRequestTimeout is used if not positive.
+	RequestTimeout time.Duration
+}
+
+// New creates a new instance of the Validator.
+//
+// The created Validator does not require additional
+// initialization and is completely ready for work.
+func New(prm Prm) (*Validator, error) {
+	switch {
+	case prm.Key == nil:
+		return nil, errors.New("ir/nodeValidator: private key is not set")
+	}
+
+	v := &Validator{
+		key:         prm.Key,
+		dialTimeout: prm.DialTimeout,
+		reqTimeout:  prm.RequestTimeout,
+	}
+
+	if v.dialTimeout <= 0 {
+		v.dialTimeout = DefaultDialTimeout
+	}
+
+	if v.reqTimeout <= 0 {
+		v.reqTimeout = DefaultRequestTimeout
+	}
+
+	return v, nil
+}
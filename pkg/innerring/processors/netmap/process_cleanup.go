@@ -1,7 +1,11 @@
 package netmap
 
 import (
+	"encoding/hex"
+
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	netmapclient "github.com/nspcc-dev/neofs-node/pkg/morph/client/netmap"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"go.uber.org/zap"
@@ -43,3 +47,47 @@ func (np *Processor) processNetmapCleanupTick(ev netmapCleanupTick) {
 			zap.String("error", err.Error()))
 	}
 }
+
+// expireMaintenance votes to remove the nodes which stay in maintenance
+// mode longer than allowed by network configuration.
+func (np *Processor) expireMaintenance(nm *netmap.Netmap, epoch uint64, txHash util.Uint256) {
+	if !np.alphabetState.IsAlphabet() {
+		return
+	}
+
+	dur, err := np.netmapClient.MaintenanceDuration()
+	if err != nil {
+		np.log.Warn("can't get maintenance duration",
+			zap.String("error", err.Error()))
+
+		return
+	}
+
+	for i := range nm.Nodes {
+		start, ok, err := netmapcore.MaintenanceEpoch(nm.Nodes[i].NodeInfo)
+		if !ok {
+			continue
+		}
+
+		// nodes with invalid maintenance mark are removed as well
+		if err == nil && !netmapcore.MaintenanceExpired(start, epoch, dur) {
+			continue
+		}
+
+		key := nm.Nodes[i].PublicKey()
+
+		np.log.Info("vote to remove node with expired maintenance from netmap",
+			zap.String("key", hex.EncodeToString(key)))
+
+		prm := netmapclient.UpdatePeerPrm{}
+
+		prm.SetKey(key)
+		prm.SetState(netmap.NodeStateOffline)
+		prm.SetHash(txHash)
+
+		err = np.netmapClient.UpdatePeerState(prm)
+		if err != nil {
+			np.log.Error("can't invoke netmap.UpdateState", zap.Error(err))
+		}
+	}
+}
@@ -53,6 +53,7 @@ func (np *Processor) processNewEpoch(ev netmapEvent.NewEpoch) {
 	}
 
 	np.netmapSnapshot.update(networkMap, epoch)
+	np.expireMaintenance(networkMap, epoch, ev.TxHash())
 	np.handleCleanupTick(netmapCleanupTick{epoch: epoch, txHash: ev.TxHash()})
 	np.handleNewAudit(audit.NewAuditStartEvent(epoch))
 	np.handleAuditSettlements(settlement.NewAuditEvent(epoch))
@@ -67,11 +68,16 @@ func (np *Processor) processNewEpochTick() {
 		return
 	}
 
+	if _, err := np.tickEpoch(); err != nil {
+		np.log.Error("can't invoke netmap.NewEpoch", zap.Error(err))
+	}
+}
+
+// tickEpoch invokes new epoch method in network map contract
+// and returns the number of the requested epoch.
+func (np *Processor) tickEpoch() (uint64, error) {
 	nextEpoch := np.epochState.EpochCounter() + 1
 	np.log.Debug("next epoch", zap.Uint64("value", nextEpoch))
 
-	err := np.netmapClient.NewEpoch(nextEpoch)
-	if err != nil {
-		np.log.Error("can't invoke netmap.NewEpoch", zap.Error(err))
-	}
+	return nextEpoch, np.netmapClient.NewEpoch(nextEpoch)
 }
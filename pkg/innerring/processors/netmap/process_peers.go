@@ -43,6 +43,27 @@ func (np *Processor) processAddPeer(ev netmapEvent.AddPeer) {
 		return
 	}
 
+	if np.validPool == nil {
+		np.approveCandidate(ev, nodeInfo)
+		return
+	}
+
+	// validation can take a while, so it is performed
+	// out of the main pool to not delay other events;
+	// the pool is blocking, so Submit waits for a free worker
+	err := np.validPool.Submit(func() {
+		np.approveCandidate(ev, nodeInfo)
+	})
+	if err != nil {
+		// pool is closed
+		np.log.Warn("could not submit network map candidate for validation",
+			zap.String("error", err.Error()))
+	}
+}
+
+// approveCandidate validates network map candidate and
+// sends approval tx to the smart contract.
+func (np *Processor) approveCandidate(ev netmapEvent.AddPeer, nodeInfo *netmap.NodeInfo) {
 	// validate and update node info
 	err := np.nodeValidator.VerifyAndUpdate(nodeInfo)
 	if err != nil {
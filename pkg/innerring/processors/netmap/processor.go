@@ -55,6 +55,7 @@ type (
 	Processor struct {
 		log           *zap.Logger
 		pool          *ants.Pool
+		validPool     *ants.Pool
 		epochTimer    EpochTimerReseter
 		epochState    EpochState
 		alphabetState AlphabetState
@@ -96,6 +97,12 @@ type (
 
 		NodeValidator NodeValidator
 
+		// Size of the pool of network map candidate validation workers.
+		// Should be set if validation includes network communication
+		// with the candidate to not delay other events. Candidates are
+		// validated in the main worker pool if not positive.
+		ValidationPoolSize int
+
 		NotaryDisabled bool
 	}
 )
@@ -141,9 +148,20 @@ func New(p *Params) (*Processor, error) {
 		return nil, fmt.Errorf("ir/netmap: can't create worker pool: %w", err)
 	}
 
+	var validPool *ants.Pool
+
+	if p.ValidationPoolSize > 0 {
+		// pool is blocking to queue candidates instead of dropping them
+		validPool, err = ants.NewPool(p.ValidationPoolSize)
+		if err != nil {
+			return nil, fmt.Errorf("ir/netmap: can't create validation worker pool: %w", err)
+		}
+	}
+
 	return &Processor{
 		log:            p.Log,
 		pool:           pool,
+		validPool:      validPool,
 		epochTimer:     p.EpochTimer,
 		epochState:     p.EpochState,
 		alphabetState:  p.AlphabetState,
@@ -291,3 +309,9 @@ func (np *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (np *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns number of the running workers
+// and capacity of the processor's worker pool.
+func (np *Processor) PoolState() (running, capacity int) {
+	return np.pool.Running(), np.pool.Cap()
+}
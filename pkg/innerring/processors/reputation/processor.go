@@ -152,3 +152,9 @@ func (rp *Processor) ListenerNotaryHandlers() []event.NotaryHandlerInfo {
 func (rp *Processor) TimersHandlers() []event.NotificationHandlerInfo {
 	return nil
 }
+
+// PoolState returns number of the running workers
+// and capacity of the processor's worker pool.
+func (rp *Processor) PoolState() (running, capacity int) {
+	return rp.pool.Running(), rp.pool.Cap()
+}
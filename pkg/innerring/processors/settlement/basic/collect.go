@@ -55,8 +55,8 @@ func (inc *IncomeSettlementContext) Collect() {
 			continue
 		}
 
-		avg := inc.avgEstimation(cnrEstimations[i]) // average container size per node
-		total := calculateBasicSum(avg, cachedRate, len(cnrNodes))
+		avg := AverageEstimation(cnrEstimations[i]) // average container size per node
+		total := CalculateBasicSum(avg, cachedRate, len(cnrNodes))
 
 		// fill distribute asset table
 		for i := range cnrNodes {
@@ -73,10 +73,10 @@ func (inc *IncomeSettlementContext) Collect() {
 	common.TransferAssets(inc.exchange, txTable, common.BasicIncomeCollectionDetails(inc.epoch))
 }
 
-// avgEstimation returns estimation value for single container. Right now it
+// AverageEstimation returns estimation value for single container. Right now it
 // simply calculates average of all announcements, however it can be smarter and
 // base result on reputation of announcers and clever math.
-func (inc *IncomeSettlementContext) avgEstimation(e *cntClient.Estimations) (avg uint64) {
+func AverageEstimation(e *cntClient.Estimations) (avg uint64) {
 	if len(e.Values) == 0 {
 		return 0
 	}
@@ -88,7 +88,10 @@ func (inc *IncomeSettlementContext) avgEstimation(e *cntClient.Estimations) (avg
 	return avg / uint64(len(e.Values))
 }
 
-func calculateBasicSum(size, rate uint64, ln int) *big.Int {
+// CalculateBasicSum returns the basic income payment for the storage of
+// the container of the given size per node on ln nodes with the rate per GB.
+// Payment is never less than 1.
+func CalculateBasicSum(size, rate uint64, ln int) *big.Int {
 	bigRate := big.NewInt(int64(rate))
 
 	total := size * uint64(ln)
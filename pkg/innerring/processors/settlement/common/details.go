@@ -1,9 +1,44 @@
 package common
 
 import (
+	"bytes"
 	"encoding/binary"
+	"errors"
 )
 
+// SettlementType is a type of the settlement transfer.
+type SettlementType uint8
+
+const (
+	_ SettlementType = iota
+
+	// AuditSettlement is a payment for the audit of the containers.
+	AuditSettlement
+
+	// BasicIncomeCollection is a payment for the storage of the containers.
+	BasicIncomeCollection
+
+	// BasicIncomeDistribution is a reward of the storage nodes for
+	// the storage of the containers.
+	BasicIncomeDistribution
+)
+
+// String returns string representation of the SettlementType.
+func (t SettlementType) String() string {
+	switch t {
+	case AuditSettlement:
+		return "audit"
+	case BasicIncomeCollection:
+		return "basic income collection"
+	case BasicIncomeDistribution:
+		return "basic income distribution"
+	default:
+		return "unknown"
+	}
+}
+
+var errUnknownDetails = errors.New("unknown settlement transfer details")
+
 var (
 	auditPrefix                   = []byte{0x40}
 	basicIncomeCollectionPrefix   = []byte{0x41}
@@ -31,3 +66,19 @@ func details(prefix []byte, epoch uint64) []byte {
 
 	return buf
 }
+
+// ParseDetails returns type and epoch of the settlement transfer
+// from the transfer details.
+func ParseDetails(data []byte) (SettlementType, uint64, error) {
+	for typ, prefix := range map[SettlementType][]byte{
+		AuditSettlement:         auditPrefix,
+		BasicIncomeCollection:   basicIncomeCollectionPrefix,
+		BasicIncomeDistribution: basicIncomeDistributionPrefix,
+	} {
+		if len(data) == len(prefix)+8 && bytes.HasPrefix(data, prefix) {
+			return typ, binary.LittleEndian.Uint64(data[len(prefix):]), nil
+		}
+	}
+
+	return 0, 0, errUnknownDetails
+}
@@ -26,3 +26,24 @@ func TestBasicIncomeDistributionDetails(t *testing.T) {
 	got := BasicIncomeDistributionDetails(n)
 	require.Equal(t, exp, got)
 }
+
+func TestParseDetails(t *testing.T) {
+	var n uint64 = 1994
+
+	for typ, details := range map[SettlementType][]byte{
+		AuditSettlement:         AuditSettlementDetails(n),
+		BasicIncomeCollection:   BasicIncomeCollectionDetails(n),
+		BasicIncomeDistribution: BasicIncomeDistributionDetails(n),
+	} {
+		gotType, gotEpoch, err := ParseDetails(details)
+		require.NoError(t, err)
+		require.Equal(t, typ, gotType)
+		require.Equal(t, n, gotEpoch)
+	}
+
+	_, _, err := ParseDetails([]byte{0x43, 0xCA, 0x07, 0, 0, 0, 0, 0, 0})
+	require.Error(t, err)
+
+	_, _, err = ParseDetails([]byte{0x40, 0xCA})
+	require.Error(t, err)
+}
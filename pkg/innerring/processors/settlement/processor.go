@@ -5,7 +5,6 @@ import (
 	"sync"
 
 	"github.com/nspcc-dev/neofs-node/pkg/innerring/processors/settlement/basic"
-	nodeutil "github.com/nspcc-dev/neofs-node/pkg/util"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
 	"github.com/panjf2000/ants/v2"
 	"go.uber.org/zap"
@@ -23,7 +22,7 @@ type (
 
 		state AlphabetState
 
-		pool nodeutil.WorkerPool
+		pool *ants.Pool
 
 		auditProc AuditProcessor
 
@@ -76,3 +75,9 @@ func New(prm Prm, opts ...Option) *Processor {
 		incomeContexts: make(map[uint64]*basic.IncomeSettlementContext),
 	}
 }
+
+// PoolState returns number of the running workers
+// and capacity of the processor's worker pool.
+func (p *Processor) PoolState() (running, capacity int) {
+	return p.pool.Running(), p.pool.Cap()
+}
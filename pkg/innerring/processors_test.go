@@ -0,0 +1,54 @@
+package innerring
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	controlsrv "github.com/nspcc-dev/neofs-node/pkg/services/control/ir/server"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type testPool struct {
+	running, capacity int
+}
+
+func (x testPool) PoolState() (int, int) {
+	return x.running, x.capacity
+}
+
+type testEvent struct{}
+
+func (testEvent) MorphEvent() {}
+
+func TestServer_SetProcessorPaused(t *testing.T) {
+	s := &Server{log: zap.NewNop()}
+
+	s.registerProcessor("netmap", testPool{running: 1, capacity: 10}, false)
+	audit := s.registerProcessor("audit", testPool{capacity: 5}, true)
+
+	var handled int
+
+	h := audit.wrapHandler(func(event.Event) { handled++ })
+
+	h(testEvent{})
+	require.Equal(t, 1, handled)
+
+	require.ErrorIs(t, s.SetProcessorPaused("unknown", true), controlsrv.ErrUnknownProcessor)
+	require.ErrorIs(t, s.SetProcessorPaused("netmap", true), controlsrv.ErrNotPausableProcessor)
+
+	require.NoError(t, s.SetProcessorPaused("audit", true))
+	require.Equal(t, []controlsrv.ProcessorInfo{
+		{Name: "netmap", Running: 1, Capacity: 10},
+		{Name: "audit", Capacity: 5, Pausable: true, Paused: true},
+	}, s.ListProcessors())
+
+	// events are skipped while processor is paused
+	h(testEvent{})
+	require.Equal(t, 1, handled)
+
+	require.NoError(t, s.SetProcessorPaused("audit", false))
+
+	h(testEvent{})
+	require.Equal(t, 2, handled)
+}
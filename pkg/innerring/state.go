@@ -9,6 +9,7 @@ import (
 	auditClient "github.com/nspcc-dev/neofs-node/pkg/morph/client/audit"
 	"github.com/nspcc-dev/neofs-node/pkg/services/audit"
 	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+	controlsrv "github.com/nspcc-dev/neofs-node/pkg/services/control/ir/server"
 	"github.com/nspcc-dev/neofs-node/pkg/util/state"
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
@@ -46,6 +47,21 @@ func (s *Server) SetEpochDuration(val uint64) {
 	s.epochDuration.Store(val)
 }
 
+// TickEpoch requests the new epoch and returns its number. The epoch is not
+// changed until the request is accepted by the side chain: in notary
+// environment the alphabet quorum must request the same epoch, so the tick
+// of a single node takes effect only along with the ticks of the other
+// alphabet nodes.
+//
+// Returns controlsrv.ErrNotAlphabet if the node is not an alphabet one.
+func (s *Server) TickEpoch() (uint64, error) {
+	if !s.IsAlphabet() {
+		return 0, controlsrv.ErrNotAlphabet
+	}
+
+	return s.netmapProcessor.TickEpoch()
+}
+
 // IsActive is a getter for a global active flag state.
 func (s *Server) IsActive() bool {
 	return s.InnerRingIndex() >= 0
@@ -1,7 +1,6 @@
 package blobstor
 
 import (
-	"bytes"
 	"errors"
 	"fmt"
 	"path/filepath"
@@ -785,10 +784,6 @@ func (b *blobovniczas) init() error {
 	if err != nil {
 		return fmt.Errorf("could not create zstd compressor: %v", err)
 	}
-	zstdD, err := zstdDecompressor()
-	if err != nil {
-		return fmt.Errorf("could not create zstd decompressor: %v", err)
-	}
 
 	// Compression is always done based on config settings.
 	if b.compressionEnabled {
@@ -799,15 +794,9 @@ func (b *blobovniczas) init() error {
 
 	// However we should be able to read any object
 	// we have previously written.
-	b.decompressor = func(data []byte) ([]byte, error) {
-		// Fallback to reading decompressed objects.
-		// For normal objects data is always bigger than 4 bytes, the first check is here
-		// because function interface is rather generic (Go compiler inserts bound
-		// checks anyway).
-		if len(data) < 4 || !bytes.Equal(data[:4], zstdFrameMagic) {
-			return noOpDecompressor(data)
-		}
-		return zstdD(data)
+	b.decompressor, err = Decompressor()
+	if err != nil {
+		return fmt.Errorf("could not create zstd decompressor: %v", err)
 	}
 
 	return b.iterateBlobovniczas(false, func(p string, blz *blobovnicza.Blobovnicza) error {
@@ -45,6 +45,12 @@ type cfg struct {
 	blzRootPath string
 
 	blzOpts []blobovnicza.Option
+
+	dedupEnabled bool
+
+	payloadRefs PayloadReferences
+
+	payloads payloadStore
 }
 
 const (
@@ -74,6 +80,10 @@ func defaultCfg() *cfg {
 		openedCacheSize: defaultOpenedCacheSize,
 		blzShallowDepth: defaultBlzShallowDepth,
 		blzShallowWidth: defaultBlzShallowWidth,
+		payloads: payloadStore{
+			rootPath: payloadDir,
+			perm:     defaultPerm,
+		},
 	}
 }
 
@@ -133,6 +143,7 @@ func WithRootPath(rootDir string) Option {
 	return func(c *cfg) {
 		c.fsTree.RootPath = rootDir
 		c.blzRootPath = filepath.Join(rootDir, blobovniczaDir)
+		c.payloads.rootPath = filepath.Join(rootDir, payloadDir)
 	}
 }
 
@@ -141,6 +152,7 @@ func WithRootPath(rootDir string) Option {
 func WithRootPerm(perm fs.FileMode) Option {
 	return func(c *cfg) {
 		c.fsTree.Permissions = perm
+		c.payloads.perm = perm
 		c.blzOpts = append(c.blzOpts, blobovnicza.WithPermissions(perm))
 	}
 }
@@ -1,6 +1,8 @@
 package blobstor
 
 import (
+	"bytes"
+
 	"github.com/klauspost/compress/zstd"
 )
 
@@ -37,3 +39,23 @@ func zstdDecompressor() (func([]byte) ([]byte, error), error) {
 		return dec.DecodeAll(data, nil)
 	}, nil
 }
+
+// Decompressor returns function which decompresses the object data stored
+// by BlobStor. Data stored without compression is returned as is.
+func Decompressor() (func([]byte) ([]byte, error), error) {
+	zstdD, err := zstdDecompressor()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(data []byte) ([]byte, error) {
+		// Fallback to reading decompressed objects.
+		// For normal objects data is always bigger than 4 bytes, the first check is here
+		// because function interface is rather generic (Go compiler inserts bound
+		// checks anyway).
+		if len(data) < 4 || !bytes.Equal(data[:4], zstdFrameMagic) {
+			return noOpDecompressor(data)
+		}
+		return zstdD(data)
+	}, nil
+}
@@ -72,6 +72,7 @@ func addressFromString(s string) (*addressSDK.Address, error) {
 // IterationPrm contains iteraction parameters.
 type IterationPrm struct {
 	handler      func(addr *addressSDK.Address, data []byte) error
+	lazyHandler  func(addr *addressSDK.Address, f func() ([]byte, error)) error
 	ignoreErrors bool
 }
 
@@ -81,6 +82,15 @@ func (p *IterationPrm) WithHandler(f func(addr *addressSDK.Address, data []byte)
 	return p
 }
 
+// WithLazyHandler sets a function to call on each object.
+// Object data is read only if f is called, so the handler can be used
+// to list stored objects without reading them.
+// Lazy handler takes precedence over the one set by WithHandler.
+func (p *IterationPrm) WithLazyHandler(f func(addr *addressSDK.Address, f func() ([]byte, error)) error) *IterationPrm {
+	p.lazyHandler = f
+	return p
+}
+
 // WithIgnoreErrors sets a flag indicating whether errors should be ignored.
 func (p *IterationPrm) WithIgnoreErrors(ignore bool) *IterationPrm {
 	p.ignoreErrors = ignore
@@ -127,6 +137,18 @@ func (t *FSTree) iterate(depth int, curPath []string, prm *IterationPrm) error {
 			continue
 		}
 
+		if prm.lazyHandler != nil {
+			p := filepath.Join(curPath...)
+			err := prm.lazyHandler(addr, func() ([]byte, error) {
+				return os.ReadFile(p)
+			})
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		data, err := os.ReadFile(filepath.Join(curPath...))
 		if err != nil {
 			if prm.ignoreErrors {
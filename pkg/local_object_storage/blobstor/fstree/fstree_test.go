@@ -119,6 +119,23 @@ func TestFSTree(t *testing.T) {
 			require.Equal(t, count-1, n)
 		})
 
+		t.Run("lazy handler", func(t *testing.T) {
+			n := 0
+			err := fs.Iterate(new(IterationPrm).WithLazyHandler(func(addr *addressSDK.Address, f func() ([]byte, error)) error {
+				n++
+				expected, ok := store[addr.String()]
+				require.True(t, ok, "object %s was not found", addr.String())
+
+				data, err := f()
+				require.NoError(t, err)
+				require.Equal(t, data, expected)
+				return nil
+			}))
+
+			require.NoError(t, err)
+			require.Equal(t, count, n)
+		})
+
 		t.Run("ignore errors", func(t *testing.T) {
 			n := 0
 
@@ -47,6 +47,10 @@ func (b *BlobStor) GetBig(prm *GetBigPrm) (*GetBigRes, error) {
 		return nil, fmt.Errorf("could not unmarshal the object: %w", err)
 	}
 
+	if err := b.attachPayload(obj); err != nil {
+		return nil, err
+	}
+
 	return &GetBigRes{
 		roObject: roObject{
 			obj: obj,
@@ -41,6 +41,10 @@ func (b *BlobStor) GetRangeBig(prm *GetRangeBigPrm) (*GetRangeBigRes, error) {
 		return nil, fmt.Errorf("could not unmarshal the object: %w", err)
 	}
 
+	if err := b.attachPayload(obj); err != nil {
+		return nil, err
+	}
+
 	payload := obj.Payload()
 	ln, off := prm.rng.GetLength(), prm.rng.GetOffset()
 
@@ -14,6 +14,8 @@ type IterationElement struct {
 	data []byte
 
 	blzID *blobovnicza.ID
+
+	dedup bool
 }
 
 // ObjectData returns stored object in a binary representation.
@@ -27,12 +29,19 @@ func (x IterationElement) BlobovniczaID() *blobovnicza.ID {
 	return x.blzID
 }
 
+// DeduplicatedPayload returns true if object payload is stored
+// in the deduplicated payload area.
+func (x IterationElement) DeduplicatedPayload() bool {
+	return x.dedup
+}
+
 // IterationHandler is a generic processor of IterationElement.
 type IterationHandler func(IterationElement) error
 
 // IteratePrm groups the parameters of Iterate operation.
 type IteratePrm struct {
 	handler      IterationHandler
+	errHandler   func(*addressSDK.Address, error) error
 	ignoreErrors bool
 }
 
@@ -44,6 +53,14 @@ func (i *IteratePrm) SetIterationHandler(h IterationHandler) {
 	i.handler = h
 }
 
+// SetErrorHandler sets the handler of the objects from the file tree which
+// can not be read, e.g. when the deduplicated payload of the object is lost.
+// Iteration continues if the handler returns nil. The handler is not called
+// if errors are ignored.
+func (i *IteratePrm) SetErrorHandler(f func(*addressSDK.Address, error) error) {
+	i.errHandler = f
+}
+
 // IgnoreErrors sets the flag signifying whether errors should be ignored.
 func (i *IteratePrm) IgnoreErrors() {
 	i.ignoreErrors = true
@@ -88,16 +105,34 @@ func (b *BlobStor) Iterate(prm IteratePrm) (*IterateRes, error) {
 
 	elem.blzID = nil
 
-	err = b.fsTree.Iterate(new(fstree.IterationPrm).WithHandler(func(_ *addressSDK.Address, data []byte) error {
+	dedup := b.payloads.inUse()
+
+	err = b.fsTree.Iterate(new(fstree.IterationPrm).WithHandler(func(addr *addressSDK.Address, data []byte) error {
 		// decompress the data
 		elem.data, err = b.decompressor(data)
 		if err != nil {
 			if prm.ignoreErrors {
 				return nil
+			} else if prm.errHandler != nil {
+				return prm.errHandler(addr, fmt.Errorf("could not decompress object data: %w", err))
 			}
 			return fmt.Errorf("could not decompress object data: %w", err)
 		}
 
+		elem.dedup = false
+
+		if dedup {
+			elem.data, elem.dedup, err = b.attachPayloadData(elem.data)
+			if err != nil {
+				if prm.ignoreErrors {
+					return nil
+				} else if prm.errHandler != nil {
+					return prm.errHandler(addr, err)
+				}
+				return err
+			}
+		}
+
 		return prm.handler(elem)
 	}).WithIgnoreErrors(prm.ignoreErrors))
 
@@ -0,0 +1,59 @@
+package blobstor
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// OrphanedBlobovniczas returns paths of the files in blobovnicza root
+// directory which do not belong to the configured blobovnicza tree
+// (e.g. left after the change of the tree depth or width). Paths are
+// relative to the root directory of the blobovnicza tree.
+//
+// Objects from the orphaned blobovniczas are not accessible through BlobStor.
+func (b *BlobStor) OrphanedBlobovniczas() ([]string, error) {
+	return b.blobovniczas.orphaned()
+}
+
+func (b *blobovniczas) orphaned() ([]string, error) {
+	leaves := make(map[string]struct{})
+
+	err := b.iterateLeaves(func(p string) (bool, error) {
+		leaves[p] = struct{}{}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+
+	err = filepath.WalkDir(b.blzRootPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) && p == b.blzRootPath {
+				return filepath.SkipDir
+			}
+
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(b.blzRootPath, p)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := leaves[rel]; !ok {
+			res = append(res, rel)
+		}
+
+		return nil
+	})
+
+	return res, err
+}
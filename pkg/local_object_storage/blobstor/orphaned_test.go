@@ -0,0 +1,53 @@
+package blobstor
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobStor_OrphanedBlobovniczas(t *testing.T) {
+	dir := t.TempDir()
+
+	newBlobStor := func(width, depth uint64) *BlobStor {
+		b := New(
+			WithRootPath(dir),
+			WithBlobovniczaShallowWidth(width),
+			WithBlobovniczaShallowDepth(depth),
+		)
+		require.NoError(t, b.Open())
+		require.NoError(t, b.Init())
+
+		return b
+	}
+
+	b := newBlobStor(2, 2)
+
+	orphaned, err := b.OrphanedBlobovniczas()
+	require.NoError(t, err)
+	require.Empty(t, orphaned)
+	require.NoError(t, b.Close())
+
+	// narrow the tree, blobovniczas of the last index become orphaned
+	b = newBlobStor(1, 2)
+	defer b.Close()
+
+	orphaned, err = b.OrphanedBlobovniczas()
+	require.NoError(t, err)
+	require.Len(t, orphaned, 8-1)
+	require.Contains(t, orphaned, filepath.Join("1", "1", "1"))
+	require.NotContains(t, orphaned, filepath.Join("0", "0", "0"))
+
+	t.Run("missing tree", func(t *testing.T) {
+		b := New(WithRootPath(filepath.Join(dir, "missing")))
+
+		orphaned, err := b.OrphanedBlobovniczas()
+		require.NoError(t, err)
+		require.Empty(t, orphaned)
+
+		_, err = os.Stat(filepath.Join(dir, "missing"))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
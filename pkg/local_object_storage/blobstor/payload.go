@@ -0,0 +1,282 @@
+package blobstor
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	storagelog "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/internal/log"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
+)
+
+// PayloadReferences is an interface of the storage of the references
+// to the deduplicated payloads.
+type PayloadReferences interface {
+	// ReferencePayload must register the object as the user of the payload
+	// with the SHA-256 checksum. Repeated calls for the same object must
+	// not lead to extra references.
+	ReferencePayload(*addressSDK.Address, [sha256.Size]byte) error
+
+	// DropUnreferencedPayload must return true and forget the payload if it
+	// is not referenced by any object.
+	DropUnreferencedPayload([sha256.Size]byte) (bool, error)
+}
+
+const payloadDir = "payload"
+
+// payloadStore is a content-addressed storage of the object payloads.
+// Payload is stored in the file named by the hex-encoded SHA-256 checksum.
+type payloadStore struct {
+	rootPath string
+
+	perm os.FileMode
+
+	// the lock of the checksum group serializes saving of the payload
+	// and its removal
+	mtx [256]sync.Mutex
+}
+
+// WithPayloadDeduplication returns option to toggle deduplication
+// of the "big" object payloads.
+//
+// If enabled, the payloads with SHA-256 checksum are stored once
+// per BlobStor and shared by the objects. Payload references are
+// tracked by the storage set via WithPayloadReferences, deduplication
+// is not performed without it.
+func WithPayloadDeduplication(v bool) Option {
+	return func(c *cfg) {
+		c.dedupEnabled = v
+	}
+}
+
+// WithPayloadReferences returns option to set the storage of the references
+// to the deduplicated payloads.
+func WithPayloadReferences(refs PayloadReferences) Option {
+	return func(c *cfg) {
+		c.payloadRefs = refs
+	}
+}
+
+// DeletePayload removes the deduplicated payload with the SHA-256 checksum
+// if it is not referenced by any object.
+//
+// Returns true if the payload is removed or is missing.
+func (b *BlobStor) DeletePayload(sum [sha256.Size]byte) (bool, error) {
+	if b.payloadRefs == nil {
+		return false, nil
+	}
+
+	mtx := b.payloads.lock(sum)
+	defer mtx.Unlock()
+
+	ok, err := b.payloadRefs.DropUnreferencedPayload(sum)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	err = os.Remove(b.payloads.path(sum))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	b.log.Debug("unreferenced payload removed",
+		zap.String("checksum", hex.EncodeToString(sum[:])),
+	)
+
+	return true, nil
+}
+
+// putDeduplicated saves the payload of the object in the payload store and
+// the header in the shallow dir. Returns false if the object is not subject
+// to deduplication.
+func (b *BlobStor) putDeduplicated(addr *addressSDK.Address, obj *object.Object, compress bool) (bool, error) {
+	sum, ok := deduplicatableChecksum(obj)
+	if !ok {
+		return false, nil
+	}
+
+	hdr, err := object.NewRawFromObject(obj).CutPayload().Marshal()
+	if err != nil {
+		return false, fmt.Errorf("could not marshal the object header: %w", err)
+	}
+
+	payload := obj.Payload()
+
+	if compress {
+		hdr = b.compressor(hdr)
+		payload = b.compressor(payload)
+	}
+
+	mtx := b.payloads.lock(sum)
+	defer mtx.Unlock()
+
+	if err := b.payloads.put(sum, payload); err != nil {
+		return false, fmt.Errorf("could not save the payload: %w", err)
+	}
+
+	if err := b.payloadRefs.ReferencePayload(addr, sum); err != nil {
+		return false, fmt.Errorf("could not reference the payload: %w", err)
+	}
+
+	if err := b.fsTree.Put(addr, hdr); err != nil {
+		return false, err
+	}
+
+	storagelog.Write(b.log, storagelog.AddressField(addr), storagelog.OpField("fstree deduplicated PUT"))
+
+	return true, nil
+}
+
+// attachPayload reads the deduplicated payload of the object
+// whose header is stored without payload.
+func (c *cfg) attachPayload(obj *object.Object) error {
+	sum, ok := detachedChecksum(obj)
+	if !ok {
+		return nil
+	}
+
+	data, err := c.payloads.get(sum)
+	if err != nil {
+		return fmt.Errorf("could not read deduplicated payload: %w", err)
+	}
+
+	data, err = c.decompressor(data)
+	if err != nil {
+		return fmt.Errorf("could not decompress deduplicated payload: %w", err)
+	}
+
+	if uint64(len(data)) != obj.PayloadSize() {
+		return fmt.Errorf("deduplicated payload size mismatch: %d instead of %d", len(data), obj.PayloadSize())
+	}
+
+	object.NewRawFromObject(obj).SetPayload(data)
+
+	return nil
+}
+
+// attachPayloadData attaches the deduplicated payload to the object
+// in a binary representation. Returns true if the payload has been attached.
+func (c *cfg) attachPayloadData(data []byte) ([]byte, bool, error) {
+	obj := object.New()
+	if err := obj.Unmarshal(data); err != nil {
+		return nil, false, fmt.Errorf("could not unmarshal the object: %w", err)
+	}
+
+	if _, ok := detachedChecksum(obj); !ok {
+		return data, false, nil
+	}
+
+	if err := c.attachPayload(obj); err != nil {
+		return nil, false, err
+	}
+
+	data, err := obj.Marshal()
+	if err != nil {
+		return nil, false, fmt.Errorf("could not marshal the object: %w", err)
+	}
+
+	return data, true, nil
+}
+
+// deduplicatable checks if the object in a binary representation may be
+// stored with the deduplicated payload.
+func (c *cfg) deduplicatable(data []byte) bool {
+	return c.dedupEnabled && c.payloadRefs != nil && uint64(len(data)) > c.smallSizeLimit
+}
+
+// deduplicatableChecksum returns SHA-256 checksum of the object payload
+// if it is declared in the header and matches the payload. Payload size
+// must be declared too, so the header stored without payload is detected
+// on read.
+func deduplicatableChecksum(obj *object.Object) ([sha256.Size]byte, bool) {
+	payload := obj.Payload()
+
+	cs := obj.PayloadChecksum()
+	if len(payload) == 0 || uint64(len(payload)) != obj.PayloadSize() ||
+		cs == nil || cs.Type() != checksum.SHA256 {
+		return [sha256.Size]byte{}, false
+	}
+
+	sum := sha256.Sum256(payload)
+
+	return sum, bytes.Equal(sum[:], cs.Sum())
+}
+
+// detachedChecksum returns SHA-256 checksum of the payload if the object
+// is stored without it.
+func detachedChecksum(obj *object.Object) ([sha256.Size]byte, bool) {
+	var sum [sha256.Size]byte
+
+	if obj.PayloadSize() == 0 || len(obj.Payload()) != 0 {
+		return sum, false
+	}
+
+	cs := obj.PayloadChecksum()
+	if cs == nil || cs.Type() != checksum.SHA256 || len(cs.Sum()) != sha256.Size {
+		return sum, false
+	}
+
+	copy(sum[:], cs.Sum())
+
+	return sum, true
+}
+
+func (s *payloadStore) lock(sum [sha256.Size]byte) *sync.Mutex {
+	mtx := &s.mtx[sum[0]]
+	mtx.Lock()
+
+	return mtx
+}
+
+func (s *payloadStore) path(sum [sha256.Size]byte) string {
+	name := hex.EncodeToString(sum[:])
+
+	return filepath.Join(s.rootPath, name[:2], name)
+}
+
+// put saves the payload if it is not stored yet.
+func (s *payloadStore) put(sum [sha256.Size]byte, data []byte) error {
+	p := s.path(sum)
+
+	if _, err := os.Stat(p); err == nil {
+		return nil
+	}
+
+	if err := util.MkdirAllX(filepath.Dir(p), s.perm); err != nil {
+		return err
+	}
+
+	// write to the temporary file first, so the partially written
+	// payload is never visible to the readers
+	tmp := p + ".tmp"
+
+	if err := os.WriteFile(tmp, data, s.perm); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, p)
+}
+
+func (s *payloadStore) get(sum [sha256.Size]byte) ([]byte, error) {
+	data, err := os.ReadFile(s.path(sum))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, object.ErrNotFound
+	}
+
+	return data, err
+}
+
+// inUse checks if there are any deduplicated payloads.
+func (s *payloadStore) inUse() bool {
+	_, err := os.Stat(s.rootPath)
+	return err == nil
+}
@@ -0,0 +1,224 @@
+package blobstor
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/stretchr/testify/require"
+)
+
+type testPayloadReferences struct {
+	mtx sync.Mutex
+
+	refs map[string][sha256.Size]byte
+}
+
+func (r *testPayloadReferences) ReferencePayload(addr *addressSDK.Address, sum [sha256.Size]byte) error {
+	r.mtx.Lock()
+	r.refs[addr.String()] = sum
+	r.mtx.Unlock()
+
+	return nil
+}
+
+func (r *testPayloadReferences) DropUnreferencedPayload(sum [sha256.Size]byte) (bool, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	for _, s := range r.refs {
+		if s == sum {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+func (r *testPayloadReferences) drop(addr *addressSDK.Address) {
+	r.mtx.Lock()
+	delete(r.refs, addr.String())
+	r.mtx.Unlock()
+}
+
+func TestPayloadDeduplication(t *testing.T) {
+	const smallSizeLimit = 512
+
+	for _, compress := range []bool{false, true} {
+		compress := compress
+
+		name := "uncompressed"
+		if compress {
+			name = "compressed"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			refs := &testPayloadReferences{refs: make(map[string][sha256.Size]byte)}
+
+			bs := New(WithCompressObjects(compress),
+				WithRootPath(dir),
+				WithSmallSizeLimit(smallSizeLimit),
+				WithBlobovniczaShallowWidth(1),
+				WithPayloadDeduplication(true),
+				WithPayloadReferences(refs))
+			require.NoError(t, bs.Open())
+			require.NoError(t, bs.Init())
+			t.Cleanup(func() { _ = bs.Close() })
+
+			payload := make([]byte, smallSizeLimit*2)
+			_, _ = rand.Read(payload)
+
+			objs := []*object.Object{
+				testObjectWithPayload(payload),
+				testObjectWithPayload(payload),
+			}
+
+			for i := range objs {
+				prm := new(PutPrm)
+				prm.SetObject(objs[i])
+
+				res, err := bs.Put(prm)
+				require.NoError(t, err)
+				require.Nil(t, res.BlobovniczaID())
+			}
+
+			// payload is stored once
+			sum := sha256.Sum256(payload)
+			files, err := os.ReadDir(filepath.Dir(bs.payloads.path(sum)))
+			require.NoError(t, err)
+			require.Len(t, files, 1)
+
+			for i := range objs {
+				res, err := bs.GetBig(&GetBigPrm{address: address{objs[i].Address()}})
+				require.NoError(t, err)
+				require.Equal(t, objs[i], res.Object())
+
+				rng := objectSDK.NewRange()
+				rng.SetOffset(10)
+				rng.SetLength(20)
+
+				rngRes, err := bs.GetRangeBig(&GetRangeBigPrm{
+					address: address{objs[i].Address()},
+					rwRange: rwRange{roRange{rng}},
+				})
+				require.NoError(t, err)
+				require.Equal(t, payload[10:30], rngRes.RangeData())
+			}
+
+			var prm IteratePrm
+
+			prm.SetIterationHandler(func(elem IterationElement) error {
+				require.True(t, elem.DeduplicatedPayload())
+
+				obj := object.New()
+				require.NoError(t, obj.Unmarshal(elem.ObjectData()))
+				require.Equal(t, payload, obj.Payload())
+
+				return nil
+			})
+
+			_, err = bs.Iterate(prm)
+			require.NoError(t, err)
+
+			// payload is still referenced by the second object
+			refs.drop(objs[0].Address())
+
+			ok, err := bs.DeletePayload(sum)
+			require.NoError(t, err)
+			require.False(t, ok)
+
+			refs.drop(objs[1].Address())
+
+			ok, err = bs.DeletePayload(sum)
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			_, err = os.Stat(bs.payloads.path(sum))
+			require.ErrorIs(t, err, os.ErrNotExist)
+		})
+	}
+
+	t.Run("raw", func(t *testing.T) {
+		refs := &testPayloadReferences{refs: make(map[string][sha256.Size]byte)}
+
+		bs := New(WithRootPath(t.TempDir()),
+			WithSmallSizeLimit(smallSizeLimit),
+			WithBlobovniczaShallowWidth(1),
+			WithPayloadDeduplication(true),
+			WithPayloadReferences(refs))
+		require.NoError(t, bs.Open())
+		require.NoError(t, bs.Init())
+		t.Cleanup(func() { _ = bs.Close() })
+
+		payload := make([]byte, smallSizeLimit*2)
+		_, _ = rand.Read(payload)
+
+		obj := testObjectWithPayload(payload)
+
+		data, err := obj.Marshal()
+		require.NoError(t, err)
+
+		_, err = bs.PutRaw(obj.Address(), data, false)
+		require.NoError(t, err)
+		require.Contains(t, refs.refs, obj.Address().String())
+
+		res, err := bs.GetBig(&GetBigPrm{address: address{obj.Address()}})
+		require.NoError(t, err)
+		require.Equal(t, obj, res.Object())
+	})
+
+	t.Run("invalid checksum", func(t *testing.T) {
+		refs := &testPayloadReferences{refs: make(map[string][sha256.Size]byte)}
+
+		bs := New(WithRootPath(t.TempDir()),
+			WithSmallSizeLimit(smallSizeLimit),
+			WithBlobovniczaShallowWidth(1),
+			WithPayloadDeduplication(true),
+			WithPayloadReferences(refs))
+		require.NoError(t, bs.Open())
+		require.NoError(t, bs.Init())
+		t.Cleanup(func() { _ = bs.Close() })
+
+		raw := testObjectRaw(smallSizeLimit * 2)
+
+		cs := checksum.New()
+		cs.SetSHA256(sha256.Sum256([]byte("other payload")))
+		raw.SetPayloadChecksum(cs)
+
+		prm := new(PutPrm)
+		prm.SetObject(raw.Object())
+
+		_, err := bs.Put(prm)
+		require.NoError(t, err)
+		require.Empty(t, refs.refs)
+
+		res, err := bs.GetBig(&GetBigPrm{address: address{raw.Object().Address()}})
+		require.NoError(t, err)
+		require.Equal(t, raw.Object(), res.Object())
+	})
+}
+
+func testObjectWithPayload(payload []byte) *object.Object {
+	raw := object.NewRaw()
+
+	addr := testAddress()
+	raw.SetID(addr.ObjectID())
+	raw.SetContainerID(addr.ContainerID())
+
+	cs := checksum.New()
+	cs.SetSHA256(sha256.Sum256(payload))
+
+	raw.SetPayloadChecksum(cs)
+	raw.SetPayloadSize(uint64(len(payload)))
+	raw.SetPayload(payload)
+
+	return raw.Object()
+}
@@ -26,6 +26,10 @@ type PutRes struct {
 // Otherwise, BlobStor saves the object in blobonicza. In this
 // case the identifier of blobovnicza is returned.
 //
+// If payload deduplication is enabled, the payload of the "big" object
+// with SHA-256 checksum is stored separately and is shared with the
+// other objects having the same payload.
+//
 // Returns any error encountered that
 // did not allow to completely save the object.
 func (b *BlobStor) Put(prm *PutPrm) (*PutRes, error) {
@@ -35,7 +39,7 @@ func (b *BlobStor) Put(prm *PutPrm) (*PutRes, error) {
 		return nil, fmt.Errorf("could not marshal the object: %w", err)
 	}
 
-	return b.PutRaw(prm.obj.Address(), data, b.NeedsCompression(prm.obj))
+	return b.putRaw(prm.obj.Address(), prm.obj, data, b.NeedsCompression(prm.obj))
 }
 
 // NeedsCompression returns true if object should be compressed.
@@ -71,8 +75,35 @@ func (b *BlobStor) NeedsCompression(obj *object.Object) bool {
 
 // PutRaw saves already marshaled object in BLOB storage.
 func (b *BlobStor) PutRaw(addr *addressSDK.Address, data []byte, compress bool) (*PutRes, error) {
+	var obj *object.Object
+
+	if b.deduplicatable(data) {
+		obj = object.New()
+		if err := obj.Unmarshal(data); err != nil {
+			return nil, fmt.Errorf("could not unmarshal the object: %w", err)
+		}
+	}
+
+	return b.putRaw(addr, obj, data, compress)
+}
+
+// putRaw saves the object in BLOB storage. If obj is not nil and
+// the payload deduplication is enabled, the payload of the "big"
+// object is stored separately from the header.
+func (b *BlobStor) putRaw(addr *addressSDK.Address, obj *object.Object, data []byte, compress bool) (*PutRes, error) {
 	big := b.isBig(data)
 
+	if obj != nil && b.deduplicatable(data) {
+		ok, err := b.putDeduplicated(addr, obj, compress)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return new(PutRes), nil
+		}
+	}
+
 	if compress {
 		data = b.compressor(data)
 	}
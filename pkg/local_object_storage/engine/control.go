@@ -25,8 +25,19 @@ func (e *StorageEngine) open() error {
 	return nil
 }
 
-// Init initializes all StorageEngine's components.
+// Init initializes all StorageEngine's components
+// and starts background rebalancer if it is enabled.
 func (e *StorageEngine) Init() error {
+	if err := e.init(); err != nil {
+		return err
+	}
+
+	e.startRebalancer()
+
+	return nil
+}
+
+func (e *StorageEngine) init() error {
 	e.mtx.RLock()
 	defer e.mtx.RUnlock()
 
@@ -46,6 +57,8 @@ var errClosed = errors.New("storage engine is closed")
 //
 // The method is supposed to be called when the application exits.
 func (e *StorageEngine) Close() error {
+	e.stopRebalancer()
+
 	return e.setBlockExecErr(errClosed)
 }
 
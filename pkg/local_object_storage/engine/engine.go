@@ -2,6 +2,7 @@ package engine
 
 import (
 	"sync"
+	"time"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/util"
@@ -20,6 +21,16 @@ type StorageEngine struct {
 
 	shardPools map[string]util.WorkerPool
 
+	rebalancer *rebalancer
+
+	// rebalancePending is set if full rebalance was requested
+	// before the rebalancer started, protected by mtx
+	rebalancePending bool
+
+	// moveMtx is held for writing by the rebalancer to complete object
+	// relocation and for reading by the operations removing the objects
+	moveMtx sync.RWMutex
+
 	blockExec struct {
 		mtx sync.RWMutex
 
@@ -73,6 +84,10 @@ type cfg struct {
 	metrics MetricRegister
 
 	shardPoolSize uint32
+
+	rebalanceInterval time.Duration
+
+	rebalanceBudget uint64
 }
 
 func defaultCfg() *cfg {
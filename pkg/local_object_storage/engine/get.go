@@ -1,17 +1,21 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 )
 
 // GetPrm groups the parameters of Get operation.
 type GetPrm struct {
+	ctx context.Context
+
 	addr *addressSDK.Address
 }
 
@@ -20,6 +24,16 @@ type GetRes struct {
 	obj *object.Object
 }
 
+// WithContext is a Get option to set the context of the operation.
+// Tracing span of the operation is created as a child of the span from ctx.
+func (p *GetPrm) WithContext(ctx context.Context) *GetPrm {
+	if p != nil {
+		p.ctx = ctx
+	}
+
+	return p
+}
+
 // WithAddress is a Get option to set the address of the requested object.
 //
 // Option is required.
@@ -58,6 +72,11 @@ func (e *StorageEngine) get(prm *GetPrm) (*GetRes, error) {
 		defer elapsed(e.metrics.AddGetDuration)()
 	}
 
+	ctx, span := tracing.StartSpan(prm.ctx, "engine.Get",
+		tracing.Attribute{Key: "address", Value: prm.addr},
+	)
+	defer span.End()
+
 	var (
 		obj   *object.Object
 		siErr *objectSDK.SplitInfoError
@@ -70,7 +89,11 @@ func (e *StorageEngine) get(prm *GetPrm) (*GetRes, error) {
 		WithAddress(prm.addr)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
+		shSpan := startShardSpan(ctx, "Get", sh)
 		res, err := sh.Get(shPrm)
+		shSpan.SetError(err)
+		shSpan.End()
+
 		if err != nil {
 			switch {
 			case errors.Is(err, object.ErrNotFound):
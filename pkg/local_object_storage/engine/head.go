@@ -1,17 +1,21 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 )
 
 // HeadPrm groups the parameters of Head operation.
 type HeadPrm struct {
+	ctx context.Context
+
 	addr *addressSDK.Address
 	raw  bool
 }
@@ -21,6 +25,16 @@ type HeadRes struct {
 	head *object.Object
 }
 
+// WithContext is a Head option to set the context of the operation
+// which is used to trace the operation.
+func (p *HeadPrm) WithContext(ctx context.Context) *HeadPrm {
+	if p != nil {
+		p.ctx = ctx
+	}
+
+	return p
+}
+
 // WithAddress is a Head option to set the address of the requested object.
 //
 // Option is required.
@@ -73,6 +87,11 @@ func (e *StorageEngine) head(prm *HeadPrm) (*HeadRes, error) {
 		defer elapsed(e.metrics.AddHeadDuration)()
 	}
 
+	ctx, span := tracing.StartSpan(prm.ctx, "engine.Head",
+		tracing.Attribute{Key: "address", Value: prm.addr},
+	)
+	defer span.End()
+
 	var (
 		head  *object.Object
 		siErr *objectSDK.SplitInfoError
@@ -86,7 +105,11 @@ func (e *StorageEngine) head(prm *HeadPrm) (*HeadRes, error) {
 		WithRaw(prm.raw)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
+		shSpan := startShardSpan(ctx, "Head", sh)
 		res, err := sh.Head(shPrm)
+		shSpan.SetError(err)
+		shSpan.End()
+
 		if err != nil {
 			switch {
 			case errors.Is(err, object.ErrNotFound):
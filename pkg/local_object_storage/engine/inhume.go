@@ -65,6 +65,10 @@ func (e *StorageEngine) inhume(prm *InhumePrm) (*InhumeRes, error) {
 		defer elapsed(e.metrics.AddInhumeDuration)()
 	}
 
+	// relocated objects must not escape the removal
+	e.moveMtx.RLock()
+	defer e.moveMtx.RUnlock()
+
 	shPrm := new(shard.InhumePrm)
 
 	for i := range prm.addrs {
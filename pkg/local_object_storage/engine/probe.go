@@ -0,0 +1,19 @@
+package engine
+
+import "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+
+// ProbeShard checks that the shard with provided identifier is able
+// to save, read and remove an object.
+//
+// Returns shard.ErrReadOnlyMode error if shard is in "read-only" mode.
+func (e *StorageEngine) ProbeShard(id *shard.ID) error {
+	e.mtx.RLock()
+	defer e.mtx.RUnlock()
+
+	sh, ok := e.shards[id.String()]
+	if !ok {
+		return errShardNotFound
+	}
+
+	return sh.Probe()
+}
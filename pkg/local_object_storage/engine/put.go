@@ -1,15 +1,19 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"go.uber.org/zap"
 )
 
 // PutPrm groups the parameters of Put operation.
 type PutPrm struct {
+	ctx context.Context
+
 	obj *object.Object
 }
 
@@ -18,6 +22,16 @@ type PutRes struct{}
 
 var errPutShard = errors.New("could not put object to any shard")
 
+// WithContext is a Put option to set the context of the operation.
+// Shard writes are traced as children of the span from ctx.
+func (p *PutPrm) WithContext(ctx context.Context) *PutPrm {
+	if p != nil {
+		p.ctx = ctx
+	}
+
+	return p
+}
+
 // WithObject is a Put option to set object to save.
 //
 // Option is required.
@@ -49,6 +63,11 @@ func (e *StorageEngine) put(prm *PutPrm) (*PutRes, error) {
 		defer elapsed(e.metrics.AddPutDuration)()
 	}
 
+	ctx, span := tracing.StartSpan(prm.ctx, "engine.Put",
+		tracing.Attribute{Key: "address", Value: prm.obj.Address()},
+	)
+	defer span.End()
+
 	_, err := e.exists(prm.obj.Address()) // TODO: #1146 make this check parallel
 	if err != nil {
 		return nil, err
@@ -96,7 +115,11 @@ func (e *StorageEngine) put(prm *PutPrm) (*PutRes, error) {
 			putPrm := new(shard.PutPrm)
 			putPrm.WithObject(prm.obj)
 
+			shSpan := startShardSpan(ctx, "Put", sh)
 			_, err = sh.Put(putPrm)
+			shSpan.SetError(err)
+			shSpan.End()
+
 			if err != nil {
 				e.log.Warn("could not put object in shard",
 					zap.Stringer("shard", sh.ID()),
@@ -106,6 +129,21 @@ func (e *StorageEngine) put(prm *PutPrm) (*PutRes, error) {
 				return
 			}
 
+			if ind != 0 {
+				// preferred shards are unavailable, object will be
+				// moved by the rebalancer later
+				toMoveItPrm := new(shard.ToMoveItPrm)
+				toMoveItPrm.WithAddress(prm.obj.Address())
+
+				_, err = sh.ToMoveIt(toMoveItPrm)
+				if err != nil {
+					e.log.Warn("could not mark object for shard relocation",
+						zap.Stringer("shard", sh.ID()),
+						zap.String("error", err.Error()),
+					)
+				}
+			}
+
 			finished = true
 		}); err != nil {
 			close(exitCh)
@@ -1,17 +1,21 @@
 package engine
 
 import (
+	"context"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 )
 
 // RngPrm groups the parameters of GetRange operation.
 type RngPrm struct {
+	ctx context.Context
+
 	off, ln uint64
 
 	addr *addressSDK.Address
@@ -22,6 +26,15 @@ type RngRes struct {
 	obj *object.Object
 }
 
+// WithContext is a GetRange option to set the context used for tracing.
+func (p *RngPrm) WithContext(ctx context.Context) *RngPrm {
+	if p != nil {
+		p.ctx = ctx
+	}
+
+	return p
+}
+
 // WithAddress is a GetRng option to set the address of the requested object.
 //
 // Option is required.
@@ -76,6 +89,11 @@ func (e *StorageEngine) getRange(prm *RngPrm) (*RngRes, error) {
 		defer elapsed(e.metrics.AddRangeDuration)()
 	}
 
+	ctx, span := tracing.StartSpan(prm.ctx, "engine.GetRange",
+		tracing.Attribute{Key: "address", Value: prm.addr},
+	)
+	defer span.End()
+
 	var (
 		obj   *object.Object
 		siErr *objectSDK.SplitInfoError
@@ -89,7 +107,11 @@ func (e *StorageEngine) getRange(prm *RngPrm) (*RngRes, error) {
 		WithRange(prm.off, prm.ln)
 
 	e.iterateOverSortedShards(prm.addr, func(_ int, sh hashedShard) (stop bool) {
+		shSpan := startShardSpan(ctx, "GetRange", sh)
 		res, err := sh.GetRange(shPrm)
+		shSpan.SetError(err)
+		shSpan.End()
+
 		if err != nil {
 			switch {
 			case errors.Is(err, object.ErrNotFound):
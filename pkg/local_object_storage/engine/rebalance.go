@@ -0,0 +1,351 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
+)
+
+// rebalanceBatchSize is a number of objects listed at once
+// during full rebalance.
+const rebalanceBatchSize = 1000
+
+// rebalancer is a background worker which moves objects marked
+// with ToMoveIt flag to their preferred shards.
+type rebalancer struct {
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	// full is signaled when all stored objects should be rebalanced
+	full chan struct{}
+}
+
+var errRebalanceSkip = errors.New("object can't be moved now")
+
+// WithRebalanceInterval returns an option to set the interval between
+// relocations of objects stored in non-preferred shards. Zero interval
+// disables the rebalancer.
+func WithRebalanceInterval(d time.Duration) Option {
+	return func(c *cfg) {
+		c.rebalanceInterval = d
+	}
+}
+
+// WithRebalanceBudget returns an option to limit the amount of payload
+// moved between shards by the rebalancer, in bytes per second. Zero value
+// means no limit.
+func WithRebalanceBudget(v uint64) Option {
+	return func(c *cfg) {
+		c.rebalanceBudget = v
+	}
+}
+
+// startRebalancer runs background rebalancer if it is enabled.
+func (e *StorageEngine) startRebalancer() {
+	if e.rebalanceInterval <= 0 {
+		return
+	}
+
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	if e.rebalancer != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r := &rebalancer{
+		cancel: cancel,
+		full:   make(chan struct{}, 1),
+	}
+
+	e.rebalancer = r
+
+	if e.rebalancePending {
+		// shards were added before the start
+		r.full <- struct{}{}
+		e.rebalancePending = false
+	}
+
+	r.wg.Add(1)
+
+	go func() {
+		defer r.wg.Done()
+
+		t := time.NewTicker(e.rebalanceInterval)
+		defer t.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+				e.rebalanceMovable(ctx)
+			case <-r.full:
+				e.rebalanceAll(ctx)
+			}
+		}
+	}()
+}
+
+// stopRebalancer stops background rebalancer and waits
+// for the current relocation to complete.
+func (e *StorageEngine) stopRebalancer() {
+	e.mtx.Lock()
+	r := e.rebalancer
+	e.rebalancer = nil
+	e.mtx.Unlock()
+
+	if r != nil {
+		r.cancel()
+		r.wg.Wait()
+	}
+}
+
+// scheduleFullRebalance requests relocation of all stored objects
+// according to the current set of shards. If the rebalancer is not
+// running, the request is performed right after its start.
+//
+// Must be called under write lock.
+func (e *StorageEngine) scheduleFullRebalance() {
+	if e.rebalancer == nil {
+		e.rebalancePending = true
+		return
+	}
+
+	select {
+	case e.rebalancer.full <- struct{}{}:
+	default:
+	}
+}
+
+// rebalanceMovable relocates objects marked with ToMoveIt flag.
+func (e *StorageEngine) rebalanceMovable(ctx context.Context) {
+	for _, sh := range e.unsortedShards() {
+		addrs, err := sh.Movable()
+		if err != nil {
+			e.reportShardError(sh, "could not list movable objects", err)
+			continue
+		}
+
+		for i := range addrs {
+			if ctx.Err() != nil {
+				return
+			}
+
+			e.rebalanceObject(ctx, sh, addrs[i], true)
+		}
+	}
+}
+
+// rebalanceAll relocates all stored objects which are not in their
+// preferred shards.
+func (e *StorageEngine) rebalanceAll(ctx context.Context) {
+	e.log.Info("starting full rebalance of the stored objects")
+
+	for _, sh := range e.unsortedShards() {
+		var (
+			addrs  []*addressSDK.Address
+			cursor *shard.Cursor
+			err    error
+		)
+
+		for {
+			addrs, cursor, err = shard.ListWithCursor(sh.Shard, rebalanceBatchSize, cursor)
+			if err != nil {
+				if !errors.Is(err, shard.ErrEndOfListing) {
+					e.reportShardError(sh, "could not list objects for rebalance", err)
+				}
+
+				break
+			}
+
+			for i := range addrs {
+				if ctx.Err() != nil {
+					return
+				}
+
+				e.rebalanceObject(ctx, sh, addrs[i], false)
+			}
+		}
+	}
+
+	e.log.Info("full rebalance of the stored objects is finished")
+}
+
+// rebalanceObject moves the object from the source shard to the healthy shard
+// with the highest weight. Marked objects already stored in the preferred shard
+// lose their ToMoveIt mark.
+func (e *StorageEngine) rebalanceObject(ctx context.Context, src hashedShard, addr *addressSDK.Address, marked bool) {
+	var moved uint64
+
+	err := e.execIfNotBlocked(func() error {
+		var err error
+		moved, err = e.moveObject(src, addr, marked)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, errRebalanceSkip) {
+			e.log.Debug("could not move object to the preferred shard",
+				zap.Stringer("shard", src.ID()),
+				zap.Stringer("address", addr),
+				zap.String("error", err.Error()),
+			)
+		}
+
+		return
+	}
+
+	if moved == 0 || e.rebalanceBudget == 0 {
+		return
+	}
+
+	// throttle to keep the average rate within the budget
+	t := time.NewTimer(time.Duration(moved * uint64(time.Second) / e.rebalanceBudget))
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
+
+// moveObject relocates the object and returns the number of moved
+// payload bytes.
+func (e *StorageEngine) moveObject(src hashedShard, addr *addressSDK.Address, marked bool) (uint64, error) {
+	if src.GetMode() != shard.ModeReadWrite {
+		return 0, errRebalanceSkip
+	}
+
+	var (
+		target    hashedShard
+		targetInd = -1
+		srcInd    = -1
+	)
+
+	for i, sh := range e.sortShardsByWeight(addr) {
+		if sh.ID().String() == src.ID().String() {
+			srcInd = i
+			break
+		}
+
+		if targetInd < 0 && e.isHealthy(sh) {
+			target, targetInd = sh, i
+		}
+	}
+
+	if srcInd < 0 {
+		// shard has been detached
+		return 0, errRebalanceSkip
+	}
+
+	if targetInd < 0 {
+		if srcInd == 0 {
+			if !marked {
+				return 0, nil
+			}
+
+			_, err := src.DoNotMove(new(shard.DoNotMovePrm).WithAddress(addr))
+			return 0, err
+		}
+
+		// better shards are unavailable, try later
+		return 0, errRebalanceSkip
+	}
+
+	exists, err := target.Exists(new(shard.ExistsPrm).WithAddress(addr))
+	if err != nil {
+		e.reportShardError(target, "could not check object existence", err)
+		return 0, err
+	}
+
+	var size uint64
+
+	if !exists.Exists() {
+		res, err := src.Get(new(shard.GetPrm).WithAddress(addr))
+		if err != nil {
+			return 0, err
+		}
+
+		_, err = target.Put(new(shard.PutPrm).WithObject(res.Object()))
+		if err != nil {
+			e.reportShardError(target, "could not put object to shard", err)
+			return 0, err
+		}
+
+		size = res.Object().PayloadSize()
+	}
+
+	if targetInd != 0 {
+		// the best shard is still unavailable
+		_, err = target.ToMoveIt(new(shard.ToMoveItPrm).WithAddress(addr))
+		if err != nil {
+			e.log.Warn("could not mark object for shard relocation",
+				zap.Stringer("shard", target.ID()),
+				zap.String("error", err.Error()),
+			)
+		}
+	}
+
+	// object could be removed from the source shard while it was copied,
+	// so the status is checked again with removals blocked
+	e.moveMtx.Lock()
+	defer e.moveMtx.Unlock()
+
+	if removed, err := removedFromShard(src, addr); removed || err != nil {
+		if !exists.Exists() {
+			// copy must not outlive the removed original
+			if _, err := target.Delete(new(shard.DeletePrm).WithAddresses(addr)); err != nil {
+				e.reportShardError(target, "could not delete copy of removed object from shard", err)
+			}
+		}
+
+		if err != nil {
+			return 0, err
+		}
+
+		return 0, errRebalanceSkip
+	}
+
+	_, err = src.Delete(new(shard.DeletePrm).WithAddresses(addr))
+	if err != nil {
+		e.reportShardError(src, "could not delete moved object from shard", err)
+		return size, err
+	}
+
+	e.log.Debug("object moved to the preferred shard",
+		zap.Stringer("address", addr),
+		zap.Stringer("from", src.ID()),
+		zap.Stringer("to", target.ID()),
+	)
+
+	return size, nil
+}
+
+// removedFromShard checks if the object is no longer available in the shard:
+// it is inhumed, marked as garbage or physically deleted.
+func removedFromShard(sh hashedShard, addr *addressSDK.Address) (bool, error) {
+	res, err := sh.Exists(new(shard.ExistsPrm).WithAddress(addr))
+	if err != nil {
+		if errors.Is(err, object.ErrAlreadyRemoved) || errors.Is(err, object.ErrNotFound) {
+			return true, nil
+		}
+
+		return false, err
+	}
+
+	return !res.Exists(), nil
+}
+
+// isHealthy checks if shard can accept relocated objects.
+func (e *StorageEngine) isHealthy(sh hashedShard) bool {
+	return sh.GetMode() == shard.ModeReadWrite &&
+		(e.errorsThreshold == 0 || sh.errorCount.Load() < e.errorsThreshold)
+}
@@ -0,0 +1,182 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/address/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRebalance(t *testing.T) {
+	s1 := testNewShard(t, 1)
+	s2 := testNewShard(t, 2)
+	e := testNewEngineWithShards(s1, s2)
+
+	t.Cleanup(func() {
+		e.Close()
+		os.RemoveAll(t.Name())
+	})
+
+	// putMisplaced saves the object to the non-preferred shard
+	putMisplaced := func(t *testing.T, mark bool) (*addressSDK.Address, hashedShard, hashedShard) {
+		obj := generateRawObjectWithCID(t, cidtest.ID()).Object()
+		addr := obj.Address()
+
+		shards := e.sortShardsByWeight(addr)
+
+		_, err := shards[1].Put(new(shard.PutPrm).WithObject(obj))
+		require.NoError(t, err)
+
+		if mark {
+			_, err = shards[1].ToMoveIt(new(shard.ToMoveItPrm).WithAddress(addr))
+			require.NoError(t, err)
+		}
+
+		return addr, shards[0], shards[1]
+	}
+
+	exists := func(t *testing.T, sh hashedShard, addr *addressSDK.Address) bool {
+		res, err := sh.Exists(new(shard.ExistsPrm).WithAddress(addr))
+		require.NoError(t, err)
+		return res.Exists()
+	}
+
+	movable := func(t *testing.T, sh hashedShard) []*addressSDK.Address {
+		addrs, err := sh.Movable()
+		require.NoError(t, err)
+		return addrs
+	}
+
+	t.Run("marked objects", func(t *testing.T) {
+		addr, best, other := putMisplaced(t, true)
+
+		e.rebalanceMovable(context.Background())
+
+		require.True(t, exists(t, best, addr))
+		require.False(t, exists(t, other, addr))
+		require.Empty(t, movable(t, other))
+	})
+
+	t.Run("preferred shard is read-only", func(t *testing.T) {
+		addr, best, other := putMisplaced(t, true)
+
+		require.NoError(t, best.SetMode(shard.ModeReadOnly))
+		e.rebalanceMovable(context.Background())
+		require.NoError(t, best.SetMode(shard.ModeReadWrite))
+
+		require.False(t, exists(t, best, addr))
+		require.True(t, exists(t, other, addr))
+		require.Len(t, movable(t, other), 1)
+
+		e.rebalanceMovable(context.Background())
+
+		require.True(t, exists(t, best, addr))
+		require.False(t, exists(t, other, addr))
+	})
+
+	t.Run("all objects", func(t *testing.T) {
+		addr, best, other := putMisplaced(t, false)
+
+		e.rebalanceMovable(context.Background())
+		require.True(t, exists(t, other, addr))
+
+		e.rebalanceAll(context.Background())
+
+		require.True(t, exists(t, best, addr))
+		require.False(t, exists(t, other, addr))
+	})
+
+	t.Run("removed while moving", func(t *testing.T) {
+		addr, best, other := putMisplaced(t, true)
+
+		// concurrent removal is in progress
+		e.moveMtx.RLock()
+
+		errCh := make(chan error, 1)
+		go func() {
+			_, err := e.moveObject(other, addr, true)
+			errCh <- err
+		}()
+
+		require.Eventually(t, func() bool { return exists(t, best, addr) }, time.Second, 10*time.Millisecond)
+
+		_, err := other.Inhume(new(shard.InhumePrm).WithTarget(objecttest.Address(), addr))
+		require.NoError(t, err)
+
+		e.moveMtx.RUnlock()
+
+		require.True(t, errors.Is(<-errCh, errRebalanceSkip))
+		require.False(t, exists(t, best, addr), "copy of the removed object must be deleted")
+
+		_, err = other.Exists(new(shard.ExistsPrm).WithAddress(addr))
+		require.True(t, errors.Is(err, object.ErrAlreadyRemoved), "removal must be kept")
+	})
+}
+
+func TestRebalance_AddShard(t *testing.T) {
+	dir := t.TempDir()
+
+	newEngine := func(t *testing.T, num int) *StorageEngine {
+		e := New(WithRebalanceInterval(time.Hour))
+
+		for i := 0; i < num; i++ {
+			_, err := e.AddShard(
+				shard.WithBlobStorOptions(
+					blobstor.WithRootPath(filepath.Join(dir, strconv.Itoa(i))),
+					blobstor.WithBlobovniczaShallowWidth(1),
+					blobstor.WithBlobovniczaShallowDepth(1),
+					blobstor.WithRootPerm(0700)),
+				shard.WithMetaBaseOptions(
+					meta.WithPath(filepath.Join(dir, fmt.Sprintf("%d.metabase", i))),
+					meta.WithPermissions(0700)))
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, e.Open())
+		require.NoError(t, e.Init())
+
+		return e
+	}
+
+	const objCount = 10
+
+	addrs := make([]*addressSDK.Address, objCount)
+
+	e := newEngine(t, 1)
+	for i := range addrs {
+		obj := generateRawObjectWithCID(t, cidtest.ID()).Object()
+		addrs[i] = obj.Address()
+
+		_, err := e.Put(new(PutPrm).WithObject(obj))
+		require.NoError(t, err)
+	}
+	require.NoError(t, e.Close())
+
+	// new shard is added to the configuration on restart
+	e = newEngine(t, 2)
+	t.Cleanup(func() { _ = e.Close() })
+
+	require.Eventually(t, func() bool {
+		for i := range addrs {
+			res, err := e.sortShardsByWeight(addrs[i])[0].Exists(new(shard.ExistsPrm).WithAddress(addrs[i]))
+			if err != nil || !res.Exists() {
+				return false
+			}
+		}
+
+		return true
+	}, 5*time.Second, 50*time.Millisecond, "objects must be moved to the preferred shards")
+}
@@ -46,6 +46,10 @@ func (e *StorageEngine) AddShard(opts ...shard.Option) (*shard.ID, error) {
 
 	e.shardPools[strID] = pool
 
+	// objects of the running engine are redistributed
+	// according to the new set of shards
+	e.scheduleFullRebalance()
+
 	return id, nil
 }
 
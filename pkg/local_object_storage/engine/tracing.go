@@ -0,0 +1,17 @@
+package engine
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
+)
+
+// startShardSpan starts a span of the shard operation which
+// is a child of the engine operation span stored in ctx.
+func startShardSpan(ctx context.Context, op string, sh hashedShard) *tracing.Span {
+	_, span := tracing.StartSpan(ctx, "shard."+op,
+		tracing.Attribute{Key: "shard_id", Value: sh.ID()},
+	)
+
+	return span
+}
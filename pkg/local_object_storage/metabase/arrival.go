@@ -0,0 +1,163 @@
+package meta
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.etcd.io/bbolt"
+)
+
+// ArrivalsPrm groups the parameters of Arrivals operation.
+type ArrivalsPrm struct {
+	since uint64
+}
+
+// ArrivalsRes groups resulting values of Arrivals operation.
+type ArrivalsRes struct {
+	addrs []*addressSDK.Address
+
+	watermark uint64
+}
+
+// WithSince is an Arrivals option to select the objects
+// which were saved after the specified arrival number.
+func (p *ArrivalsPrm) WithSince(since uint64) *ArrivalsPrm {
+	if p != nil {
+		p.since = since
+	}
+
+	return p
+}
+
+// AddressList returns addresses of the objects saved after `since` number.
+func (r *ArrivalsRes) AddressList() []*addressSDK.Address {
+	return r.addrs
+}
+
+// Watermark returns the arrival number of the last saved object.
+// It should be used as `since` value to select the objects saved
+// after the current call.
+func (r *ArrivalsRes) Watermark() uint64 {
+	return r.watermark
+}
+
+// Arrivals returns addresses of the physically stored objects which were
+// saved in the metabase after the specified arrival number.
+//
+// Each object saved in the metabase gets the next number of the local
+// counter, so unlike the creation epoch the number reflects the moment
+// object arrived to the shard.
+func (db *DB) Arrivals(prm *ArrivalsPrm) (res *ArrivalsRes, err error) {
+	res = new(ArrivalsRes)
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(arrivalBucketName)
+		if b == nil {
+			return nil
+		}
+
+		res.watermark = b.Sequence()
+
+		return b.ForEach(func(k, v []byte) error {
+			if len(v) != 8 {
+				return fmt.Errorf("invalid arrival number length %d", len(v))
+			}
+
+			if binary.LittleEndian.Uint64(v) <= prm.since {
+				return nil
+			}
+
+			addr, err := addressFromKey(k)
+			if err != nil {
+				return fmt.Errorf("invalid address in arrival index: %w", err)
+			}
+
+			res.addrs = append(res.addrs, addr)
+
+			return nil
+		})
+	})
+
+	return res, err
+}
+
+// putArrival assigns the next arrival number to the object.
+func putArrival(tx *bbolt.Tx, addr *addressSDK.Address) error {
+	b, err := tx.CreateBucketIfNotExists(arrivalBucketName)
+	if err != nil {
+		return err
+	}
+
+	n, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, n)
+
+	return b.Put(addressKey(addr), data)
+}
+
+// delArrival removes arrival number of the object.
+func delArrival(tx *bbolt.Tx, addr *addressSDK.Address) error {
+	b := tx.Bucket(arrivalBucketName)
+	if b == nil {
+		return nil
+	}
+
+	return b.Delete(addressKey(addr))
+}
+
+// indexArrivals assigns arrival numbers to all physically
+// stored objects which do not have it.
+func indexArrivals(tx *bbolt.Tx) error {
+	var addrs []*addressSDK.Address
+
+	err := tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+		containerID, postfix := parseContainerIDWithPostfix(name)
+		if containerID == nil {
+			return nil
+		}
+
+		switch postfix {
+		case "", storageGroupPostfix, tombstonePostfix:
+		default:
+			return nil
+		}
+
+		prefix := containerID.String() + "/"
+
+		return b.ForEach(func(k, _ []byte) error {
+			addr := addressSDK.NewAddress()
+			if err := addr.Parse(prefix + string(k)); err != nil {
+				return fmt.Errorf("invalid object address: %w", err)
+			}
+
+			addrs = append(addrs, addr)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	arrivals, err := tx.CreateBucketIfNotExists(arrivalBucketName)
+	if err != nil {
+		return err
+	}
+
+	for i := range addrs {
+		if arrivals.Get(addressKey(addrs[i])) != nil {
+			continue
+		}
+
+		if err := putArrival(tx, addrs[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package meta_test
+
+import (
+	"testing"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_Arrivals(t *testing.T) {
+	db := newDB(t)
+
+	arrivals := func(since uint64) *meta.ArrivalsRes {
+		res, err := db.Arrivals(new(meta.ArrivalsPrm).WithSince(since))
+		require.NoError(t, err)
+
+		return res
+	}
+
+	res := arrivals(0)
+	require.Empty(t, res.AddressList())
+	require.Zero(t, res.Watermark())
+
+	raw1 := generateRawObject(t)
+	raw2 := generateRawObject(t)
+
+	require.NoError(t, putBig(db, raw1.Object()))
+	require.NoError(t, putBig(db, raw2.Object()))
+
+	res = arrivals(0)
+	require.Len(t, res.AddressList(), 2)
+	require.EqualValues(t, 2, res.Watermark())
+
+	watermark := res.Watermark()
+
+	// repeated put does not change arrival number
+	require.NoError(t, putBig(db, raw1.Object()))
+
+	res = arrivals(watermark)
+	require.Empty(t, res.AddressList())
+	require.Equal(t, watermark, res.Watermark())
+
+	// object with an old creation epoch is selected after the watermark
+	raw3 := generateRawObject(t)
+	raw3.SetCreationEpoch(1)
+
+	require.NoError(t, putBig(db, raw3.Object()))
+
+	res = arrivals(watermark)
+	require.Len(t, res.AddressList(), 1)
+	require.Equal(t, raw3.Object().Address().String(), res.AddressList()[0].String())
+
+	// removed objects are not selected
+	require.NoError(t, meta.Delete(db, raw3.Object().Address()))
+
+	res = arrivals(watermark)
+	require.Empty(t, res.AddressList())
+}
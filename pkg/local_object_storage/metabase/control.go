@@ -30,6 +30,9 @@ func (db *DB) Open() error {
 
 // Init initializes metabase. It creates static (CID-independent) buckets in underlying BoltDB instance.
 //
+// Metabases of older versions are migrated to the current Version in place. Returns ErrUnsupportedVersion
+// if metabase has been created by a newer version of the storage node.
+//
 // Does nothing if metabase has already been initialized and filled. To roll back the database to its initial state,
 // use Reset.
 func (db *DB) Init() error {
@@ -47,9 +50,24 @@ func (db *DB) init(reset bool) error {
 		string(containerVolumeBucketName): {},
 		string(graveyardBucketName):       {},
 		string(toMoveItBucketName):        {},
+		string(shardInfoBucket):           {},
+		string(payloadRefsBucketName):     {},
+		string(payloadObjectsBucketName):  {},
+		string(arrivalBucketName):         {},
 	}
 
 	return db.boltDB.Update(func(tx *bbolt.Tx) error {
+		if !reset {
+			v, err := checkVersion(tx)
+			if err != nil {
+				return err
+			}
+
+			if err = db.migrate(tx, v); err != nil {
+				return err
+			}
+		}
+
 		for k := range mStaticBuckets {
 			b, err := tx.CreateBucketIfNotExists([]byte(k))
 			if err != nil {
@@ -63,17 +81,20 @@ func (db *DB) init(reset bool) error {
 			}
 		}
 
-		if !reset {
-			return nil
-		}
+		if reset {
+			err := tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
+				if _, ok := mStaticBuckets[string(name)]; !ok {
+					return tx.DeleteBucket(name)
+				}
 
-		return tx.ForEach(func(name []byte, b *bbolt.Bucket) error {
-			if _, ok := mStaticBuckets[string(name)]; !ok {
-				return tx.DeleteBucket(name)
+				return nil
+			})
+			if err != nil {
+				return err
 			}
+		}
 
-			return nil
-		})
+		return putVersion(tx, Version)
 	})
 }
 
@@ -85,6 +85,15 @@ func (db *DB) delete(tx *bbolt.Tx, addr *addressSDK.Address, refCounter referenc
 		}
 	}
 
+	// release deduplicated payload
+	if err := dropPayloadReference(tx, addr); err != nil {
+		return fmt.Errorf("could not drop payload reference: %w", err)
+	}
+
+	if err := delArrival(tx, addr); err != nil {
+		return fmt.Errorf("could not remove arrival number: %w", err)
+	}
+
 	// unmarshal object, work only with physically stored (raw == true) objects
 	obj, err := db.get(tx, addr, false, true)
 	if err != nil {
@@ -97,25 +106,39 @@ func (db *DB) delete(tx *bbolt.Tx, addr *addressSDK.Address, refCounter referenc
 
 	// if object is an only link to a parent, then remove parent
 	if parent := obj.GetParent(); parent != nil {
-		parAddr := parent.Address()
-		sParAddr := parAddr.String()
-
-		nRef, ok := refCounter[sParAddr]
-		if !ok {
-			nRef = &referenceNumber{
-				all:  parentLength(tx, parent.Address()),
-				addr: parAddr,
-				obj:  parent,
-			}
+		refCounter.addChild(tx, parent)
+	}
 
-			refCounter[sParAddr] = nRef
+	// remove object
+	err = db.deleteObject(tx, obj, false)
+	if err != nil {
+		return err
+	}
+
+	if object.IsErasureCodePart(obj) {
+		return db.deleteErasureCodePart(tx, obj, refCounter)
+	}
+
+	return nil
+}
+
+// addChild counts removed child of the parent object.
+func (c referenceCounter) addChild(tx *bbolt.Tx, parent *object.Object) {
+	parAddr := parent.Address()
+	sParAddr := parAddr.String()
+
+	nRef, ok := c[sParAddr]
+	if !ok {
+		nRef = &referenceNumber{
+			all:  parentLength(tx, parAddr),
+			addr: parAddr,
+			obj:  parent,
 		}
 
-		nRef.cur++
+		c[sParAddr] = nRef
 	}
 
-	// remove object
-	return db.deleteObject(tx, obj, false)
+	nRef.cur++
 }
 
 func (db *DB) deleteObject(
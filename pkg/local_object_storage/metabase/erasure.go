@@ -0,0 +1,183 @@
+package meta
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.etcd.io/bbolt"
+)
+
+/*
+Erasure-coded objects are stored as the parts only, header of the object
+is kept in the part attributes. Metabase indexes the header of the object
+as a virtual object the same way as the parent of split objects: the object
+is found by search while at least one of its parts is stored locally. Parts
+themselves are hidden from search unless erasure code attributes are
+requested explicitly.
+*/
+
+// ecHeaderBucketName returns <CID>_ECheader.
+func ecHeaderBucketName(cid *cid.ID) []byte {
+	return []byte(cid.String() + ecHeaderPostfix)
+}
+
+// ecPartBucketName returns <CID>_ECpart.
+func ecPartBucketName(cid *cid.ID) []byte {
+	return []byte(cid.String() + ecPartPostfix)
+}
+
+// putErasureCodeParent indexes header of the erasure-coded object
+// which the part belongs to.
+func (db *DB) putErasureCodeParent(tx *bbolt.Tx, part *object.Object) error {
+	hdr, err := object.ErasureCodeParent(part)
+	if err != nil {
+		return fmt.Errorf("can't read erasure code parent header: %w", err)
+	}
+
+	cnr := part.ContainerID()
+	parKey := objectKey(hdr.ID())
+
+	err = putUniqueIndexItem(tx, namedBucketItem{
+		name: ecPartBucketName(cnr),
+		key:  objectKey(part.ID()),
+		val:  parKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	if inBucket(tx, ecHeaderBucketName(cnr), parKey) {
+		return nil
+	}
+
+	// erasure-coded child of the split object
+	if hdr.GetParent() != nil {
+		si, err := splitInfoFromObject(hdr)
+		if err != nil {
+			return err
+		}
+
+		err = db.put(tx, hdr.GetParent(), nil, si)
+		if err != nil {
+			return err
+		}
+	}
+
+	rawHeader, err := object.NewRawFromObject(hdr).CutPayload().Marshal()
+	if err != nil {
+		return fmt.Errorf("can't marshal erasure code parent header: %w", err)
+	}
+
+	items := []namedBucketItem{{
+		name: ecHeaderBucketName(cnr),
+		key:  parKey,
+		val:  rawHeader,
+	}}
+
+	if hdr.Type() == objectSDK.TypeRegular && !hdr.HasParent() {
+		items = append(items, namedBucketItem{
+			name: rootBucketName(cnr),
+			key:  parKey,
+		})
+	}
+
+	for i := range items {
+		if err = putUniqueIndexItem(tx, items[i]); err != nil {
+			return err
+		}
+	}
+
+	listIndexes, err := listIndexes(hdr)
+	if err != nil {
+		return fmt.Errorf("can' build list indexes: %w", err)
+	}
+
+	for i := range listIndexes {
+		if err = putListIndexItem(tx, listIndexes[i]); err != nil {
+			return err
+		}
+	}
+
+	fkbtIndexes, err := fkbtIndexes(hdr)
+	if err != nil {
+		return fmt.Errorf("can' build fake bucket tree indexes: %w", err)
+	}
+
+	for i := range fkbtIndexes {
+		if err = putFKBTIndexItem(tx, fkbtIndexes[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteErasureCodePart removes the part from erasure code indexes. Header
+// of the erasure-coded object is removed along with the last local part.
+func (db *DB) deleteErasureCodePart(tx *bbolt.Tx, part *object.Object, refCounter referenceCounter) error {
+	hdr, err := object.ErasureCodeParent(part)
+	if err != nil {
+		return fmt.Errorf("can't read erasure code parent header: %w", err)
+	}
+
+	cnr := part.ContainerID()
+
+	delUniqueIndexItem(tx, namedBucketItem{
+		name: ecPartBucketName(cnr),
+		key:  objectKey(part.ID()),
+	})
+
+	if hasErasureCodeParts(tx, hdr.Address()) {
+		return nil
+	}
+
+	if parent := hdr.GetParent(); parent != nil {
+		refCounter.addChild(tx, parent)
+	}
+
+	delUniqueIndexItem(tx, namedBucketItem{
+		name: ecHeaderBucketName(cnr),
+		key:  objectKey(hdr.ID()),
+	})
+
+	return db.deleteObject(tx, hdr, true)
+}
+
+// hasErasureCodeParts checks if any part of the erasure-coded object
+// is indexed.
+func hasErasureCodeParts(tx *bbolt.Tx, addr *addressSDK.Address) bool {
+	fkbtRoot := tx.Bucket(attributeBucketName(addr.ContainerID(), object.AttributeECParent))
+	if fkbtRoot == nil {
+		return false
+	}
+
+	fkbtLeaf := fkbtRoot.Bucket([]byte(addr.ObjectID().String()))
+	if fkbtLeaf == nil {
+		return false
+	}
+
+	k, _ := fkbtLeaf.Cursor().First()
+
+	return k != nil
+}
+
+// isErasureCodePart checks if the object is an indexed part of
+// the erasure-coded object.
+func isErasureCodePart(tx *bbolt.Tx, addr *addressSDK.Address) bool {
+	return inBucket(tx, ecPartBucketName(addr.ContainerID()), objectKey(addr.ObjectID()))
+}
+
+// getErasureCodeHeader returns indexed header of the erasure-coded object.
+func getErasureCodeHeader(tx *bbolt.Tx, addr *addressSDK.Address) (*object.Object, error) {
+	data := getFromBucket(tx, ecHeaderBucketName(addr.ContainerID()), objectKey(addr.ObjectID()))
+	if len(data) == 0 {
+		return nil, object.ErrNotFound
+	}
+
+	hdr := object.New()
+
+	return hdr, hdr.Unmarshal(data)
+}
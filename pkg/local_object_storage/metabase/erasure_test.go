@@ -0,0 +1,141 @@
+package meta_test
+
+import (
+	"encoding/base64"
+	"strconv"
+	"testing"
+
+	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/stretchr/testify/require"
+)
+
+func generateECPart(t *testing.T, cid *cid.ID, parent *object.RawObject, index int) *object.RawObject {
+	hdr, err := parent.CutPayload().Marshal()
+	require.NoError(t, err)
+
+	part := generateRawObjectWithCID(t, cid)
+	addAttribute(part, object.AttributeECParent, parent.ID().String())
+	addAttribute(part, object.AttributeECIndex, strconv.Itoa(index))
+	addAttribute(part, object.AttributeECScheme, "2/1")
+	addAttribute(part, object.AttributeECHeader, base64.StdEncoding.EncodeToString(hdr))
+
+	return part
+}
+
+func TestDB_ErasureCode(t *testing.T) {
+	db := newDB(t)
+
+	cid := cidtest.ID()
+
+	parent := generateRawObjectWithCID(t, cid)
+	addAttribute(parent, "foo", "bar")
+
+	parts := []*object.RawObject{
+		generateECPart(t, cid, parent, 0),
+		generateECPart(t, cid, parent, 1),
+	}
+
+	for i := range parts {
+		require.NoError(t, putBig(db, parts[i].Object()))
+	}
+
+	parAddr := parent.Object().Address()
+	part0 := parts[0].Object().Address()
+	part1 := parts[1].Object().Address()
+
+	t.Run("search", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("foo", "bar", objectSDK.MatchStringEqual)
+		testSelect(t, db, cid, fs, parAddr)
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddRootFilter()
+		testSelect(t, db, cid, fs, parAddr)
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddObjectOwnerIDFilter(objectSDK.MatchStringEqual, parent.OwnerID())
+		testSelect(t, db, cid, fs, parAddr)
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddObjectIDFilter(objectSDK.MatchStringEqual, parent.ID())
+		testSelect(t, db, cid, fs, parAddr)
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddFilter(v2object.FilterHeaderVersion, parent.Version().String(), objectSDK.MatchStringEqual)
+		testSelect(t, db, cid, fs, parAddr)
+
+		testSelect(t, db, cid, objectSDK.SearchFilters{}, parAddr)
+	})
+
+	t.Run("search parts", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter(object.AttributeECParent, parent.ID().String(), objectSDK.MatchStringEqual)
+		testSelect(t, db, cid, fs, part0, part1)
+
+		fs.AddFilter(object.AttributeECIndex, "1", objectSDK.MatchStringEqual)
+		testSelect(t, db, cid, fs, part1)
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddPhyFilter()
+		testSelect(t, db, cid, fs, part0, part1)
+	})
+
+	t.Run("delete parts", func(t *testing.T) {
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("foo", "bar", objectSDK.MatchStringEqual)
+
+		require.NoError(t, meta.Delete(db, part0))
+		testSelect(t, db, cid, fs, parAddr)
+
+		require.NoError(t, meta.Delete(db, part1))
+		testSelect(t, db, cid, fs)
+		testSelect(t, db, cid, objectSDK.SearchFilters{})
+	})
+
+	t.Run("removed object", func(t *testing.T) {
+		require.NoError(t, putBig(db, parts[0].Object()))
+		require.NoError(t, meta.Inhume(db, parAddr, generateAddress()))
+
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("foo", "bar", objectSDK.MatchStringEqual)
+		testSelect(t, db, cid, fs)
+	})
+
+	t.Run("split child", func(t *testing.T) {
+		splitID := objectSDK.NewSplitID()
+
+		splitParent := generateRawObjectWithCID(t, cid)
+		addAttribute(splitParent, "x", "y")
+
+		child := generateRawObjectWithCID(t, cid)
+		child.SetParent(splitParent.Object().SDK())
+		child.SetParentID(splitParent.ID())
+		child.SetSplitID(splitID)
+
+		require.NoError(t, putBig(db, generateECPart(t, cid, child, 0).Object()))
+
+		_, err := meta.GetRaw(db, splitParent.Object().Address(), true)
+
+		siErr, ok := err.(*objectSDK.SplitInfoError)
+		require.True(t, ok)
+		require.Equal(t, splitID, siErr.SplitInfo().SplitID())
+		require.Equal(t, child.ID(), siErr.SplitInfo().LastPart())
+
+		hdr, err := meta.GetRaw(db, splitParent.Object().Address(), false)
+		require.NoError(t, err)
+		require.Equal(t, splitParent.ID(), hdr.ID())
+
+		fs := objectSDK.SearchFilters{}
+		fs.AddFilter("x", "y", objectSDK.MatchStringEqual)
+		testSelect(t, db, cid, fs, splitParent.Object().Address())
+
+		fs = objectSDK.SearchFilters{}
+		fs.AddSplitIDFilter(objectSDK.MatchStringEqual, splitID)
+		testSelect(t, db, cid, fs, child.Object().Address())
+	})
+}
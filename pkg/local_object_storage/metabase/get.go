@@ -150,6 +150,10 @@ func getVirtualObject(tx *bbolt.Tx, cid *cid.ID, key []byte, raw bool) (*object.
 	// prioritized to choose
 	virtualOID := relativeLst[len(relativeLst)-1]
 	data := getFromBucket(tx, primaryBucketName(cid), virtualOID)
+	if len(data) == 0 {
+		// the child may be erasure-coded
+		data = getFromBucket(tx, ecHeaderBucketName(cid), virtualOID)
+	}
 
 	child := object.New()
 
@@ -14,6 +14,8 @@ type Grave struct {
 	gcMark bool
 
 	addr *addressSDK.Address
+
+	tomb *addressSDK.Address
 }
 
 // WithGCMark returns true if grave marked for GC to be removed.
@@ -26,6 +28,12 @@ func (g *Grave) Address() *addressSDK.Address {
 	return g.addr
 }
 
+// Tombstone returns address of the tombstone which buried the object.
+// Returns nil if grave is marked for GC.
+func (g *Grave) Tombstone() *addressSDK.Address {
+	return g.tomb
+}
+
 // GraveHandler is a Grave handling function.
 type GraveHandler func(*Grave) error
 
@@ -71,8 +79,17 @@ func graveFromKV(k, v []byte) (*Grave, error) {
 		return nil, fmt.Errorf("could not parse address: %w", err)
 	}
 
-	return &Grave{
+	g := &Grave{
 		gcMark: bytes.Equal(v, []byte(inhumeGCMarkValue)),
 		addr:   addr,
-	}, nil
+	}
+
+	if !g.gcMark {
+		g.tomb, err = addressFromKey(v)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse tombstone address: %w", err)
+		}
+	}
+
+	return g, nil
 }
@@ -43,13 +43,16 @@ func TestDB_IterateOverGraveyard(t *testing.T) {
 	var (
 		counterAll         int
 		buriedTS, buriedGC []*addressSDK.Address
+		tombstones         []*addressSDK.Address
 	)
 
 	err = db.IterateOverGraveyard(func(g *meta.Grave) error {
 		if g.WithGCMark() {
 			buriedGC = append(buriedGC, g.Address())
+			require.Nil(t, g.Tombstone())
 		} else {
 			buriedTS = append(buriedTS, g.Address())
+			tombstones = append(tombstones, g.Tombstone())
 		}
 
 		counterAll++
@@ -61,5 +64,6 @@ func TestDB_IterateOverGraveyard(t *testing.T) {
 
 	require.Equal(t, 2, counterAll)
 	require.Equal(t, []*addressSDK.Address{obj1.Object().Address()}, buriedTS)
+	require.Equal(t, []*addressSDK.Address{addrTombstone}, tombstones)
 	require.Equal(t, []*addressSDK.Address{obj2.Object().Address()}, buriedGC)
 }
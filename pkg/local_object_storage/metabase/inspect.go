@@ -0,0 +1,256 @@
+package meta
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"go.etcd.io/bbolt"
+)
+
+// ObjectStatus groups the information about the object stored in DB.
+type ObjectStatus struct {
+	header *object.Object
+
+	grave *Grave
+
+	blzID *blobovnicza.ID
+
+	splitInfo *objectSDK.SplitInfo
+
+	toMoveIt bool
+}
+
+// Header returns header of the stored object.
+// Returns nil if object header is not stored (e.g. object is virtual).
+func (s *ObjectStatus) Header() *object.Object {
+	return s.header
+}
+
+// Grave returns graveyard record of the object.
+// Returns nil if object is not removed.
+func (s *ObjectStatus) Grave() *Grave {
+	return s.grave
+}
+
+// BlobovniczaID returns identifier of the blobovnicza with the object.
+// Returns nil if object is not small.
+func (s *ObjectStatus) BlobovniczaID() *blobovnicza.ID {
+	return s.blzID
+}
+
+// SplitInfo returns split information of the parent object.
+// Returns nil if object is not a parent of stored objects.
+func (s *ObjectStatus) SplitInfo() *objectSDK.SplitInfo {
+	return s.splitInfo
+}
+
+// ToMoveIt returns true if object is marked to be moved into another shard.
+func (s *ObjectStatus) ToMoveIt() bool {
+	return s.toMoveIt
+}
+
+// ObjectStatus returns all the information DB keeps about the object,
+// regardless of whether it is removed or not.
+//
+// Returns object.ErrNotFound if DB has no records of the object.
+func (db *DB) ObjectStatus(addr *addressSDK.Address) (*ObjectStatus, error) {
+	s := new(ObjectStatus)
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		var err error
+
+		s.header, err = db.get(tx, addr, false, true)
+		if err != nil {
+			if !errors.As(err, &splitInfoError) && !errors.Is(err, object.ErrNotFound) {
+				return err
+			}
+
+			s.header = nil
+		}
+
+		key := objectKey(addr.ObjectID())
+
+		if inBucket(tx, rootBucketName(addr.ContainerID()), key) {
+			s.splitInfo, err = getSplitInfo(tx, addr.ContainerID(), key)
+			if err != nil {
+				return err
+			}
+		}
+
+		if graveyard := tx.Bucket(graveyardBucketName); graveyard != nil {
+			if v := graveyard.Get(addressKey(addr)); v != nil {
+				s.grave, err = graveFromKV(addressKey(addr), v)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		s.blzID, err = db.isSmall(tx, addr)
+		if err != nil {
+			return err
+		}
+
+		s.toMoveIt = inBucket(tx, toMoveItBucketName, addressKey(addr))
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if s.header == nil && s.splitInfo == nil && s.grave == nil {
+		return nil, object.ErrNotFound
+	}
+
+	return s, nil
+}
+
+// ContainerStat groups object statistics of the container stored in DB.
+type ContainerStat struct {
+	id *cid.ID
+
+	regular, tombstones, storageGroups, small, parents uint64
+
+	size uint64
+}
+
+// ID returns container identifier.
+func (s *ContainerStat) ID() *cid.ID {
+	return s.id
+}
+
+// Regular returns number of stored regular objects.
+func (s *ContainerStat) Regular() uint64 {
+	return s.regular
+}
+
+// Tombstones returns number of stored tombstones.
+func (s *ContainerStat) Tombstones() uint64 {
+	return s.tombstones
+}
+
+// StorageGroups returns number of stored storage groups.
+func (s *ContainerStat) StorageGroups() uint64 {
+	return s.storageGroups
+}
+
+// Small returns number of objects stored in blobovniczas.
+func (s *ContainerStat) Small() uint64 {
+	return s.small
+}
+
+// Parents returns number of parent objects of the stored ones.
+func (s *ContainerStat) Parents() uint64 {
+	return s.parents
+}
+
+// Size returns estimated payload size of the stored regular objects.
+func (s *ContainerStat) Size() uint64 {
+	return s.size
+}
+
+// ContainerStats returns object statistics of all containers stored in DB.
+func (db *DB) ContainerStats() (list []*ContainerStat, err error) {
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		ids, err := db.containers(tx)
+		if err != nil {
+			return err
+		}
+
+		var size []byte
+
+		for i := range ids {
+			if b := tx.Bucket(containerVolumeBucketName); b != nil {
+				size = b.Get(ids[i].ToV2().GetValue())
+			}
+
+			list = append(list, &ContainerStat{
+				id:            ids[i],
+				regular:       keyNum(tx, primaryBucketName(ids[i])),
+				tombstones:    keyNum(tx, tombstoneBucketName(ids[i])),
+				storageGroups: keyNum(tx, storageGroupBucketName(ids[i])),
+				small:         keyNum(tx, smallBucketName(ids[i])),
+				parents:       keyNum(tx, rootBucketName(ids[i])),
+				size:          parseContainerSize(size),
+			})
+		}
+
+		return nil
+	})
+
+	return list, err
+}
+
+// keyNum returns number of keys in bucket <name>.
+func keyNum(tx *bbolt.Tx, name []byte) uint64 {
+	bkt := tx.Bucket(name)
+	if bkt == nil {
+		return 0
+	}
+
+	return uint64(bkt.Stats().KeyN)
+}
+
+// AttributeHandler is a handler of attribute index entries.
+type AttributeHandler func(key, value string, id *oidSDK.ID) error
+
+// IterateAttributes iterates over attribute index entries of the container.
+// Entries of all attributes are iterated if key is empty.
+//
+// If h returns ErrInterruptIterator, nil returns immediately.
+// Returns other errors of h directly.
+func (db *DB) IterateAttributes(cid *cid.ID, key string, h AttributeHandler) error {
+	prefix := []byte(cid.String() + userAttributePostfix)
+
+	err := db.boltDB.View(func(tx *bbolt.Tx) error {
+		c := tx.Cursor()
+
+		for name, _ := c.Seek(prefix); name != nil && bytes.HasPrefix(name, prefix); name, _ = c.Next() {
+			attrKey := string(name[len(prefix):])
+			if key != "" && attrKey != key {
+				continue
+			}
+
+			err := iterateFKBT(tx.Bucket(name), func(val []byte, id *oidSDK.ID) error {
+				return h(attrKey, string(val), id)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if errors.Is(err, ErrInterruptIterator) {
+		err = nil
+	}
+
+	return err
+}
+
+// iterateFKBT passes all object identifiers of the fake bucket tree index to f.
+func iterateFKBT(b *bbolt.Bucket, f func(val []byte, id *oidSDK.ID) error) error {
+	return b.ForEach(func(val, v []byte) error {
+		fkbtLeaf := b.Bucket(val)
+		if v != nil || fkbtLeaf == nil {
+			return nil
+		}
+
+		return fkbtLeaf.ForEach(func(k, _ []byte) error {
+			id := oidSDK.NewID()
+			if err := id.Parse(string(k)); err != nil {
+				return fmt.Errorf("could not parse object ID from attribute index: %w", err)
+			}
+
+			return f(val, id)
+		})
+	})
+}
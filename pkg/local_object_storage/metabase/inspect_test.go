@@ -0,0 +1,180 @@
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_ObjectStatus(t *testing.T) {
+	db := newDB(t)
+
+	t.Run("missing object", func(t *testing.T) {
+		_, err := db.ObjectStatus(generateAddress())
+		require.ErrorIs(t, err, object.ErrNotFound)
+	})
+
+	t.Run("small object", func(t *testing.T) {
+		raw := generateRawObject(t)
+		blzID := blobovnicza.ID{1, 2, 3, 4}
+
+		require.NoError(t, meta.Put(db, raw.Object(), &blzID))
+		require.NoError(t, meta.ToMoveIt(db, raw.Object().Address()))
+
+		s, err := db.ObjectStatus(raw.Object().Address())
+		require.NoError(t, err)
+		require.Equal(t, raw.ID(), s.Header().ID())
+		require.Equal(t, &blzID, s.BlobovniczaID())
+		require.True(t, s.ToMoveIt())
+		require.Nil(t, s.Grave())
+		require.Nil(t, s.SplitInfo())
+	})
+
+	t.Run("inhumed object", func(t *testing.T) {
+		raw := generateRawObject(t)
+		tomb := generateAddress()
+
+		require.NoError(t, putBig(db, raw.Object()))
+		require.NoError(t, meta.Inhume(db, raw.Object().Address(), tomb))
+
+		s, err := db.ObjectStatus(raw.Object().Address())
+		require.NoError(t, err)
+		require.Equal(t, raw.ID(), s.Header().ID())
+		require.Nil(t, s.BlobovniczaID())
+		require.False(t, s.Grave().WithGCMark())
+		require.Equal(t, tomb, s.Grave().Tombstone())
+	})
+
+	t.Run("GC-marked object", func(t *testing.T) {
+		addr := generateAddress()
+
+		_, err := db.Inhume(new(meta.InhumePrm).WithAddresses(addr).WithGCMark())
+		require.NoError(t, err)
+
+		s, err := db.ObjectStatus(addr)
+		require.NoError(t, err)
+		require.Nil(t, s.Header())
+		require.True(t, s.Grave().WithGCMark())
+		require.Nil(t, s.Grave().Tombstone())
+	})
+
+	t.Run("virtual object", func(t *testing.T) {
+		cid := cidtest.ID()
+		splitID := objectSDK.NewSplitID()
+
+		parent := generateRawObjectWithCID(t, cid)
+
+		child := generateRawObjectWithCID(t, cid)
+		child.SetParent(parent.Object().SDK())
+		child.SetParentID(parent.ID())
+		child.SetSplitID(splitID)
+
+		require.NoError(t, putBig(db, child.Object()))
+
+		s, err := db.ObjectStatus(parent.Object().Address())
+		require.NoError(t, err)
+		require.Nil(t, s.Header())
+		require.Equal(t, splitID, s.SplitInfo().SplitID())
+		require.Equal(t, child.ID(), s.SplitInfo().LastPart())
+	})
+}
+
+func TestDB_ContainerStats(t *testing.T) {
+	db := newDB(t)
+
+	cid := cidtest.ID()
+
+	small := generateRawObjectWithCID(t, cid)
+	require.NoError(t, meta.Put(db, small.Object(), &blobovnicza.ID{1}))
+
+	big := generateRawObjectWithCID(t, cid)
+	require.NoError(t, putBig(db, big.Object()))
+
+	ts := generateRawObjectWithCID(t, cid)
+	ts.SetType(objectSDK.TypeTombstone)
+	require.NoError(t, putBig(db, ts.Object()))
+
+	require.NoError(t, putBig(db, generateRawObject(t).Object()))
+
+	stats, err := db.ContainerStats()
+	require.NoError(t, err)
+	require.Len(t, stats, 2)
+
+	for _, s := range stats {
+		if !s.ID().Equal(cid) {
+			require.EqualValues(t, 1, s.Regular())
+			continue
+		}
+
+		require.EqualValues(t, 2, s.Regular())
+		require.EqualValues(t, 1, s.Tombstones())
+		require.EqualValues(t, 0, s.StorageGroups())
+		require.EqualValues(t, 1, s.Small())
+		require.Equal(t, small.PayloadSize()+big.PayloadSize(), s.Size())
+	}
+}
+
+func TestDB_IterateAttributes(t *testing.T) {
+	db := newDB(t)
+
+	cid := cidtest.ID()
+
+	raw1 := generateRawObjectWithCID(t, cid)
+	addAttribute(raw1, "foo", "bar")
+	addAttribute(raw1, "x", "y")
+
+	raw2 := generateRawObjectWithCID(t, cid)
+	addAttribute(raw2, "foo", "baz")
+
+	raw3 := generateRawObject(t)
+	addAttribute(raw3, "foo", "bar")
+
+	require.NoError(t, putBig(db, raw1.Object()))
+	require.NoError(t, putBig(db, raw2.Object()))
+	require.NoError(t, putBig(db, raw3.Object()))
+
+	type entry struct {
+		key, val string
+		id       string
+	}
+
+	collect := func(key string) []entry {
+		var res []entry
+
+		err := db.IterateAttributes(cid, key, func(key, val string, id *oidSDK.ID) error {
+			res = append(res, entry{key, val, id.String()})
+			return nil
+		})
+		require.NoError(t, err)
+
+		return res
+	}
+
+	require.ElementsMatch(t, []entry{
+		{"foo", "bar", raw1.ID().String()},
+		{"foo", "baz", raw2.ID().String()},
+	}, collect("foo"))
+
+	require.ElementsMatch(t, []entry{
+		{"foo", "bar", raw1.ID().String()},
+		{"foo", "baz", raw2.ID().String()},
+		{"x", "y", raw1.ID().String()},
+	}, collect(""))
+
+	require.Empty(t, collect("unknown"))
+
+	var n int
+
+	err := db.IterateAttributes(cid, "", func(string, string, *oidSDK.ID) error {
+		n++
+		return meta.ErrInterruptIterator
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+}
@@ -0,0 +1,284 @@
+package meta
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.etcd.io/bbolt"
+)
+
+// ReferencePayloadPrm groups the parameters of ReferencePayload operation.
+type ReferencePayloadPrm struct {
+	addr *addressSDK.Address
+
+	sum [sha256.Size]byte
+}
+
+// ReferencePayloadRes groups resulting values of ReferencePayload operation.
+type ReferencePayloadRes struct{}
+
+// WithAddress is a ReferencePayload option to set the address of the object
+// referencing the payload.
+//
+// Option is required.
+func (p *ReferencePayloadPrm) WithAddress(addr *addressSDK.Address) *ReferencePayloadPrm {
+	if p != nil {
+		p.addr = addr
+	}
+
+	return p
+}
+
+// WithChecksum is a ReferencePayload option to set SHA-256 checksum
+// of the referenced payload.
+//
+// Option is required.
+func (p *ReferencePayloadPrm) WithChecksum(sum [sha256.Size]byte) *ReferencePayloadPrm {
+	if p != nil {
+		p.sum = sum
+	}
+
+	return p
+}
+
+// DropUnreferencedPayloadPrm groups the parameters of DropUnreferencedPayload operation.
+type DropUnreferencedPayloadPrm struct {
+	sum [sha256.Size]byte
+}
+
+// DropUnreferencedPayloadRes groups resulting values of DropUnreferencedPayload operation.
+type DropUnreferencedPayloadRes struct {
+	dropped bool
+}
+
+// WithChecksum is a DropUnreferencedPayload option to set SHA-256 checksum
+// of the payload.
+//
+// Option is required.
+func (p *DropUnreferencedPayloadPrm) WithChecksum(sum [sha256.Size]byte) *DropUnreferencedPayloadPrm {
+	if p != nil {
+		p.sum = sum
+	}
+
+	return p
+}
+
+// Dropped returns true if the payload is not referenced by any object.
+func (r *DropUnreferencedPayloadRes) Dropped() bool {
+	return r.dropped
+}
+
+// UnreferencedPayloadsPrm groups the parameters of UnreferencedPayloads operation.
+type UnreferencedPayloadsPrm struct {
+	count int
+}
+
+// UnreferencedPayloadsRes groups resulting values of UnreferencedPayloads operation.
+type UnreferencedPayloadsRes struct {
+	sums [][sha256.Size]byte
+}
+
+// WithCount is an UnreferencedPayloads option to limit the number
+// of returned checksums. Non-positive value means no limit.
+func (p *UnreferencedPayloadsPrm) WithCount(count int) *UnreferencedPayloadsPrm {
+	if p != nil {
+		p.count = count
+	}
+
+	return p
+}
+
+// Checksums returns SHA-256 checksums of the payloads which are
+// not referenced by any object.
+func (r *UnreferencedPayloadsRes) Checksums() [][sha256.Size]byte {
+	return r.sums
+}
+
+// ReferencePayload registers the object as the user of the deduplicated payload.
+func ReferencePayload(db *DB, addr *addressSDK.Address, sum [sha256.Size]byte) error {
+	_, err := db.ReferencePayload(new(ReferencePayloadPrm).
+		WithAddress(addr).
+		WithChecksum(sum),
+	)
+
+	return err
+}
+
+// ReferencePayload increases the reference counter of the deduplicated payload.
+// Repeated calls for the same object do not change the counter.
+//
+// The reference is dropped on the object removal from the metabase.
+func (db *DB) ReferencePayload(prm *ReferencePayloadPrm) (*ReferencePayloadRes, error) {
+	return new(ReferencePayloadRes), db.boltDB.Update(func(tx *bbolt.Tx) error {
+		objects, err := tx.CreateBucketIfNotExists(payloadObjectsBucketName)
+		if err != nil {
+			return err
+		}
+
+		refs, err := tx.CreateBucketIfNotExists(payloadRefsBucketName)
+		if err != nil {
+			return err
+		}
+
+		addrKey := addressKey(prm.addr)
+
+		if old := objects.Get(addrKey); old != nil {
+			if string(old) == string(prm.sum[:]) {
+				return nil
+			}
+
+			// object is re-saved with another payload
+			if err = changePayloadReferences(refs, append([]byte{}, old...), -1); err != nil {
+				return err
+			}
+		}
+
+		if err = objects.Put(addrKey, prm.sum[:]); err != nil {
+			return err
+		}
+
+		return changePayloadReferences(refs, prm.sum[:], 1)
+	})
+}
+
+// DropUnreferencedPayload removes the record of the deduplicated payload
+// if it is not referenced by any object.
+//
+// Returns true if the payload can be removed from the storage.
+func DropUnreferencedPayload(db *DB, sum [sha256.Size]byte) (bool, error) {
+	r, err := db.DropUnreferencedPayload(new(DropUnreferencedPayloadPrm).WithChecksum(sum))
+	if err != nil {
+		return false, err
+	}
+
+	return r.Dropped(), nil
+}
+
+// DropUnreferencedPayload removes the reference counter of the payload
+// if it is zero. Payloads without the counter are considered unreferenced.
+func (db *DB) DropUnreferencedPayload(prm *DropUnreferencedPayloadPrm) (res *DropUnreferencedPayloadRes, err error) {
+	res = new(DropUnreferencedPayloadRes)
+
+	err = db.boltDB.Update(func(tx *bbolt.Tx) error {
+		refs := tx.Bucket(payloadRefsBucketName)
+		if refs == nil {
+			res.dropped = true
+			return nil
+		}
+
+		if payloadReferences(refs, prm.sum[:]) > 0 {
+			return nil
+		}
+
+		res.dropped = true
+
+		return refs.Delete(prm.sum[:])
+	})
+
+	return
+}
+
+// UnreferencedPayloads returns checksums of the deduplicated payloads
+// which are not referenced by any object.
+func UnreferencedPayloads(db *DB, count int) ([][sha256.Size]byte, error) {
+	r, err := db.UnreferencedPayloads(new(UnreferencedPayloadsPrm).WithCount(count))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Checksums(), nil
+}
+
+// UnreferencedPayloads lists the payloads with zero reference counter.
+func (db *DB) UnreferencedPayloads(prm *UnreferencedPayloadsPrm) (res *UnreferencedPayloadsRes, err error) {
+	res = new(UnreferencedPayloadsRes)
+
+	err = db.boltDB.View(func(tx *bbolt.Tx) error {
+		refs := tx.Bucket(payloadRefsBucketName)
+		if refs == nil {
+			return nil
+		}
+
+		return refs.ForEach(func(k, v []byte) error {
+			if prm.count > 0 && len(res.sums) == prm.count {
+				return ErrInterruptIterator
+			}
+
+			if len(k) != sha256.Size || binary.LittleEndian.Uint64(v) > 0 {
+				return nil
+			}
+
+			var sum [sha256.Size]byte
+
+			copy(sum[:], k)
+			res.sums = append(res.sums, sum)
+
+			return nil
+		})
+	})
+	if errors.Is(err, ErrInterruptIterator) {
+		err = nil
+	}
+
+	return
+}
+
+// dropPayloadReference decreases the reference counter of the payload
+// used by the object if there is any.
+func dropPayloadReference(tx *bbolt.Tx, addr *addressSDK.Address) error {
+	objects := tx.Bucket(payloadObjectsBucketName)
+	if objects == nil {
+		return nil
+	}
+
+	addrKey := addressKey(addr)
+
+	sum := objects.Get(addrKey)
+	if sum == nil {
+		return nil
+	}
+
+	refs := tx.Bucket(payloadRefsBucketName)
+	if refs != nil {
+		// copy checksum since it is used as a key of another bucket
+		if err := changePayloadReferences(refs, append([]byte{}, sum...), -1); err != nil {
+			return err
+		}
+	}
+
+	return objects.Delete(addrKey)
+}
+
+func payloadReferences(refs *bbolt.Bucket, sum []byte) uint64 {
+	v := refs.Get(sum)
+	if len(v) != 8 {
+		return 0
+	}
+
+	return binary.LittleEndian.Uint64(v)
+}
+
+func changePayloadReferences(refs *bbolt.Bucket, sum []byte, delta int) error {
+	n := payloadReferences(refs, sum)
+
+	switch {
+	case delta > 0:
+		n += uint64(delta)
+	case uint64(-delta) < n:
+		n -= uint64(-delta)
+	default:
+		n = 0
+	}
+
+	v := make([]byte, 8)
+	binary.LittleEndian.PutUint64(v, n)
+
+	if err := refs.Put(sum, v); err != nil {
+		return fmt.Errorf("could not update payload references: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package meta_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDB_PayloadReferences(t *testing.T) {
+	db := newDB(t)
+
+	raw1 := generateRawObject(t)
+	raw2 := generateRawObject(t)
+
+	require.NoError(t, putBig(db, raw1.Object()))
+	require.NoError(t, putBig(db, raw2.Object()))
+
+	sum := sha256.Sum256([]byte("payload"))
+
+	// both objects reference the same payload
+	require.NoError(t, meta.ReferencePayload(db, raw1.Object().Address(), sum))
+	require.NoError(t, meta.ReferencePayload(db, raw2.Object().Address(), sum))
+
+	// repeated reference does not change the counter
+	require.NoError(t, meta.ReferencePayload(db, raw2.Object().Address(), sum))
+
+	sums, err := meta.UnreferencedPayloads(db, 0)
+	require.NoError(t, err)
+	require.Empty(t, sums)
+
+	ok, err := meta.DropUnreferencedPayload(db, sum)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// payload is still referenced by the second object
+	require.NoError(t, meta.Delete(db, raw1.Object().Address()))
+
+	sums, err = meta.UnreferencedPayloads(db, 0)
+	require.NoError(t, err)
+	require.Empty(t, sums)
+
+	require.NoError(t, meta.Delete(db, raw2.Object().Address()))
+
+	sums, err = meta.UnreferencedPayloads(db, 0)
+	require.NoError(t, err)
+	require.Equal(t, [][sha256.Size]byte{sum}, sums)
+
+	ok, err = meta.DropUnreferencedPayload(db, sum)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	sums, err = meta.UnreferencedPayloads(db, 0)
+	require.NoError(t, err)
+	require.Empty(t, sums)
+
+	t.Run("unknown payload", func(t *testing.T) {
+		ok, err := meta.DropUnreferencedPayload(db, sha256.Sum256([]byte("unknown")))
+		require.NoError(t, err)
+		require.True(t, ok)
+	})
+}
@@ -158,6 +158,20 @@ func (db *DB) put(tx *bbolt.Tx, obj *object.Object, id *blobovnicza.ID, si *obje
 		}
 	}
 
+	// index header of the erasure-coded object
+	if !isParent && object.IsErasureCodePart(obj) {
+		if err = db.putErasureCodeParent(tx, obj); err != nil {
+			return err
+		}
+	}
+
+	// register local arrival of the physically stored object
+	if !isParent {
+		if err = putArrival(tx, obj.Address()); err != nil {
+			return fmt.Errorf("could not put arrival number: %w", err)
+		}
+	}
+
 	// update container volume size estimation
 	if obj.Type() == objectSDK.TypeRegular && !isParent {
 		err = changeContainerSize(
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	v2object "github.com/nspcc-dev/neofs-api-go/v2/object"
+	objectcore "github.com/nspcc-dev/neofs-node/pkg/core/object"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
@@ -23,6 +24,9 @@ type (
 		cid         *cid.ID
 		fastFilters object.SearchFilters
 		slowFilters object.SearchFilters
+
+		// parts of the erasure-coded objects are requested
+		withECParts bool
 	}
 )
 
@@ -135,6 +139,10 @@ func (db *DB) selectObjects(tx *bbolt.Tx, cid *cid.ID, fs object.SearchFilters)
 			continue // ignore removed objects
 		}
 
+		if !group.withECParts && isErasureCodePart(tx, addr) {
+			continue // ignore parts of the erasure-coded objects
+		}
+
 		if !db.matchSlowFilters(tx, addr, group.slowFilters) {
 			continue // ignore objects with unmatched slow filters
 		}
@@ -153,6 +161,7 @@ func (db *DB) selectAll(tx *bbolt.Tx, cid *cid.ID, to map[string]int) {
 	selectAllFromBucket(tx, tombstoneBucketName(cid), prefix, to, 0)
 	selectAllFromBucket(tx, storageGroupBucketName(cid), prefix, to, 0)
 	selectAllFromBucket(tx, parentBucketName(cid), prefix, to, 0)
+	selectAllFromBucket(tx, ecHeaderBucketName(cid), prefix, to, 0)
 }
 
 // selectAllFromBucket goes through all keys in bucket and adds them in a
@@ -219,7 +228,7 @@ func (db *DB) selectFastFilter(
 }
 
 var mBucketNaming = map[string][]func(*cid.ID) []byte{
-	v2object.TypeRegular.String():      {primaryBucketName, parentBucketName},
+	v2object.TypeRegular.String():      {primaryBucketName, parentBucketName, ecHeaderBucketName},
 	v2object.TypeTombstone.String():    {tombstoneBucketName},
 	v2object.TypeStorageGroup.String(): {storageGroupBucketName},
 }
@@ -447,7 +456,8 @@ func (db *DB) selectObjectID(
 		}
 
 		ok, err := db.exists(tx, addr)
-		if (err == nil && ok) || errors.As(err, &splitInfoError) {
+		if (err == nil && ok) || errors.As(err, &splitInfoError) ||
+			inBucket(tx, ecHeaderBucketName(cid), []byte(oid)) {
 			markAddressInCache(to, fNum, addrStr)
 		}
 	}
@@ -495,6 +505,10 @@ func (db *DB) matchSlowFilters(tx *bbolt.Tx, addr *addressSDK.Address, f object.
 	}
 
 	obj, err := db.get(tx, addr, true, false)
+	if errors.Is(err, objectcore.ErrNotFound) {
+		obj, err = getErasureCodeHeader(tx, addr)
+	}
+
 	if err != nil {
 		return false
 	}
@@ -556,6 +570,11 @@ func groupFilters(filters object.SearchFilters) (*filterGroup, error) {
 			res.slowFilters = append(res.slowFilters, filters[i])
 		default: // fast filters or user attributes if unknown
 			res.fastFilters = append(res.fastFilters, filters[i])
+
+			if filters[i].Header() == v2object.FilterPropertyPhy ||
+				objectcore.IsErasureCodeAttribute(filters[i].Header()) {
+				res.withECParts = true
+			}
 		}
 	}
 
@@ -24,6 +24,9 @@ var (
 	graveyardBucketName       = []byte(invalidBase58String + "Graveyard")
 	toMoveItBucketName        = []byte(invalidBase58String + "ToMoveIt")
 	containerVolumeBucketName = []byte(invalidBase58String + "ContainerSize")
+	payloadRefsBucketName     = []byte(invalidBase58String + "PayloadRefs")
+	payloadObjectsBucketName  = []byte(invalidBase58String + "PayloadObjects")
+	arrivalBucketName         = []byte(invalidBase58String + "Arrivals")
 
 	zeroValue = []byte{0xFF}
 
@@ -35,6 +38,8 @@ var (
 	rootPostfix         = invalidBase58String + "root"
 	parentPostfix       = invalidBase58String + "parent"
 	splitPostfix        = invalidBase58String + "splitid"
+	ecHeaderPostfix     = invalidBase58String + "ECheader"
+	ecPartPostfix       = invalidBase58String + "ECpart"
 
 	userAttributePostfix = invalidBase58String + "attr_"
 
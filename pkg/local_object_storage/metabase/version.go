@@ -0,0 +1,198 @@
+package meta
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"go.etcd.io/bbolt"
+	"go.uber.org/zap"
+)
+
+// Version is the current version of the metabase schema.
+//
+// Version must be increased with every change of the bucket layout,
+// and a migration from the previous version must be added to migrations.
+const Version = 3
+
+var (
+	shardInfoBucket = []byte(invalidBase58String + "i")
+	versionKey      = []byte("version")
+)
+
+// ErrUnsupportedVersion is returned when the metabase has been created by
+// a newer version of the storage node.
+var ErrUnsupportedVersion = errors.New("unsupported metabase version")
+
+var errNotEmpty = errors.New("database is not empty")
+
+// migration upgrades the layout of the metabase by one version.
+type migration struct {
+	desc string
+
+	upgrade func(*bbolt.Tx) error
+}
+
+// migrations contains upgrades of the metabase, i-th element upgrades
+// the layout of version i to version i+1.
+var migrations = [Version]migration{
+	{
+		// metabases created before versioning have the same layout
+		desc:    "store schema version",
+		upgrade: func(*bbolt.Tx) error { return nil },
+	},
+	{
+		desc: "add payload reference buckets",
+		upgrade: func(tx *bbolt.Tx) error {
+			for _, name := range [][]byte{payloadRefsBucketName, payloadObjectsBucketName} {
+				if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	},
+	{
+		desc:    "add object arrival index",
+		upgrade: indexArrivals,
+	},
+}
+
+// MigratePrm groups the parameters of Migrate operation.
+type MigratePrm struct {
+	dryRun bool
+}
+
+// MigrateRes groups resulting values of Migrate operation.
+type MigrateRes struct {
+	version uint64
+
+	migrations []string
+}
+
+// WithDryRun is a Migrate option to only list pending migrations
+// without changing the database.
+func (p *MigratePrm) WithDryRun(v bool) *MigratePrm {
+	if p != nil {
+		p.dryRun = v
+	}
+
+	return p
+}
+
+// Version returns version of the metabase before migration.
+func (r *MigrateRes) Version() uint64 {
+	return r.version
+}
+
+// Migrations returns descriptions of the applied migrations
+// (pending ones in dry run mode).
+func (r *MigrateRes) Migrations() []string {
+	return r.migrations
+}
+
+// Migrate upgrades the metabase layout to the current Version.
+//
+// Returns ErrUnsupportedVersion if the metabase has a newer version.
+func (db *DB) Migrate(prm *MigratePrm) (res *MigrateRes, err error) {
+	res = new(MigrateRes)
+
+	exec := db.boltDB.Update
+	if prm.dryRun {
+		exec = db.boltDB.View
+	}
+
+	err = exec(func(tx *bbolt.Tx) error {
+		var err error
+
+		res.version, err = checkVersion(tx)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range migrations[res.version:] {
+			res.migrations = append(res.migrations, m.desc)
+		}
+
+		if prm.dryRun {
+			return nil
+		}
+
+		err = db.migrate(tx, res.version)
+		if err != nil {
+			return err
+		}
+
+		return putVersion(tx, Version)
+	})
+
+	return res, err
+}
+
+// migrate applies all migrations starting from the specified version.
+func (db *DB) migrate(tx *bbolt.Tx, from uint64) error {
+	for v := from; v < Version; v++ {
+		db.log.Info("migrating metabase",
+			zap.Uint64("from", v),
+			zap.Uint64("to", v+1),
+			zap.String("migration", migrations[v].desc),
+		)
+
+		if err := migrations[v].upgrade(tx); err != nil {
+			return fmt.Errorf("could not migrate metabase from version %d: %w", v, err)
+		}
+	}
+
+	return nil
+}
+
+// checkVersion returns version of the metabase layout if it is supported.
+func checkVersion(tx *bbolt.Tx) (uint64, error) {
+	v, err := storedVersion(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	if v > Version {
+		return 0, fmt.Errorf("%w: %d, expected at most %d", ErrUnsupportedVersion, v, Version)
+	}
+
+	return v, nil
+}
+
+// storedVersion returns version of the metabase layout. Empty
+// databases have the current version and databases without version
+// record have version 0.
+func storedVersion(tx *bbolt.Tx) (uint64, error) {
+	if b := tx.Bucket(shardInfoBucket); b != nil {
+		if data := b.Get(versionKey); data != nil {
+			if len(data) != 8 {
+				return 0, fmt.Errorf("invalid metabase version length %d", len(data))
+			}
+
+			return binary.LittleEndian.Uint64(data), nil
+		}
+	}
+
+	err := tx.ForEach(func([]byte, *bbolt.Bucket) error {
+		return errNotEmpty
+	})
+	if errors.Is(err, errNotEmpty) {
+		return 0, nil
+	}
+
+	return Version, err
+}
+
+func putVersion(tx *bbolt.Tx, version uint64) error {
+	b, err := tx.CreateBucketIfNotExists(shardInfoBucket)
+	if err != nil {
+		return fmt.Errorf("could not create shard info bucket: %w", err)
+	}
+
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, version)
+
+	return b.Put(versionKey, data)
+}
@@ -0,0 +1,192 @@
+package meta_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+// fixtures create metabases of the specified version with a single
+// stored object.
+var fixtures = map[uint64]func(t *testing.T, path string){
+	0: func(t *testing.T, path string) {
+		fixtureV1(t, path)
+
+		// metabases before versioning have the same layout
+		// but lack version record
+		updateRaw(t, path, func(tx *bbolt.Tx) error {
+			return tx.DeleteBucket([]byte("_i"))
+		})
+	},
+	1: fixtureV1,
+	2: fixtureV2,
+	3: fixtureV3,
+}
+
+func fixtureV1(t *testing.T, path string) {
+	fixtureV2(t, path)
+
+	// payload reference buckets were added in version 2
+	updateRaw(t, path, func(tx *bbolt.Tx) error {
+		for _, name := range []string{"_PayloadRefs", "_PayloadObjects"} {
+			if err := tx.DeleteBucket([]byte(name)); err != nil {
+				return err
+			}
+		}
+
+		data := make([]byte, 8)
+		binary.LittleEndian.PutUint64(data, 1)
+
+		return tx.Bucket([]byte("_i")).Put([]byte("version"), data)
+	})
+}
+
+func fixtureV2(t *testing.T, path string) {
+	fixtureV3(t, path)
+
+	// arrival index was added in version 3
+	updateRaw(t, path, func(tx *bbolt.Tx) error {
+		if err := tx.DeleteBucket([]byte("_Arrivals")); err != nil {
+			return err
+		}
+
+		data := make([]byte, 8)
+		binary.LittleEndian.PutUint64(data, 2)
+
+		return tx.Bucket([]byte("_i")).Put([]byte("version"), data)
+	})
+}
+
+func fixtureV3(t *testing.T, path string) {
+	db := meta.New(meta.WithPath(path), meta.WithPermissions(0600))
+	require.NoError(t, db.Open())
+	require.NoError(t, db.Init())
+	require.NoError(t, putBig(db, generateRawObject(t).Object()))
+	require.NoError(t, db.Close())
+}
+
+func updateRaw(t *testing.T, path string, f func(*bbolt.Tx) error) {
+	db, err := bbolt.Open(path, 0600, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(f))
+	require.NoError(t, db.Close())
+}
+
+func openFixture(t *testing.T, version uint64) *meta.DB {
+	path := filepath.Join(t.TempDir(), "meta")
+	fixtures[version](t, path)
+
+	db := meta.New(meta.WithPath(path), meta.WithPermissions(0600))
+	require.NoError(t, db.Open())
+
+	t.Cleanup(func() {
+		db.Close()
+		os.Remove(path)
+	})
+
+	return db
+}
+
+func TestVersion(t *testing.T) {
+	require.Len(t, fixtures, meta.Version+1)
+
+	checkObjects := func(t *testing.T, db *meta.DB) {
+		addrs, _, err := meta.ListWithCursor(db, 10, nil)
+		require.NoError(t, err)
+		require.Len(t, addrs, 1)
+
+		// objects stored before the arrival index get the numbers on migration
+		res, err := db.Arrivals(new(meta.ArrivalsPrm))
+		require.NoError(t, err)
+		require.Len(t, res.AddressList(), 1)
+		require.EqualValues(t, 1, res.Watermark())
+	}
+
+	for v := range fixtures {
+		v := v
+
+		t.Run(fmt.Sprintf("from version %d", v), func(t *testing.T) {
+			db := openFixture(t, v)
+
+			res, err := db.Migrate(new(meta.MigratePrm).WithDryRun(true))
+			require.NoError(t, err)
+			require.Equal(t, v, res.Version())
+			require.Len(t, res.Migrations(), meta.Version-int(v))
+
+			// dry run does not change the database
+			res, err = db.Migrate(new(meta.MigratePrm).WithDryRun(true))
+			require.NoError(t, err)
+			require.Equal(t, v, res.Version())
+
+			require.NoError(t, db.Init())
+			checkObjects(t, db)
+
+			res, err = db.Migrate(new(meta.MigratePrm).WithDryRun(true))
+			require.NoError(t, err)
+			require.EqualValues(t, meta.Version, res.Version())
+			require.Empty(t, res.Migrations())
+		})
+	}
+
+	t.Run("offline migration", func(t *testing.T) {
+		db := openFixture(t, 0)
+
+		res, err := db.Migrate(new(meta.MigratePrm))
+		require.NoError(t, err)
+		require.Zero(t, res.Version())
+		require.Len(t, res.Migrations(), meta.Version)
+
+		res, err = db.Migrate(new(meta.MigratePrm).WithDryRun(true))
+		require.NoError(t, err)
+		require.EqualValues(t, meta.Version, res.Version())
+		checkObjects(t, db)
+	})
+
+	t.Run("new database", func(t *testing.T) {
+		db := newDB(t)
+
+		require.NoError(t, db.Init())
+
+		res, err := db.Migrate(new(meta.MigratePrm).WithDryRun(true))
+		require.NoError(t, err)
+		require.EqualValues(t, meta.Version, res.Version())
+		require.Empty(t, res.Migrations())
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		db := openFixture(t, 0)
+
+		require.NoError(t, db.Reset())
+
+		res, err := db.Migrate(new(meta.MigratePrm).WithDryRun(true))
+		require.NoError(t, err)
+		require.EqualValues(t, meta.Version, res.Version())
+	})
+
+	t.Run("newer version", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "meta")
+		fixtureV3(t, path)
+
+		updateRaw(t, path, func(tx *bbolt.Tx) error {
+			data := make([]byte, 8)
+			binary.LittleEndian.PutUint64(data, meta.Version+1)
+
+			return tx.Bucket([]byte("_i")).Put([]byte("version"), data)
+		})
+
+		db := meta.New(meta.WithPath(path), meta.WithPermissions(0600))
+		require.NoError(t, db.Open())
+		defer db.Close()
+
+		require.ErrorIs(t, db.Init(), meta.ErrUnsupportedVersion)
+
+		_, err := db.Migrate(new(meta.MigratePrm))
+		require.ErrorIs(t, err, meta.ErrUnsupportedVersion)
+	})
+}
@@ -75,55 +75,104 @@ func (s *Shard) Init() error {
 	return nil
 }
 
+// InitCheck initializes Shard's components for the consistency check
+// without changing them: unlike Init, metabase is neither migrated nor
+// refilled and GC is not started.
+//
+// Returns an error if metabase has not the current version.
+func (s *Shard) InitCheck() error {
+	if err := s.blobStor.Init(); err != nil {
+		return fmt.Errorf("could not initialize %T: %w", s.blobStor, err)
+	}
+
+	res, err := s.metaBase.Migrate(new(meta.MigratePrm).WithDryRun(true))
+	if err != nil {
+		return fmt.Errorf("could not check metabase version: %w", err)
+	}
+
+	if len(res.Migrations()) != 0 {
+		return fmt.Errorf("metabase version %d differs from the current %d, migration is required",
+			res.Version(), meta.Version)
+	}
+
+	return nil
+}
+
 func (s *Shard) refillMetabase() error {
 	err := s.metaBase.Reset()
 	if err != nil {
 		return fmt.Errorf("could not reset metabase: %w", err)
 	}
 
-	return blobstor.IterateObjects(s.blobStor, func(obj *object.Object, blzID *blobovnicza.ID) error {
-		if obj.Type() == objectSDK.TypeTombstone {
-			tombstone := objectSDK.NewTombstone()
+	var prm blobstor.IteratePrm
 
-			if err := tombstone.Unmarshal(obj.Payload()); err != nil {
-				return fmt.Errorf("could not unmarshal tombstone content: %w", err)
-			}
+	prm.SetIterationHandler(func(elem blobstor.IterationElement) error {
+		obj := object.New()
 
-			tombAddr := obj.Address()
-			cid := tombAddr.ContainerID()
-			memberIDs := tombstone.Members()
-			tombMembers := make([]*addressSDK.Address, 0, len(memberIDs))
+		if err := obj.Unmarshal(elem.ObjectData()); err != nil {
+			return fmt.Errorf("could not unmarshal the object: %w", err)
+		}
 
-			for _, id := range memberIDs {
-				if id == nil {
-					return errors.New("empty member in tombstone")
-				}
+		if err := s.indexObject(obj, elem.BlobovniczaID()); err != nil {
+			return err
+		}
 
-				a := addressSDK.NewAddress()
-				a.SetContainerID(cid)
-				a.SetObjectID(id)
+		if elem.DeduplicatedPayload() {
+			return s.referencePayload(obj)
+		}
 
-				tombMembers = append(tombMembers, a)
-			}
+		return nil
+	})
 
-			var inhumePrm meta.InhumePrm
+	_, err = s.blobStor.Iterate(prm)
 
-			inhumePrm.WithTombstoneAddress(tombAddr)
-			inhumePrm.WithAddresses(tombMembers...)
+	return err
+}
+
+// indexObject saves stored object to metabase. Members of the
+// tombstones are inhumed.
+func (s *Shard) indexObject(obj *object.Object, blzID *blobovnicza.ID) error {
+	if obj.Type() == objectSDK.TypeTombstone {
+		tombstone := objectSDK.NewTombstone()
+
+		if err := tombstone.Unmarshal(obj.Payload()); err != nil {
+			return fmt.Errorf("could not unmarshal tombstone content: %w", err)
+		}
+
+		tombAddr := obj.Address()
+		cid := tombAddr.ContainerID()
+		memberIDs := tombstone.Members()
+		tombMembers := make([]*addressSDK.Address, 0, len(memberIDs))
 
-			_, err = s.metaBase.Inhume(&inhumePrm)
-			if err != nil {
-				return fmt.Errorf("could not inhume objects: %w", err)
+		for _, id := range memberIDs {
+			if id == nil {
+				return errors.New("empty member in tombstone")
 			}
+
+			a := addressSDK.NewAddress()
+			a.SetContainerID(cid)
+			a.SetObjectID(id)
+
+			tombMembers = append(tombMembers, a)
 		}
 
-		err := meta.Put(s.metaBase, obj, blzID)
-		if err != nil && !errors.Is(err, object.ErrAlreadyRemoved) {
-			return err
+		var inhumePrm meta.InhumePrm
+
+		inhumePrm.WithTombstoneAddress(tombAddr)
+		inhumePrm.WithAddresses(tombMembers...)
+
+		_, err := s.metaBase.Inhume(&inhumePrm)
+		if err != nil {
+			return fmt.Errorf("could not inhume objects: %w", err)
 		}
+	}
 
-		return nil
-	})
+	err := meta.Put(s.metaBase, obj, blzID)
+	if err != nil && !errors.Is(err, object.ErrAlreadyRemoved) {
+		return err
+	}
+
+	return nil
 }
 
 // Close releases all Shard's components.
@@ -142,7 +191,10 @@ func (s *Shard) Close() error {
 		}
 	}
 
-	s.gc.stop()
+	// GC is not started by InitCheck
+	if s.gc != nil {
+		s.gc.stop()
+	}
 
 	return nil
 }
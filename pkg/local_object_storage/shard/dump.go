@@ -1,22 +1,32 @@
 package shard
 
 import (
-	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 )
 
-var dumpMagic = []byte("NEOF")
-
 // DumpPrm groups the parameters of Dump operation.
 type DumpPrm struct {
 	path         string
 	stream       io.Writer
 	ignoreErrors bool
+
+	containers []*cid.ID
+
+	fromEpoch, toEpoch uint64
+
+	incremental bool
+	since       uint64
+
+	compress bool
 }
 
 // WithPath is an Dump option to set the destination path.
@@ -39,9 +49,47 @@ func (p *DumpPrm) WithIgnoreErrors(ignore bool) *DumpPrm {
 	return p
 }
 
+// WithContainers is a Dump option to dump only the objects
+// from the specified containers.
+func (p *DumpPrm) WithContainers(ids ...*cid.ID) *DumpPrm {
+	p.containers = ids
+	return p
+}
+
+// WithEpochRange is a Dump option to dump only the objects
+// created in the specified epoch range. Both bounds are inclusive,
+// zero upper bound means no limit.
+func (p *DumpPrm) WithEpochRange(from, to uint64) *DumpPrm {
+	p.fromEpoch, p.toEpoch = from, to
+	return p
+}
+
+// WithIncremental is a Dump option to make an incremental dump which
+// contains only the objects (including tombstones) saved in the shard after
+// the previous dump regardless of their creation epoch. Watermark of the
+// previous dump should be used as `since` value, so that the increments can
+// be applied in order. Objects from the write-cache are always dumped.
+func (p *DumpPrm) WithIncremental(since uint64) *DumpPrm {
+	p.incremental = true
+	p.since = since
+	return p
+}
+
+// WithCompression is a Dump option to compress the dump body with zstd.
+func (p *DumpPrm) WithCompression(compress bool) *DumpPrm {
+	p.compress = compress
+	return p
+}
+
+// needsHeader checks whether the object must be decoded to apply filters.
+func (p *DumpPrm) needsHeader() bool {
+	return len(p.containers) != 0 || p.fromEpoch != 0 || p.toEpoch != 0 || p.incremental
+}
+
 // DumpRes groups the result fields of Dump operation.
 type DumpRes struct {
-	count int
+	count     int
+	watermark uint64
 }
 
 // Count return amount of object written.
@@ -49,6 +97,16 @@ func (r *DumpRes) Count() int {
 	return r.count
 }
 
+// Watermark returns the watermark of the incremental dump: the
+// number of the last object saved in the shard according to the local
+// arrival counter of the metabase. The value should be passed to the next
+// incremental dump.
+//
+// Always returns zero for full dumps.
+func (r *DumpRes) Watermark() uint64 {
+	return r.watermark
+}
+
 var ErrMustBeReadOnly = errors.New("shard must be in read-only mode")
 
 // Dump dumps all objects from the shard to a file or stream.
@@ -73,28 +131,84 @@ func (s *Shard) Dump(prm *DumpPrm) (*DumpRes, error) {
 		w = f
 	}
 
-	_, err := w.Write(dumpMagic)
+	hdr := dumpHeader{version: dumpVersion}
+
+	if prm.compress {
+		hdr.flags |= dumpFlagCompressed
+	}
+
+	if prm.incremental {
+		hdr.flags |= dumpFlagIncremental
+		hdr.since = prm.since
+	}
+
+	dw, err := newDumpWriter(w, hdr)
 	if err != nil {
 		return nil, err
 	}
 
-	var count int
+	var (
+		count     int
+		watermark uint64
 
-	if s.hasWriteCache() {
-		err := s.writeCache.Iterate(new(writecache.IterationPrm).WithHandler(func(data []byte) error {
-			var size [4]byte
-			binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-			if _, err := w.Write(size[:]); err != nil {
+		// addresses of the objects arrived after the previous dump
+		arrived map[string]struct{}
+	)
+
+	if prm.incremental {
+		res, err := s.metaBase.Arrivals(new(meta.ArrivalsPrm).WithSince(prm.since))
+		if err != nil {
+			return nil, fmt.Errorf("could not select arrived objects: %w", err)
+		}
+
+		watermark = res.Watermark()
+		if watermark < prm.since {
+			watermark = prm.since
+		}
+
+		addrs := res.AddressList()
+		arrived = make(map[string]struct{}, len(addrs))
+
+		for i := range addrs {
+			arrived[addrs[i].String()] = struct{}{}
+		}
+	}
+
+	writeObject := func(data []byte, fromCache bool) error {
+		if prm.needsHeader() {
+			obj := object.New()
+			if err := obj.Unmarshal(data); err != nil {
+				if prm.ignoreErrors {
+					return nil
+				}
 				return err
 			}
 
-			if _, err := w.Write(data); err != nil {
-				return err
+			if !prm.match(obj) {
+				return nil
+			}
+
+			if prm.incremental && !fromCache {
+				if _, ok := arrived[obj.Address().String()]; !ok {
+					return nil
+				}
 			}
+		}
+
+		if err := dw.writeRecord(data); err != nil {
+			return err
+		}
 
-			count++
-			return nil
-		}).WithIgnoreErrors(prm.ignoreErrors))
+		count++
+		return nil
+	}
+
+	if s.hasWriteCache() {
+		err := s.writeCache.Iterate(new(writecache.IterationPrm).
+			WithHandler(func(data []byte) error {
+				return writeObject(data, true)
+			}).
+			WithIgnoreErrors(prm.ignoreErrors))
 		if err != nil {
 			return nil, err
 		}
@@ -106,25 +220,43 @@ func (s *Shard) Dump(prm *DumpPrm) (*DumpRes, error) {
 		pi.IgnoreErrors()
 	}
 	pi.SetIterationHandler(func(elem blobstor.IterationElement) error {
-		data := elem.ObjectData()
-
-		var size [4]byte
-		binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
-		if _, err := w.Write(size[:]); err != nil {
-			return err
-		}
-
-		if _, err := w.Write(data); err != nil {
-			return err
-		}
-
-		count++
-		return nil
+		return writeObject(elem.ObjectData(), false)
 	})
 
 	if _, err := s.blobStor.Iterate(pi); err != nil {
 		return nil, err
 	}
 
-	return &DumpRes{count: count}, nil
+	if !prm.incremental {
+		watermark = 0
+	}
+
+	if err := dw.close(watermark); err != nil {
+		return nil, err
+	}
+
+	return &DumpRes{count: count, watermark: watermark}, nil
+}
+
+// match checks whether the object satisfies the filters.
+func (p *DumpPrm) match(obj *object.Object) bool {
+	epoch := obj.CreationEpoch()
+
+	if epoch < p.fromEpoch || p.toEpoch != 0 && epoch > p.toEpoch {
+		return false
+	}
+
+	if len(p.containers) == 0 {
+		return true
+	}
+
+	cnr := obj.ContainerID()
+
+	for i := range p.containers {
+		if cnr.Equal(p.containers[i]) {
+			return true
+		}
+	}
+
+	return false
 }
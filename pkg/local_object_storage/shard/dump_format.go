@@ -0,0 +1,320 @@
+package shard
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// Dump format.
+//
+// Dump starts with the fixed-size header:
+//
+//	magic "NEOD" (4 bytes) | version (1 byte) | flags (1 byte) | reserved (2 bytes) |
+//	since (8 bytes) | CRC32C of the previous header bytes (4 bytes)
+//
+// It is followed by the body which is a zstd stream if dumpFlagCompressed
+// flag is set. Body consists of the object records:
+//
+//	size (4 bytes) | CRC32C of the data (4 bytes) | data (size bytes)
+//
+// and is terminated by the trailer:
+//
+//	zero size (4 bytes) | number of records (4 bytes) | watermark (8 bytes) |
+//	CRC32C of the previous trailer bytes except the size (4 bytes)
+//
+// All integers are little-endian.
+//
+// Dumps of the legacy format consist of "NEOF" magic followed by
+// the records without checksums up to the end of the file.
+var (
+	legacyDumpMagic = []byte("NEOF")
+	dumpMagic       = []byte("NEOD")
+)
+
+const (
+	dumpVersion = 1
+
+	dumpHeaderSize  = 20
+	dumpTrailerSize = 16
+)
+
+const (
+	dumpFlagCompressed = 1 << iota
+	dumpFlagIncremental
+)
+
+var dumpCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+// ErrUnsupportedVersion is returned when dump format version is not supported.
+var ErrUnsupportedVersion = errors.New("unsupported dump version")
+
+// ErrIncompleteDump is returned when the number of the records
+// in the dump does not match the trailer.
+var ErrIncompleteDump = errors.New("incomplete dump")
+
+// ErrInvalidChecksum is returned when dump data does not match its checksum.
+var ErrInvalidChecksum = errors.New("invalid checksum")
+
+// dumpHeader represents the header of the dump.
+type dumpHeader struct {
+	version uint8
+	flags   uint8
+	since   uint64
+}
+
+func (h dumpHeader) marshal() []byte {
+	buf := make([]byte, dumpHeaderSize)
+
+	copy(buf, dumpMagic)
+	buf[4] = h.version
+	buf[5] = h.flags
+	binary.LittleEndian.PutUint64(buf[8:], h.since)
+	binary.LittleEndian.PutUint32(buf[16:], crc32.Checksum(buf[:16], dumpCRCTable))
+
+	return buf
+}
+
+// readDumpHeader reads the rest of the header after the magic.
+func readDumpHeader(r io.Reader) (dumpHeader, error) {
+	var (
+		h   dumpHeader
+		buf = make([]byte, dumpHeaderSize)
+	)
+
+	copy(buf, dumpMagic)
+
+	if _, err := io.ReadFull(r, buf[len(dumpMagic):]); err != nil {
+		return h, fmt.Errorf("could not read dump header: %w", err)
+	}
+
+	if crc32.Checksum(buf[:16], dumpCRCTable) != binary.LittleEndian.Uint32(buf[16:]) {
+		return h, fmt.Errorf("%w: dump header", ErrInvalidChecksum)
+	}
+
+	h.version = buf[4]
+	h.flags = buf[5]
+	h.since = binary.LittleEndian.Uint64(buf[8:])
+
+	if h.version != dumpVersion {
+		return h, fmt.Errorf("%w: %d", ErrUnsupportedVersion, h.version)
+	}
+
+	return h, nil
+}
+
+// dumpWriter writes the body of the dump.
+type dumpWriter struct {
+	w   io.Writer
+	enc *zstd.Encoder
+
+	count uint32
+}
+
+func newDumpWriter(w io.Writer, h dumpHeader) (*dumpWriter, error) {
+	if _, err := w.Write(h.marshal()); err != nil {
+		return nil, err
+	}
+
+	dw := &dumpWriter{w: w}
+
+	if h.flags&dumpFlagCompressed != 0 {
+		enc, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, fmt.Errorf("could not create zstd encoder: %w", err)
+		}
+
+		dw.w = enc
+		dw.enc = enc
+	}
+
+	return dw, nil
+}
+
+func (w *dumpWriter) writeRecord(data []byte) error {
+	var hdr [8]byte
+	binary.LittleEndian.PutUint32(hdr[:], uint32(len(data)))
+	binary.LittleEndian.PutUint32(hdr[4:], crc32.Checksum(data, dumpCRCTable))
+
+	if _, err := w.w.Write(hdr[:]); err != nil {
+		return err
+	}
+
+	if _, err := w.w.Write(data); err != nil {
+		return err
+	}
+
+	w.count++
+
+	return nil
+}
+
+// close writes the trailer and flushes the compressed stream.
+func (w *dumpWriter) close(watermark uint64) error {
+	// zero size of the record marks the trailer
+	buf := make([]byte, 4+dumpTrailerSize)
+	trailer := buf[4:]
+
+	binary.LittleEndian.PutUint32(trailer, w.count)
+	binary.LittleEndian.PutUint64(trailer[4:], watermark)
+	copy(trailer[12:], crc32Bytes(trailer[:12]))
+
+	if _, err := w.w.Write(buf); err != nil {
+		return err
+	}
+
+	if w.enc != nil {
+		return w.enc.Close()
+	}
+
+	return nil
+}
+
+func crc32Bytes(data []byte) []byte {
+	var sum [4]byte
+	binary.LittleEndian.PutUint32(sum[:], crc32.Checksum(data, dumpCRCTable))
+	return sum[:]
+}
+
+// dumpReader reads the body of the dump.
+type dumpReader struct {
+	r   io.Reader
+	dec *zstd.Decoder
+
+	legacy bool
+
+	data []byte
+
+	count     uint32
+	watermark uint64
+}
+
+// newDumpReader reads the dump header and returns the reader of the body.
+func newDumpReader(r io.Reader) (*dumpReader, dumpHeader, error) {
+	var (
+		m [4]byte
+		h dumpHeader
+	)
+
+	_, _ = io.ReadFull(r, m[:])
+
+	switch {
+	case bytes.Equal(m[:], legacyDumpMagic):
+		return &dumpReader{r: r, legacy: true}, h, nil
+	case !bytes.Equal(m[:], dumpMagic):
+		return nil, h, ErrInvalidMagic
+	}
+
+	h, err := readDumpHeader(r)
+	if err != nil {
+		return nil, h, err
+	}
+
+	dr := &dumpReader{r: r}
+
+	if h.flags&dumpFlagCompressed != 0 {
+		dec, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, h, fmt.Errorf("could not create zstd decoder: %w", err)
+		}
+
+		dr.r = dec
+		dr.dec = dec
+	}
+
+	return dr, h, nil
+}
+
+// next returns the data of the next record. The result is valid
+// until the next call. Returns io.EOF after the last record.
+//
+// If record data does not match the checksum, ErrInvalidChecksum
+// is returned and the record is skipped.
+func (r *dumpReader) next() ([]byte, error) {
+	hdrSize := 8
+	if r.legacy {
+		hdrSize = 4
+	}
+
+	var hdr [8]byte
+
+	// If there are less than 4 bytes left, `Read` returns nil error instead of
+	// io.ErrUnexpectedEOF, thus `ReadFull` is used.
+	_, err := io.ReadFull(r.r, hdr[:hdrSize])
+	if err != nil {
+		if errors.Is(err, io.EOF) && !r.legacy {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	sz := binary.LittleEndian.Uint32(hdr[:])
+	if sz == 0 && !r.legacy {
+		return nil, r.readTrailer(hdr[4:])
+	}
+
+	if uint32(cap(r.data)) < sz {
+		r.data = make([]byte, sz)
+	} else {
+		r.data = r.data[:sz]
+	}
+
+	_, err = io.ReadFull(r.r, r.data)
+	if err != nil {
+		return nil, err
+	}
+
+	r.count++
+
+	if !r.legacy && crc32.Checksum(r.data, dumpCRCTable) != binary.LittleEndian.Uint32(hdr[4:]) {
+		return nil, fmt.Errorf("%w: record #%d", ErrInvalidChecksum, r.count)
+	}
+
+	return r.data, nil
+}
+
+// readTrailer reads and verifies the trailer. prefix contains the trailer
+// bytes already read along with the size.
+func (r *dumpReader) readTrailer(prefix []byte) error {
+	var trailer [dumpTrailerSize]byte
+
+	n := copy(trailer[:], prefix)
+
+	if _, err := io.ReadFull(r.r, trailer[n:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return fmt.Errorf("could not read dump trailer: %w", err)
+	}
+
+	if !bytes.Equal(crc32Bytes(trailer[:12]), trailer[12:]) {
+		return fmt.Errorf("%w: dump trailer", ErrInvalidChecksum)
+	}
+
+	if count := binary.LittleEndian.Uint32(trailer[:]); count != r.count {
+		return fmt.Errorf("%w: %d records expected, %d read", ErrIncompleteDump, count, r.count)
+	}
+
+	r.watermark = binary.LittleEndian.Uint64(trailer[4:])
+
+	if r.dec != nil {
+		// read the rest of zstd frame to verify its checksum
+		// and to not block the writer of the stream
+		if _, err := io.Copy(io.Discard, r.dec); err != nil {
+			return fmt.Errorf("could not read the end of compressed dump: %w", err)
+		}
+	}
+
+	return io.EOF
+}
+
+func (r *dumpReader) close() {
+	if r.dec != nil {
+		r.dec.Close()
+	}
+}
@@ -2,7 +2,9 @@ package shard_test
 
 import (
 	"bytes"
+	"encoding/binary"
 	"errors"
+	"hash/crc32"
 	"io"
 	"io/ioutil"
 	"math/rand"
@@ -17,8 +19,11 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/writecache"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/id/test"
 	"github.com/stretchr/testify/require"
 )
@@ -39,6 +44,9 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 		wcBigObjectSize   = 4 * 1024      // 4 KiB, goes to write-cache FSTree
 		bsSmallObjectSize = 10 * 1024     // 10 KiB, goes to blobovnicza DB
 		bsBigObjectSize   = 1024*1024 + 1 // > 1 MiB, goes to blobovnicza FSTree
+
+		// Size of the dump header.
+		headerSize = 20
 	)
 
 	var sh *shard.Shard
@@ -70,7 +78,7 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 	require.NoError(t, sh.SetMode(shard.ModeReadWrite))
 
 	// Approximate object header size.
-	const headerSize = 400
+	const objHeaderSize = 400
 
 	objects := make([]*object.Object, objCount)
 	for i := 0; i < objCount; i++ {
@@ -78,13 +86,13 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 		var size int
 		switch i % 6 {
 		case 0, 1:
-			size = wcSmallObjectSize - headerSize
+			size = wcSmallObjectSize - objHeaderSize
 		case 2, 3:
-			size = bsSmallObjectSize - headerSize
+			size = bsSmallObjectSize - objHeaderSize
 		case 4:
-			size = wcBigObjectSize - headerSize
+			size = wcBigObjectSize - objHeaderSize
 		default:
-			size = bsBigObjectSize - headerSize
+			size = bsBigObjectSize - objHeaderSize
 		}
 		data := make([]byte, size)
 		rand.Read(data)
@@ -134,29 +142,31 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 			fileData, err := ioutil.ReadFile(out)
 			require.NoError(t, err)
 
-			t.Run("incomplete size", func(t *testing.T) {
-				out := out + ".wrongsize"
-				fileData := append(fileData, 1)
-				require.NoError(t, ioutil.WriteFile(out, fileData, os.ModePerm))
+			t.Run("truncated", func(t *testing.T) {
+				out := out + ".truncated"
+				require.NoError(t, ioutil.WriteFile(out, fileData[:len(fileData)-1], os.ModePerm))
 
 				_, err := sh.Restore(new(shard.RestorePrm).WithPath(out))
 				require.True(t, errors.Is(err, io.ErrUnexpectedEOF), "got: %v", err)
 			})
-			t.Run("incomplete object data", func(t *testing.T) {
-				out := out + ".wrongsize"
-				fileData := append(fileData, 1, 0, 0, 0)
+			t.Run("unsupported version", func(t *testing.T) {
+				out := out + ".version"
+				fileData := append([]byte{}, fileData...)
+				fileData[4]++
+				binary.LittleEndian.PutUint32(fileData[16:], crc32.Checksum(fileData[:16], crc32.MakeTable(crc32.Castagnoli)))
 				require.NoError(t, ioutil.WriteFile(out, fileData, os.ModePerm))
 
 				_, err := sh.Restore(new(shard.RestorePrm).WithPath(out))
-				require.True(t, errors.Is(err, io.EOF), "got: %v", err)
+				require.True(t, errors.Is(err, shard.ErrUnsupportedVersion), "got: %v", err)
 			})
-			t.Run("invalid object", func(t *testing.T) {
-				out := out + ".wrongobj"
-				fileData := append(fileData, 1, 0, 0, 0, 0xFF, 4, 0, 0, 0, 1, 2, 3, 4)
+			t.Run("invalid checksum", func(t *testing.T) {
+				out := out + ".checksum"
+				fileData := append([]byte{}, fileData...)
+				fileData[headerSize+8] ^= 0xFF // first byte of the first record
 				require.NoError(t, ioutil.WriteFile(out, fileData, os.ModePerm))
 
 				_, err := sh.Restore(new(shard.RestorePrm).WithPath(out))
-				require.Error(t, err)
+				require.True(t, errors.Is(err, shard.ErrInvalidChecksum), "got: %v", err)
 
 				t.Run("skip errors", func(t *testing.T) {
 					sh := newCustomShard(t, filepath.Join(t.TempDir(), "ignore"), false, nil, nil)
@@ -164,10 +174,37 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 
 					res, err := sh.Restore(new(shard.RestorePrm).WithPath(out).WithIgnoreErrors(true))
 					require.NoError(t, err)
-					require.Equal(t, objCount, res.Count())
-					require.Equal(t, 2, res.FailCount())
+					require.Equal(t, objCount-1, res.Count())
+					require.Equal(t, 1, res.FailCount())
 				})
 			})
+			t.Run("legacy format", func(t *testing.T) {
+				out := out + ".legacy"
+				fileData := []byte("NEOF")
+				for i := range objects {
+					data, err := objects[i].Marshal()
+					require.NoError(t, err)
+
+					var size [4]byte
+					binary.LittleEndian.PutUint32(size[:], uint32(len(data)))
+					fileData = append(append(fileData, size[:]...), data...)
+				}
+
+				// invalid objects
+				fileData = append(fileData, 1, 0, 0, 0, 0xFF, 4, 0, 0, 0, 1, 2, 3, 4)
+				require.NoError(t, ioutil.WriteFile(out, fileData, os.ModePerm))
+
+				_, err := sh.Restore(new(shard.RestorePrm).WithPath(out))
+				require.Error(t, err)
+
+				sh := newCustomShard(t, filepath.Join(t.TempDir(), "legacy"), false, nil, nil)
+				defer releaseShard(sh, t)
+
+				res, err := sh.Restore(new(shard.RestorePrm).WithPath(out).WithIgnoreErrors(true))
+				require.NoError(t, err)
+				require.Equal(t, objCount, res.Count())
+				require.Equal(t, 2, res.FailCount())
+			})
 		})
 
 		prm := new(shard.RestorePrm).WithPath(out)
@@ -185,6 +222,15 @@ func testDump(t *testing.T, objCount int, hasWriteCache bool) {
 }
 
 func TestStream(t *testing.T) {
+	t.Run("plain", func(t *testing.T) {
+		testStream(t, false)
+	})
+	t.Run("compressed", func(t *testing.T) {
+		testStream(t, true)
+	})
+}
+
+func testStream(t *testing.T, compress bool) {
 	sh1 := newCustomShard(t, filepath.Join(t.TempDir(), "shard1"), false, nil, nil)
 	defer releaseShard(sh1, t)
 
@@ -209,7 +255,7 @@ func TestStream(t *testing.T) {
 	finish := make(chan struct{})
 
 	go func() {
-		res, err := sh1.Dump(new(shard.DumpPrm).WithStream(w))
+		res, err := sh1.Dump(new(shard.DumpPrm).WithStream(w).WithCompression(compress))
 		require.NoError(t, err)
 		require.Equal(t, objCount, res.Count())
 		require.NoError(t, w.Close())
@@ -350,3 +396,107 @@ func TestDumpIgnoreErrors(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, objCount, res.Count())
 }
+
+func TestDumpFiltered(t *testing.T) {
+	sh := newShard(t, false)
+	defer releaseShard(sh, t)
+
+	cnrA, cnrB := cidtest.ID(), cidtest.ID()
+
+	putObject := func(cnr *cid.ID, epoch uint64) *object.RawObject {
+		obj := generateRawObjectWithCID(t, cnr)
+		obj.SetCreationEpoch(epoch)
+
+		_, err := sh.Put(new(shard.PutPrm).WithObject(obj.Object()))
+		require.NoError(t, err)
+
+		return obj
+	}
+
+	objA1 := putObject(cnrA, 1)
+	putObject(cnrA, 2)
+	putObject(cnrB, 2)
+
+	require.NoError(t, sh.SetMode(shard.ModeReadOnly))
+
+	dir := t.TempDir()
+
+	t.Run("containers", func(t *testing.T) {
+		res, err := sh.Dump(new(shard.DumpPrm).WithPath(filepath.Join(dir, "cnr")).WithContainers(cnrA))
+		require.NoError(t, err)
+		require.Equal(t, 2, res.Count())
+		require.Zero(t, res.Watermark())
+	})
+
+	t.Run("epoch range", func(t *testing.T) {
+		res, err := sh.Dump(new(shard.DumpPrm).WithPath(filepath.Join(dir, "range")).WithEpochRange(2, 2))
+		require.NoError(t, err)
+		require.Equal(t, 2, res.Count())
+
+		res, err = sh.Dump(new(shard.DumpPrm).WithPath(filepath.Join(dir, "range")).
+			WithEpochRange(2, 0).WithContainers(cnrB))
+		require.NoError(t, err)
+		require.Equal(t, 1, res.Count())
+	})
+
+	inc1 := filepath.Join(dir, "inc1")
+	res, err := sh.Dump(new(shard.DumpPrm).WithPath(inc1).WithIncremental(0))
+	require.NoError(t, err)
+	require.Equal(t, 3, res.Count())
+	require.EqualValues(t, 3, res.Watermark())
+
+	require.NoError(t, sh.SetMode(shard.ModeReadWrite))
+
+	objA3 := putObject(cnrA, 3)
+
+	tombContent := objectSDK.NewTombstone()
+	tombContent.SetMembers([]*oidSDK.ID{objA1.ID()})
+
+	payload, err := tombContent.Marshal()
+	require.NoError(t, err)
+
+	tomb := generateRawObjectWithPayload(cnrA, payload)
+	tomb.SetType(objectSDK.TypeTombstone)
+	tomb.SetCreationEpoch(3)
+
+	_, err = sh.Put(new(shard.PutPrm).WithObject(tomb.Object()))
+	require.NoError(t, err)
+
+	// object with an old creation epoch arrived after the previous dump
+	objB1 := putObject(cnrB, 1)
+
+	require.NoError(t, sh.SetMode(shard.ModeReadOnly))
+
+	inc2 := filepath.Join(dir, "inc2")
+	res, err = sh.Dump(new(shard.DumpPrm).WithPath(inc2).WithIncremental(res.Watermark()))
+	require.NoError(t, err)
+	require.Equal(t, 3, res.Count())
+	require.EqualValues(t, 6, res.Watermark())
+
+	t.Run("restore", func(t *testing.T) {
+		sh := newCustomShard(t, filepath.Join(t.TempDir(), "restore"), false, nil, nil)
+		defer releaseShard(sh, t)
+
+		_, err := sh.Restore(new(shard.RestorePrm).WithPath(inc2).WithBaseWatermark(0))
+		require.True(t, errors.Is(err, shard.ErrIncrementGap), "got: %v", err)
+
+		res, err := sh.Restore(new(shard.RestorePrm).WithPath(inc1))
+		require.NoError(t, err)
+		require.Equal(t, 3, res.Count())
+		require.EqualValues(t, 3, res.Watermark())
+
+		res, err = sh.Restore(new(shard.RestorePrm).WithPath(inc2).WithBaseWatermark(res.Watermark()))
+		require.NoError(t, err)
+		require.Equal(t, 3, res.Count())
+		require.EqualValues(t, 6, res.Watermark())
+
+		_, err = sh.Get(new(shard.GetPrm).WithAddress(objA3.Object().Address()))
+		require.NoError(t, err)
+
+		_, err = sh.Get(new(shard.GetPrm).WithAddress(objB1.Object().Address()))
+		require.NoError(t, err)
+
+		_, err = sh.Get(new(shard.GetPrm).WithAddress(objA1.Object().Address()))
+		require.True(t, errors.Is(err, object.ErrAlreadyRemoved), "got: %v", err)
+	})
+}
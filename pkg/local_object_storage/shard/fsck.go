@@ -0,0 +1,459 @@
+package shard
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobovnicza"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// FsckIssueType is a type of the inconsistency found by Fsck.
+type FsckIssueType uint8
+
+const (
+	_ FsckIssueType = iota
+
+	// FsckDanglingRecord means that metabase has a record
+	// of the object missing in BlobStor.
+	FsckDanglingRecord
+
+	// FsckOrphanedObject means that object stored in BlobStor
+	// has no record in metabase.
+	FsckOrphanedObject
+
+	// FsckBlobovniczaMismatch means that metabase refers to
+	// the wrong location of the stored object.
+	FsckBlobovniczaMismatch
+
+	// FsckCorruptedObject means that stored object can't be decoded
+	// or has invalid checksum or signature, or its deduplicated payload
+	// can't be read.
+	FsckCorruptedObject
+
+	// FsckOrphanedBlobovnicza means that blobovnicza file does not
+	// belong to the configured blobovnicza tree.
+	FsckOrphanedBlobovnicza
+)
+
+// String returns string representation of FsckIssueType.
+func (t FsckIssueType) String() string {
+	switch t {
+	case FsckDanglingRecord:
+		return "DANGLING_RECORD"
+	case FsckOrphanedObject:
+		return "ORPHANED_OBJECT"
+	case FsckBlobovniczaMismatch:
+		return "BLOBOVNICZA_MISMATCH"
+	case FsckCorruptedObject:
+		return "CORRUPTED_OBJECT"
+	case FsckOrphanedBlobovnicza:
+		return "ORPHANED_BLOBOVNICZA"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// FsckIssue describes the inconsistency found by Fsck.
+type FsckIssue struct {
+	typ FsckIssueType
+
+	addr *addressSDK.Address
+
+	path string
+
+	err error
+
+	repaired bool
+}
+
+// Type returns type of the inconsistency.
+func (i *FsckIssue) Type() FsckIssueType {
+	return i.typ
+}
+
+// Address returns address of the object.
+// Returns nil if address is unknown.
+func (i *FsckIssue) Address() *addressSDK.Address {
+	return i.addr
+}
+
+// Path returns path of the blobovnicza relative to the blobovnicza tree root.
+// Returns empty string if issue is not related to a blobovnicza.
+func (i *FsckIssue) Path() string {
+	return i.path
+}
+
+// Error returns the reason of the inconsistency.
+func (i *FsckIssue) Error() error {
+	return i.err
+}
+
+// Repaired returns true if the inconsistency has been repaired.
+func (i *FsckIssue) Repaired() bool {
+	return i.repaired
+}
+
+// FsckPrm groups the parameters of Fsck operation.
+type FsckPrm struct {
+	repair bool
+
+	handler func(*FsckIssue)
+}
+
+// FsckRes groups resulting values of Fsck operation.
+type FsckRes struct {
+	objects, records uint64
+
+	issues, repaired uint64
+}
+
+// WithRepair is a Fsck option to repair found inconsistencies:
+// orphaned objects are indexed in metabase, dangling records are
+// removed and blobovnicza identifiers are fixed. Corrupted objects
+// and orphaned blobovniczas are only reported.
+func (p *FsckPrm) WithRepair(v bool) *FsckPrm {
+	if p != nil {
+		p.repair = v
+	}
+
+	return p
+}
+
+// WithIssueHandler is a Fsck option to set the handler of found inconsistencies.
+func (p *FsckPrm) WithIssueHandler(f func(*FsckIssue)) *FsckPrm {
+	if p != nil {
+		p.handler = f
+	}
+
+	return p
+}
+
+// Objects returns number of checked objects stored in BlobStor.
+func (r *FsckRes) Objects() uint64 {
+	return r.objects
+}
+
+// Records returns number of checked metabase records.
+func (r *FsckRes) Records() uint64 {
+	return r.records
+}
+
+// Issues returns number of found inconsistencies.
+func (r *FsckRes) Issues() uint64 {
+	return r.issues
+}
+
+// Repaired returns number of repaired inconsistencies.
+func (r *FsckRes) Repaired() uint64 {
+	return r.repaired
+}
+
+// fsckBatchSize is a number of metabase records checked at once.
+const fsckBatchSize = 1000
+
+// Fsck checks that metabase and BlobStor of the shard agree with each other.
+// Every stored object is decoded, verified and looked up in metabase, every
+// metabase record is looked up in BlobStor.
+//
+// Write-cache is not checked, objects from it are not indexed in metabase
+// until flushed, so the shard is supposed to be checked offline with the
+// flushed write-cache.
+//
+// Returns ErrReadOnlyMode error if repair is requested in "read-only" mode.
+func (s *Shard) Fsck(prm *FsckPrm) (*FsckRes, error) {
+	if prm.repair && s.GetMode() == ModeReadOnly {
+		return nil, ErrReadOnlyMode
+	}
+
+	c := &fsck{
+		Shard:      s,
+		prm:        prm,
+		res:        new(FsckRes),
+		mismatched: make(map[string]struct{}),
+	}
+
+	if err := c.checkObjects(); err != nil {
+		return nil, err
+	}
+
+	if err := c.checkRecords(); err != nil {
+		return nil, err
+	}
+
+	orphaned, err := s.blobStor.OrphanedBlobovniczas()
+	if err != nil {
+		return nil, fmt.Errorf("could not list orphaned blobovniczas: %w", err)
+	}
+
+	for i := range orphaned {
+		c.report(&FsckIssue{
+			typ:  FsckOrphanedBlobovnicza,
+			path: orphaned[i],
+		})
+	}
+
+	return c.res, nil
+}
+
+type fsck struct {
+	*Shard
+
+	prm *FsckPrm
+
+	res *FsckRes
+
+	// addresses of the objects with mismatched blobovnicza ID
+	mismatched map[string]struct{}
+}
+
+func (c *fsck) report(i *FsckIssue) {
+	c.res.issues++
+
+	if i.repaired {
+		c.res.repaired++
+	}
+
+	if c.prm.handler != nil {
+		c.prm.handler(i)
+	}
+}
+
+// checkObjects verifies all objects stored in BlobStor
+// and checks their metabase records.
+func (c *fsck) checkObjects() error {
+	var prm blobstor.IteratePrm
+
+	prm.SetIterationHandler(func(elem blobstor.IterationElement) error {
+		c.res.objects++
+
+		var path string
+		if id := elem.BlobovniczaID(); id != nil {
+			path = id.String()
+		}
+
+		obj := object.New()
+
+		if err := obj.Unmarshal(elem.ObjectData()); err != nil {
+			c.report(&FsckIssue{
+				typ:  FsckCorruptedObject,
+				path: path,
+				err:  fmt.Errorf("could not unmarshal the object: %w", err),
+			})
+
+			return nil
+		}
+
+		if err := checkObject(obj); err != nil {
+			c.report(&FsckIssue{
+				typ:  FsckCorruptedObject,
+				addr: obj.Address(),
+				path: path,
+				err:  err,
+			})
+
+			return nil
+		}
+
+		return c.checkObjectRecord(obj, elem.BlobovniczaID(), elem.DeduplicatedPayload())
+	})
+
+	prm.SetErrorHandler(func(addr *addressSDK.Address, err error) error {
+		c.res.objects++
+
+		c.report(&FsckIssue{
+			typ:  FsckCorruptedObject,
+			addr: addr,
+			err:  err,
+		})
+
+		return nil
+	})
+
+	_, err := c.blobStor.Iterate(prm)
+	if err != nil {
+		return fmt.Errorf("could not check stored objects: %w", err)
+	}
+
+	return nil
+}
+
+func (c *fsck) checkObjectRecord(obj *object.Object, blzID *blobovnicza.ID, dedup bool) error {
+	addr := obj.Address()
+
+	st, err := c.metaBase.ObjectStatus(addr)
+	if err != nil && !errors.Is(err, object.ErrNotFound) {
+		return fmt.Errorf("could not get metabase record of %s: %w", addr, err)
+	}
+
+	if st == nil || st.Header() == nil {
+		i := &FsckIssue{
+			typ:  FsckOrphanedObject,
+			addr: addr,
+			path: blzIDString(blzID),
+		}
+
+		if c.prm.repair {
+			i.err = c.indexObject(obj, blzID)
+			if i.err == nil && dedup {
+				i.err = c.referencePayload(obj)
+			}
+
+			i.repaired = i.err == nil
+		}
+
+		c.report(i)
+
+		return nil
+	}
+
+	recID := st.BlobovniczaID()
+	if blzIDString(recID) == blzIDString(blzID) || c.storedAt(addr, recID) {
+		// the other copy of the object is referenced by metabase
+		return nil
+	}
+
+	c.mismatched[addr.String()] = struct{}{}
+
+	i := &FsckIssue{
+		typ:  FsckBlobovniczaMismatch,
+		addr: addr,
+		path: blzIDString(blzID),
+		err:  fmt.Errorf("metabase refers to blobovnicza %q", blzIDString(recID)),
+	}
+
+	if c.prm.repair && blzID != nil {
+		// metabase updates blobovnicza ID of the existing records
+		i.err = meta.Put(c.metaBase, obj, blzID)
+		i.repaired = i.err == nil
+	}
+
+	c.report(i)
+
+	return nil
+}
+
+// checkRecords checks that objects of all metabase records are stored in BlobStor.
+func (c *fsck) checkRecords() error {
+	var (
+		addrs  []*addressSDK.Address
+		cursor *meta.Cursor
+		err    error
+	)
+
+	for {
+		addrs, cursor, err = meta.ListWithCursor(c.metaBase, fsckBatchSize, cursor)
+		if err != nil {
+			if errors.Is(err, meta.ErrEndOfListing) {
+				return nil
+			}
+
+			return fmt.Errorf("could not list metabase records: %w", err)
+		}
+
+		for _, addr := range addrs {
+			c.res.records++
+
+			if _, ok := c.mismatched[addr.String()]; ok {
+				continue
+			}
+
+			blzID, err := meta.IsSmall(c.metaBase, addr)
+			if err != nil {
+				return fmt.Errorf("could not get blobovnicza ID of %s: %w", addr, err)
+			}
+
+			if c.storedAt(addr, blzID) {
+				continue
+			}
+
+			i := &FsckIssue{
+				typ:  FsckDanglingRecord,
+				addr: addr,
+				path: blzIDString(blzID),
+			}
+
+			if c.prm.repair {
+				i.err = meta.Delete(c.metaBase, addr)
+				i.repaired = i.err == nil
+			}
+
+			c.report(i)
+		}
+	}
+}
+
+// storedAt checks if the object is stored in the specified blobovnicza
+// or in the file tree if blobovnicza ID is nil.
+func (c *fsck) storedAt(addr *addressSDK.Address, blzID *blobovnicza.ID) bool {
+	if blzID == nil {
+		prm := new(blobstor.ExistsPrm)
+		prm.SetAddress(addr)
+
+		res, err := c.blobStor.Exists(prm)
+
+		return err == nil && res.Exists()
+	}
+
+	prm := new(blobstor.GetSmallPrm)
+	prm.SetAddress(addr)
+	prm.SetBlobovniczaID(blzID)
+
+	_, err := c.blobStor.GetSmall(prm)
+
+	return err == nil
+}
+
+func blzIDString(id *blobovnicza.ID) string {
+	if id == nil {
+		return ""
+	}
+
+	return id.String()
+}
+
+// checkObject verifies identifier, signature and payload checksums of the object.
+func checkObject(obj *object.Object) error {
+	if err := objectSDK.CheckHeaderVerificationFields(obj.SDK()); err != nil {
+		return fmt.Errorf("invalid header verification fields: %w", err)
+	}
+
+	cs := obj.PayloadChecksum()
+	if cs == nil {
+		return errors.New("missing payload checksum")
+	}
+
+	var h hash.Hash
+
+	switch typ := cs.Type(); typ {
+	default:
+		return fmt.Errorf("unsupported payload checksum type %v", typ)
+	case checksum.SHA256:
+		h = sha256.New()
+	case checksum.TZ:
+		h = tz.New()
+	}
+
+	_, _ = h.Write(obj.Payload())
+
+	if !bytes.Equal(h.Sum(nil), cs.Sum()) {
+		return errors.New("incorrect payload checksum")
+	}
+
+	if hh := obj.PayloadHomomorphicHash(); hh != nil {
+		if sum := tz.Sum(obj.Payload()); !bytes.Equal(sum[:], hh.Sum()) {
+			return errors.New("incorrect payload homomorphic hash")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,333 @@
+package shard_test
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"io/fs"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/util/test"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/tzhash/tz"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/bbolt"
+)
+
+// generateSignedObject returns object with valid checksums and signature.
+func generateSignedObject(t *testing.T, size int) *object.RawObject {
+	payload := make([]byte, size)
+	_, _ = rand.Read(payload)
+
+	obj := generateRawObjectWithPayload(cidtest.ID(), payload)
+	obj.SetPayloadSize(uint64(size))
+
+	csumTZ := new(checksum.Checksum)
+	csumTZ.SetTillichZemor(tz.Sum(payload))
+	obj.SetPayloadHomomorphicHash(csumTZ)
+
+	require.NoError(t, objectSDK.SetIDWithSignature(test.DecodeKey(-1), obj.SDK()))
+
+	return obj
+}
+
+func TestShard_Fsck(t *testing.T) {
+	dir := t.TempDir()
+	root := filepath.Join(dir, "nowc")
+
+	openShard := func() *shard.Shard {
+		return newCustomShard(t, dir, false, nil, []blobstor.Option{
+			blobstor.WithSmallSizeLimit(1 << 10),
+		})
+	}
+
+	var issues []*shard.FsckIssue
+
+	fsck := func(t *testing.T, sh *shard.Shard, repair bool) *shard.FsckRes {
+		issues = nil
+
+		res, err := sh.Fsck(new(shard.FsckPrm).
+			WithRepair(repair).
+			WithIssueHandler(func(i *shard.FsckIssue) {
+				issues = append(issues, i)
+			}),
+		)
+		require.NoError(t, err)
+
+		return res
+	}
+
+	requireIssue := func(t *testing.T, typ shard.FsckIssueType, repaired bool) *shard.FsckIssue {
+		require.Len(t, issues, 1)
+		require.Equal(t, typ, issues[0].Type())
+		require.Equal(t, repaired, issues[0].Repaired())
+
+		return issues[0]
+	}
+
+	sh := openShard()
+
+	small := generateSignedObject(t, 100)
+	big := generateSignedObject(t, 2<<10)
+
+	for _, obj := range []*object.RawObject{small, big} {
+		_, err := sh.Put(new(shard.PutPrm).WithObject(obj.Object()))
+		require.NoError(t, err)
+	}
+
+	res := fsck(t, sh, false)
+	require.EqualValues(t, 2, res.Objects())
+	require.EqualValues(t, 2, res.Records())
+	require.Empty(t, issues)
+
+	t.Run("orphaned object", func(t *testing.T) {
+		require.NoError(t, sh.Close())
+
+		db := meta.New(meta.WithPath(filepath.Join(root, "meta")))
+		require.NoError(t, db.Open())
+		require.NoError(t, meta.Delete(db, small.Object().Address()))
+		require.NoError(t, db.Close())
+
+		sh = openShard()
+
+		res := fsck(t, sh, false)
+		require.EqualValues(t, 1, res.Records())
+
+		i := requireIssue(t, shard.FsckOrphanedObject, false)
+		require.Equal(t, small.Object().Address(), i.Address())
+		require.NotEmpty(t, i.Path())
+
+		fsck(t, sh, true)
+		requireIssue(t, shard.FsckOrphanedObject, true)
+
+		res = fsck(t, sh, false)
+		require.EqualValues(t, 2, res.Records())
+		require.Empty(t, issues)
+	})
+
+	t.Run("dangling record", func(t *testing.T) {
+		// the only file outside the blobovnicza tree is the big object
+		err := filepath.WalkDir(filepath.Join(root, "blob"), func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.Name() == "blobovnicza" {
+				return filepath.SkipDir
+			}
+
+			if d.IsDir() {
+				return nil
+			}
+
+			return os.Remove(p)
+		})
+		require.NoError(t, err)
+
+		res := fsck(t, sh, false)
+		require.EqualValues(t, 1, res.Objects())
+
+		i := requireIssue(t, shard.FsckDanglingRecord, false)
+		require.Equal(t, big.Object().Address(), i.Address())
+
+		fsck(t, sh, true)
+		requireIssue(t, shard.FsckDanglingRecord, true)
+
+		res = fsck(t, sh, false)
+		require.EqualValues(t, 1, res.Records())
+		require.Empty(t, issues)
+	})
+
+	t.Run("orphaned blobovnicza", func(t *testing.T) {
+		p := filepath.Join(root, "blob", "blobovnicza", "garbage")
+		require.NoError(t, os.WriteFile(p, []byte{1, 2, 3}, 0600))
+
+		fsck(t, sh, false)
+		i := requireIssue(t, shard.FsckOrphanedBlobovnicza, false)
+		require.Equal(t, "garbage", i.Path())
+		require.Nil(t, i.Address())
+
+		require.NoError(t, os.Remove(p))
+	})
+
+	t.Run("corrupted object", func(t *testing.T) {
+		obj := generateSignedObject(t, 100)
+		obj.SetPayload([]byte{1, 2, 3})
+
+		_, err := sh.Put(new(shard.PutPrm).WithObject(obj.Object()))
+		require.NoError(t, err)
+
+		fsck(t, sh, true)
+		i := requireIssue(t, shard.FsckCorruptedObject, false)
+		require.Equal(t, obj.Object().Address(), i.Address())
+		require.Error(t, i.Error())
+	})
+
+	t.Run("read-only mode", func(t *testing.T) {
+		require.NoError(t, sh.SetMode(shard.ModeReadOnly))
+
+		_, err := sh.Fsck(new(shard.FsckPrm).WithRepair(true))
+		require.ErrorIs(t, err, shard.ErrReadOnlyMode)
+	})
+
+	require.NoError(t, sh.Close())
+}
+
+func TestShard_InitCheck(t *testing.T) {
+	dir := t.TempDir()
+	metaPath := filepath.Join(dir, "nowc", "meta")
+
+	sh := newCustomShard(t, dir, false, nil, nil)
+
+	_, err := sh.Put(new(shard.PutPrm).WithObject(generateSignedObject(t, 100).Object()))
+	require.NoError(t, err)
+	require.NoError(t, sh.Close())
+
+	version := func(t *testing.T) uint64 {
+		db, err := bbolt.Open(metaPath, 0600, &bbolt.Options{ReadOnly: true})
+		require.NoError(t, err)
+		defer db.Close()
+
+		var v uint64
+
+		require.NoError(t, db.View(func(tx *bbolt.Tx) error {
+			v = binary.LittleEndian.Uint64(tx.Bucket([]byte("_i")).Get([]byte("version")))
+			return nil
+		}))
+
+		return v
+	}
+
+	open := func(t *testing.T) *shard.Shard {
+		sh := shard.New(
+			shard.WithBlobStorOptions(
+				blobstor.WithRootPath(filepath.Join(dir, "nowc", "blob")),
+				blobstor.WithBlobovniczaShallowWidth(2),
+				blobstor.WithBlobovniczaShallowDepth(2)),
+			shard.WithMetaBaseOptions(meta.WithPath(metaPath)),
+		)
+		require.NoError(t, sh.Open())
+
+		return sh
+	}
+
+	sh = open(t)
+	require.NoError(t, sh.InitCheck())
+
+	res, err := sh.Fsck(new(shard.FsckPrm))
+	require.NoError(t, err)
+	require.Zero(t, res.Issues())
+	require.NoError(t, sh.Close())
+
+	// downgrade metabase to the version without arrival index
+	db, err := bbolt.Open(metaPath, 0600, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bbolt.Tx) error {
+		data := make([]byte, 8)
+		binary.LittleEndian.PutUint64(data, meta.Version-1)
+
+		return tx.Bucket([]byte("_i")).Put([]byte("version"), data)
+	}))
+	require.NoError(t, db.Close())
+
+	sh = open(t)
+	require.Error(t, sh.InitCheck())
+	require.NoError(t, sh.Close())
+	require.EqualValues(t, meta.Version-1, version(t), "metabase must not be migrated")
+
+	sh = open(t)
+	require.NoError(t, sh.Init())
+	require.NoError(t, sh.Close())
+	require.EqualValues(t, meta.Version, version(t))
+}
+
+func TestShard_FsckDeduplicatedPayload(t *testing.T) {
+	dir := t.TempDir()
+	root := filepath.Join(dir, "nowc")
+
+	openShard := func() *shard.Shard {
+		return newCustomShard(t, dir, false, nil, []blobstor.Option{
+			blobstor.WithSmallSizeLimit(1 << 10),
+			blobstor.WithPayloadDeduplication(true),
+		})
+	}
+
+	unreferenced := func() [][sha256.Size]byte {
+		db := meta.New(meta.WithPath(filepath.Join(root, "meta")))
+		require.NoError(t, db.Open())
+
+		defer db.Close()
+
+		sums, err := meta.UnreferencedPayloads(db, 10)
+		require.NoError(t, err)
+
+		return sums
+	}
+
+	var issues []*shard.FsckIssue
+
+	fsck := func(sh *shard.Shard, repair bool) {
+		issues = nil
+
+		_, err := sh.Fsck(new(shard.FsckPrm).
+			WithRepair(repair).
+			WithIssueHandler(func(i *shard.FsckIssue) {
+				issues = append(issues, i)
+			}),
+		)
+		require.NoError(t, err)
+	}
+
+	sh := openShard()
+
+	obj := generateSignedObject(t, 4<<10)
+
+	_, err := sh.Put(new(shard.PutPrm).WithObject(obj.Object()))
+	require.NoError(t, err)
+	require.NoError(t, sh.Close())
+
+	t.Run("payload reference", func(t *testing.T) {
+		db := meta.New(meta.WithPath(filepath.Join(root, "meta")))
+		require.NoError(t, db.Open())
+		require.NoError(t, meta.Delete(db, obj.Object().Address()))
+		require.NoError(t, db.Close())
+
+		// the payload is released along with the record
+		require.Len(t, unreferenced(), 1)
+
+		sh := openShard()
+
+		fsck(sh, true)
+		require.Len(t, issues, 1)
+		require.Equal(t, shard.FsckOrphanedObject, issues[0].Type())
+		require.True(t, issues[0].Repaired())
+		require.NoError(t, sh.Close())
+
+		require.Empty(t, unreferenced())
+	})
+
+	t.Run("lost payload", func(t *testing.T) {
+		// the payload directory is kept, so the payload is expected
+		err := filepath.WalkDir(filepath.Join(root, "blob", "payload"), func(p string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+
+			return os.Remove(p)
+		})
+		require.NoError(t, err)
+
+		sh := openShard()
+		defer sh.Close()
+
+		fsck(sh, false)
+		require.NotEmpty(t, issues)
+		require.Equal(t, shard.FsckCorruptedObject, issues[0].Type())
+		require.Equal(t, obj.Object().Address(), issues[0].Address())
+		require.Error(t, issues[0].Error())
+	})
+}
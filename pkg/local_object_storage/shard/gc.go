@@ -178,6 +178,10 @@ func (s *Shard) removeGarbage() {
 		return
 	}
 
+	// payloads released by the objects removed
+	// on the previous iterations
+	s.removeUnreferencedPayloads()
+
 	buf := make([]*addressSDK.Address, 0, s.rmBatchSize)
 
 	// iterate over metabase graveyard and accumulate
@@ -40,3 +40,42 @@ func (s *Shard) ToMoveIt(prm *ToMoveItPrm) (*ToMoveItRes, error) {
 
 	return new(ToMoveItRes), nil
 }
+
+// DoNotMovePrm encapsulates parameters for DoNotMove operation.
+type DoNotMovePrm struct {
+	addr *addressSDK.Address
+}
+
+// DoNotMoveRes encapsulates results of DoNotMove operation.
+type DoNotMoveRes struct{}
+
+// WithAddress sets object address that should not be moved into another
+// shard anymore.
+func (p *DoNotMovePrm) WithAddress(addr *addressSDK.Address) *DoNotMovePrm {
+	if p != nil {
+		p.addr = addr
+	}
+
+	return p
+}
+
+// DoNotMove calls metabase.DoNotMove method to remove relocation mark
+// of the object.
+func (s *Shard) DoNotMove(prm *DoNotMovePrm) (*DoNotMoveRes, error) {
+	if s.GetMode() == ModeReadOnly {
+		return nil, ErrReadOnlyMode
+	}
+
+	err := meta.DoNotMove(s.metaBase, prm.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return new(DoNotMoveRes), nil
+}
+
+// Movable returns addresses of the objects marked
+// to be moved into another shard.
+func (s *Shard) Movable() ([]*addressSDK.Address, error) {
+	return meta.Movable(s.metaBase)
+}
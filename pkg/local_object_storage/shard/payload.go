@@ -0,0 +1,62 @@
+package shard
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
+)
+
+// payloadReferences provides BlobStor with the references
+// to the deduplicated payloads stored in the metabase.
+type payloadReferences struct {
+	db *meta.DB
+}
+
+func (r payloadReferences) ReferencePayload(addr *addressSDK.Address, sum [sha256.Size]byte) error {
+	return meta.ReferencePayload(r.db, addr, sum)
+}
+
+func (r payloadReferences) DropUnreferencedPayload(sum [sha256.Size]byte) (bool, error) {
+	return meta.DropUnreferencedPayload(r.db, sum)
+}
+
+// referencePayload restores the reference of the object
+// to the deduplicated payload.
+func (s *Shard) referencePayload(obj *object.Object) error {
+	cs := obj.PayloadChecksum()
+	if cs == nil || cs.Type() != checksum.SHA256 || len(cs.Sum()) != sha256.Size {
+		return errors.New("missing SHA-256 checksum of the deduplicated payload")
+	}
+
+	var sum [sha256.Size]byte
+
+	copy(sum[:], cs.Sum())
+
+	return meta.ReferencePayload(s.metaBase, obj.Address(), sum)
+}
+
+// removeUnreferencedPayloads removes deduplicated payloads which
+// are not referenced by any object (no more the s.rmBatchSize payloads).
+func (s *Shard) removeUnreferencedPayloads() {
+	sums, err := meta.UnreferencedPayloads(s.metaBase, s.rmBatchSize)
+	if err != nil {
+		s.log.Warn("could not get unreferenced payloads",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	for i := range sums {
+		if _, err := s.blobStor.DeletePayload(sums[i]); err != nil {
+			s.log.Warn("could not delete unreferenced payload",
+				zap.String("error", err.Error()),
+			)
+		}
+	}
+}
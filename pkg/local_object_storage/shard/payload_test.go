@@ -0,0 +1,120 @@
+package shard
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	objecttest "github.com/nspcc-dev/neofs-sdk-go/object/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_PayloadDeduplication(t *testing.T) {
+	p := t.Name()
+
+	defer os.RemoveAll(p)
+
+	newShard := func(refill bool) *Shard {
+		sh := New(
+			WithBlobStorOptions(
+				blobstor.WithRootPath(filepath.Join(p, "blob")),
+				blobstor.WithBlobovniczaShallowWidth(1),
+				blobstor.WithBlobovniczaShallowDepth(1),
+				blobstor.WithSmallSizeLimit(1<<10),
+				blobstor.WithPayloadDeduplication(true),
+			),
+			WithMetaBaseOptions(
+				meta.WithPath(filepath.Join(p, "meta")),
+			),
+			WithRefillMetabase(refill),
+		)
+
+		require.NoError(t, sh.Open())
+		require.NoError(t, sh.Init())
+
+		return sh
+	}
+
+	countPayloads := func() int {
+		var n int
+
+		err := filepath.WalkDir(filepath.Join(p, "blob", "payload"), func(_ string, d fs.DirEntry, err error) error {
+			if err == nil && !d.IsDir() {
+				n++
+			}
+
+			return err
+		})
+		require.NoError(t, err)
+
+		return n
+	}
+
+	payload := make([]byte, 4<<10)
+	_, _ = rand.Read(payload)
+
+	cs := checksum.New()
+	cs.SetSHA256(sha256.Sum256(payload))
+
+	objs := make([]*object.Object, 2)
+
+	for i := range objs {
+		raw := object.NewRawFrom(objecttest.Raw())
+		raw.SetType(objectSDK.TypeRegular)
+		raw.SetPayload(payload)
+		raw.SetPayloadSize(uint64(len(payload)))
+		raw.SetPayloadChecksum(cs)
+
+		objs[i] = raw.Object()
+	}
+
+	sh := newShard(false)
+
+	var putPrm PutPrm
+
+	for i := range objs {
+		_, err := sh.Put(putPrm.WithObject(objs[i]))
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, 1, countPayloads())
+
+	require.NoError(t, sh.Close())
+
+	// references are restored from the stored objects
+	sh = newShard(true)
+	defer sh.Close()
+
+	var getPrm GetPrm
+
+	for i := range objs {
+		res, err := sh.Get(getPrm.WithAddress(objs[i].Address()))
+		require.NoError(t, err)
+		require.Equal(t, payload, res.Object().Payload())
+	}
+
+	_, err := sh.Delete(new(DeletePrm).WithAddresses(objs[0].Address()))
+	require.NoError(t, err)
+
+	// payload is still used by the second object
+	sh.removeUnreferencedPayloads()
+	require.Equal(t, 1, countPayloads())
+
+	res, err := sh.Get(getPrm.WithAddress(objs[1].Address()))
+	require.NoError(t, err)
+	require.Equal(t, payload, res.Object().Payload())
+
+	_, err = sh.Delete(new(DeletePrm).WithAddresses(objs[1].Address()))
+	require.NoError(t, err)
+
+	sh.removeUnreferencedPayloads()
+	require.Zero(t, countPayloads())
+}
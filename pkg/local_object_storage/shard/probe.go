@@ -0,0 +1,141 @@
+package shard
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// probePayloadSize is a payload size of the probe object.
+const probePayloadSize = 64
+
+var errProbeMismatch = errors.New("probe object differs from the saved one")
+
+// Probe checks that the shard is able to save, read and remove an object.
+//
+// Probe object is written directly to BLOB storage and is not indexed
+// in metabase, so it can't be seen by the shard users. Metabase is only
+// checked for being readable.
+//
+// Returns ErrReadOnlyMode error if shard is in "read-only" mode.
+func (s *Shard) Probe() error {
+	if s.GetMode() == ModeReadOnly {
+		return ErrReadOnlyMode
+	}
+
+	obj, err := newProbeObject()
+	if err != nil {
+		return fmt.Errorf("could not generate probe object: %w", err)
+	}
+
+	addr := obj.Address()
+
+	if _, err = meta.Exists(s.metaBase, addr); err != nil {
+		return fmt.Errorf("could not read metabase: %w", err)
+	}
+
+	putPrm := new(blobstor.PutPrm)
+	putPrm.SetObject(obj)
+
+	putRes, err := s.blobStor.Put(putPrm)
+	if err != nil {
+		return fmt.Errorf("could not put probe object to BLOB storage: %w", err)
+	}
+
+	var got *object.Object
+
+	if blzID := putRes.BlobovniczaID(); blzID != nil {
+		getPrm := new(blobstor.GetSmallPrm)
+		getPrm.SetAddress(addr)
+		getPrm.SetBlobovniczaID(blzID)
+
+		var res *blobstor.GetSmallRes
+
+		if res, err = s.blobStor.GetSmall(getPrm); err == nil {
+			got = res.Object()
+		}
+	} else {
+		getPrm := new(blobstor.GetBigPrm)
+		getPrm.SetAddress(addr)
+
+		var res *blobstor.GetBigRes
+
+		if res, err = s.blobStor.GetBig(getPrm); err == nil {
+			got = res.Object()
+		}
+	}
+
+	if err == nil && !bytes.Equal(got.Payload(), obj.Payload()) {
+		err = errProbeMismatch
+	}
+
+	if err != nil {
+		err = fmt.Errorf("could not get probe object from BLOB storage: %w", err)
+	}
+
+	// probe object is removed even if it can't be read
+	if delErr := s.deleteProbeObject(putRes, obj); delErr != nil && err == nil {
+		err = fmt.Errorf("could not delete probe object from BLOB storage: %w", delErr)
+	}
+
+	return err
+}
+
+func (s *Shard) deleteProbeObject(putRes *blobstor.PutRes, obj *object.Object) error {
+	if blzID := putRes.BlobovniczaID(); blzID != nil {
+		delPrm := new(blobstor.DeleteSmallPrm)
+		delPrm.SetAddress(obj.Address())
+		delPrm.SetBlobovniczaID(blzID)
+
+		_, err := s.blobStor.DeleteSmall(delPrm)
+
+		return err
+	}
+
+	delPrm := new(blobstor.DeleteBigPrm)
+	delPrm.SetAddress(obj.Address())
+
+	_, err := s.blobStor.DeleteBig(delPrm)
+
+	return err
+}
+
+func newProbeObject() (*object.Object, error) {
+	// container and object IDs are random, so the probe object
+	// never collides with the stored ones
+	buf := make([]byte, 2*sha256.Size+probePayloadSize)
+
+	if _, err := rand.Read(buf); err != nil {
+		return nil, err
+	}
+
+	var cs [sha256.Size]byte
+
+	copy(cs[:], buf)
+
+	cnr := cid.New()
+	cnr.SetSHA256(cs)
+
+	copy(cs[:], buf[sha256.Size:])
+
+	id := oidSDK.NewID()
+	id.SetSHA256(cs)
+
+	payload := buf[2*sha256.Size:]
+
+	obj := object.NewRaw()
+	obj.SetContainerID(cnr)
+	obj.SetID(id)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(len(payload)))
+
+	return obj.Object(), nil
+}
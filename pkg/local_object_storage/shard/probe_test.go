@@ -0,0 +1,37 @@
+package shard_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/blobstor"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShard_Probe(t *testing.T) {
+	t.Run("small", func(t *testing.T) {
+		testShardProbe(t, newShard(t, false))
+	})
+
+	t.Run("big", func(t *testing.T) {
+		// probe object does not fit in blobovnicza
+		testShardProbe(t, newCustomShard(t, t.TempDir(), false, nil, []blobstor.Option{
+			blobstor.WithSmallSizeLimit(1),
+		}))
+	})
+}
+
+func testShardProbe(t *testing.T, sh *shard.Shard) {
+	defer releaseShard(sh, t)
+
+	require.NoError(t, sh.Probe())
+
+	// probe object must not be left in the shard
+	res, err := sh.Fsck(new(shard.FsckPrm))
+	require.NoError(t, err)
+	require.Zero(t, res.Objects())
+	require.Zero(t, res.Issues())
+
+	require.NoError(t, sh.SetMode(shard.ModeReadOnly))
+	require.ErrorIs(t, sh.Probe(), shard.ErrReadOnlyMode)
+}
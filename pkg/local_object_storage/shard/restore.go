@@ -1,23 +1,31 @@
 package shard
 
 import (
-	"bytes"
-	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"os"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 )
 
 // ErrInvalidMagic is returned when dump format is invalid.
 var ErrInvalidMagic = errors.New("invalid magic")
 
+// ErrIncrementGap is returned when incremental dump starts
+// after the watermark of the previously restored data.
+var ErrIncrementGap = errors.New("incremental dump does not follow the base watermark")
+
 // RestorePrm groups the parameters of Restore operation.
 type RestorePrm struct {
 	path         string
 	stream       io.Reader
 	ignoreErrors bool
+
+	checkBase bool
+	base      uint64
 }
 
 // WithPath is a Restore option to set the destination path.
@@ -40,10 +48,20 @@ func (p *RestorePrm) WithIgnoreErrors(ignore bool) *RestorePrm {
 	return p
 }
 
+// WithBaseWatermark is a Restore option to set the watermark of the data
+// restored before. Incremental dump which was made since the later watermark
+// is rejected with ErrIncrementGap. Has no effect for full dumps.
+func (p *RestorePrm) WithBaseWatermark(w uint64) *RestorePrm {
+	p.checkBase = true
+	p.base = w
+	return p
+}
+
 // RestoreRes groups the result fields of Restore operation.
 type RestoreRes struct {
-	count  int
-	failed int
+	count     int
+	failed    int
+	watermark uint64
 }
 
 // Count return amount of object written.
@@ -56,8 +74,19 @@ func (r *RestoreRes) FailCount() int {
 	return r.failed
 }
 
+// Watermark returns the watermark of the restored incremental dump.
+// It should be passed as the base watermark when restoring the next increment.
+//
+// Always returns zero for full dumps.
+func (r *RestoreRes) Watermark() uint64 {
+	return r.watermark
+}
+
 // Restore restores objects from the dump prepared by Dump.
 //
+// Objects removed by the restored tombstones are marked as removed.
+// Incremental dumps must be applied in the order they were made.
+//
 // Returns any error encountered.
 func (s *Shard) Restore(prm *RestorePrm) (*RestoreRes, error) {
 	// Disallow changing mode during restore.
@@ -79,35 +108,28 @@ func (s *Shard) Restore(prm *RestorePrm) (*RestoreRes, error) {
 		r = f
 	}
 
-	var m [4]byte
-	_, _ = io.ReadFull(r, m[:])
-	if !bytes.Equal(m[:], dumpMagic) {
-		return nil, ErrInvalidMagic
+	dr, hdr, err := newDumpReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.close()
+
+	incremental := hdr.flags&dumpFlagIncremental != 0
+	if incremental && prm.checkBase && hdr.since > prm.base {
+		return nil, fmt.Errorf("%w: since %d, base %d", ErrIncrementGap, hdr.since, prm.base)
 	}
 
 	var count, failCount int
-	var data []byte
-	var size [4]byte
 	for {
-		// If there are less than 4 bytes left, `Read` returns nil error instead of
-		// io.ErrUnexpectedEOF, thus `ReadFull` is used.
-		_, err := io.ReadFull(r, size[:])
+		data, err := dr.next()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
 				break
 			}
-			return nil, err
-		}
-
-		sz := binary.LittleEndian.Uint32(size[:])
-		if uint32(cap(data)) < sz {
-			data = make([]byte, sz)
-		} else {
-			data = data[:sz]
-		}
-
-		_, err = r.Read(data)
-		if err != nil {
+			if errors.Is(err, ErrInvalidChecksum) && prm.ignoreErrors {
+				failCount++
+				continue
+			}
 			return nil, err
 		}
 
@@ -123,11 +145,54 @@ func (s *Shard) Restore(prm *RestorePrm) (*RestoreRes, error) {
 
 		_, err = s.Put(new(PutPrm).WithObject(obj))
 		if err != nil {
+			// object has been removed by the tombstone restored before
+			if errors.Is(err, object.ErrAlreadyRemoved) {
+				continue
+			}
 			return nil, err
 		}
 
+		if obj.Type() == objectSDK.TypeTombstone {
+			if err := s.restoreTombstone(obj); err != nil {
+				if prm.ignoreErrors {
+					failCount++
+					continue
+				}
+				return nil, err
+			}
+		}
+
 		count++
 	}
 
-	return &RestoreRes{count: count, failed: failCount}, nil
+	return &RestoreRes{count: count, failed: failCount, watermark: dr.watermark}, nil
+}
+
+// restoreTombstone marks the members of the restored tombstone as removed.
+func (s *Shard) restoreTombstone(obj *object.Object) error {
+	tombstone := objectSDK.NewTombstone()
+
+	if err := tombstone.Unmarshal(obj.Payload()); err != nil {
+		return fmt.Errorf("could not unmarshal tombstone content: %w", err)
+	}
+
+	tombAddr := obj.Address()
+	cid := tombAddr.ContainerID()
+	memberIDs := tombstone.Members()
+	tombMembers := make([]*addressSDK.Address, 0, len(memberIDs))
+
+	for _, id := range memberIDs {
+		if id == nil {
+			return errors.New("empty member in tombstone")
+		}
+
+		a := addressSDK.NewAddress()
+		a.SetContainerID(cid)
+		a.SetObjectID(id)
+
+		tombMembers = append(tombMembers, a)
+	}
+
+	_, err := s.Inhume(new(InhumePrm).WithTarget(tombAddr, tombMembers...))
+	return err
 }
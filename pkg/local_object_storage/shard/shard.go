@@ -73,8 +73,10 @@ func New(opts ...Option) *Shard {
 		opts[i](c)
 	}
 
-	bs := blobstor.New(c.blobOpts...)
 	mb := meta.New(c.metaOpts...)
+	bs := blobstor.New(
+		append(c.blobOpts,
+			blobstor.WithPayloadReferences(payloadReferences{db: mb}))...)
 
 	var writeCache writecache.Cache
 	if c.useWriteCache {
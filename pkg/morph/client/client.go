@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/nspcc-dev/neo-go/pkg/core/block"
 	"github.com/nspcc-dev/neo-go/pkg/core/native/nativenames"
 	"github.com/nspcc-dev/neo-go/pkg/core/native/noderoles"
 	"github.com/nspcc-dev/neo-go/pkg/core/transaction"
 	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
 	"github.com/nspcc-dev/neo-go/pkg/encoding/fixedn"
 	"github.com/nspcc-dev/neo-go/pkg/rpc/client"
+	"github.com/nspcc-dev/neo-go/pkg/rpc/response/result"
 	sc "github.com/nspcc-dev/neo-go/pkg/smartcontract"
 	"github.com/nspcc-dev/neo-go/pkg/smartcontract/trigger"
 	"github.com/nspcc-dev/neo-go/pkg/util"
@@ -19,6 +21,7 @@ import (
 	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
 	"github.com/nspcc-dev/neo-go/pkg/wallet"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"go.uber.org/zap"
 )
 
@@ -116,13 +119,19 @@ func unwrapNeoFSError(err error) error {
 
 // Invoke invokes contract method by sending transaction into blockchain.
 // Supported args types: int64, string, util.Uint160, []byte and bool.
-func (c *Client) Invoke(contract util.Uint160, fee fixedn.Fixed8, method string, args ...interface{}) error {
+func (c *Client) Invoke(contract util.Uint160, fee fixedn.Fixed8, method string, args ...interface{}) (err error) {
 	if c.multiClient != nil {
 		return c.multiClient.iterateClients(func(c *Client) error {
 			return c.Invoke(contract, fee, method, args...)
 		})
 	}
 
+	span := startInvocationSpan("Invoke", contract, method)
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+
 	params := make([]sc.Parameter, 0, len(args))
 
 	for i := range args {
@@ -189,6 +198,12 @@ func (c *Client) TestInvoke(contract util.Uint160, method string, args ...interf
 		})
 	}
 
+	span := startInvocationSpan("TestInvoke", contract, method)
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+
 	var params = make([]sc.Parameter, 0, len(args))
 
 	for i := range args {
@@ -219,6 +234,18 @@ func (c *Client) TestInvoke(contract util.Uint160, method string, args ...interf
 	return val.Stack, nil
 }
 
+// startInvocationSpan starts tracing span of the contract method call.
+// Side chain calls are not made within the traced object requests,
+// so the span is a root one.
+func startInvocationSpan(op string, contract util.Uint160, method string) *tracing.Span {
+	_, span := tracing.StartSpanWithKind(context.Background(), "morph."+op, tracing.SpanKindClient,
+		tracing.Attribute{Key: "contract", Value: contract.StringLE()},
+		tracing.Attribute{Key: "method", Value: method},
+	)
+
+	return span
+}
+
 // TransferGas to the receiver from local wallet
 func (c *Client) TransferGas(receiver util.Uint160, amount fixedn.Fixed8) error {
 	if c.multiClient != nil {
@@ -462,6 +489,46 @@ func (c *Client) BlockCount() (res uint32, err error) {
 	return c.client.GetBlockCount()
 }
 
+// Block returns block of the network with the given index.
+func (c *Client) Block(index uint32) (res *block.Block, err error) {
+	if c.multiClient != nil {
+		return res, c.multiClient.iterateClients(func(c *Client) error {
+			res, err = c.Block(index)
+			return err
+		})
+	}
+
+	return c.client.GetBlockByIndex(index)
+}
+
+// ApplicationLog returns application log of the transaction
+// with the given hash. Only Application trigger executions are returned.
+func (c *Client) ApplicationLog(h util.Uint256) (res *result.ApplicationLog, err error) {
+	if c.multiClient != nil {
+		return res, c.multiClient.iterateClients(func(c *Client) error {
+			res, err = c.ApplicationLog(h)
+			return err
+		})
+	}
+
+	trig := trigger.Application
+	return c.client.GetApplicationLog(h, &trig)
+}
+
+// NEP17Transfers returns the page of NEP-17 transfers of the account made
+// in the time range (Unix timestamps in milliseconds). The latest transfers
+// are on the first page.
+func (c *Client) NEP17Transfers(acc util.Uint160, start, stop uint64, limit, page int) (res *result.NEP17Transfers, err error) {
+	if c.multiClient != nil {
+		return res, c.multiClient.iterateClients(func(c *Client) error {
+			res, err = c.NEP17Transfers(acc, start, stop, limit, page)
+			return err
+		})
+	}
+
+	return c.client.GetNEP17Transfers(acc, &start, &stop, &limit, &page)
+}
+
 // MsPerBlock returns MillisecondsPerBlock network parameter.
 func (c *Client) MsPerBlock() (res int64, err error) {
 	if c.multiClient != nil {
@@ -20,8 +20,13 @@ const (
 	etAlphaConfig           = "EigenTrustAlpha"
 	irCandidateFeeConfig    = "InnerRingCandidateFee"
 	withdrawFeeConfig       = "WithdrawFee"
+	maintenanceDurConfig    = "MaintenanceDuration"
 )
 
+// DefaultMaintenanceDuration is a number of epochs storage node is allowed
+// to stay in maintenance mode if the limit is not set in network config.
+const DefaultMaintenanceDuration = 10
+
 // MaxObjectSize receives max object size configuration
 // value through the Netmap contract call.
 func (c *Client) MaxObjectSize() (uint64, error) {
@@ -131,6 +136,24 @@ func (c *Client) WithdrawFee() (uint64, error) {
 	return fee, nil
 }
 
+// MaintenanceDuration returns global configuration value of the number of
+// epochs storage node is allowed to stay in maintenance mode. Returns
+// DefaultMaintenanceDuration if the value is not set.
+func (c *Client) MaintenanceDuration() (uint64, error) {
+	v, err := c.config([]byte(maintenanceDurConfig), func(item stackitem.Item) (interface{}, error) {
+		if _, ok := item.(stackitem.Null); ok {
+			return int64(DefaultMaintenanceDuration), nil
+		}
+
+		return IntegerAssert(item)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("(%T) could not get maintenance duration: %w", c, err)
+	}
+
+	return uint64(v.(int64)), nil
+}
+
 func (c *Client) readUInt64Config(key string) (uint64, error) {
 	v, err := c.config([]byte(key), IntegerAssert)
 	if err != nil {
@@ -225,6 +248,7 @@ type ConfigWriter interface {
 	EigenTrustAlpha(float64)
 	InnerRingCandidateFee(uint64)
 	WithdrawFee(uint64)
+	MaintenanceDuration(uint64)
 }
 
 // WriteConfig writes NeoFS network configuration received via iterator.
@@ -260,6 +284,8 @@ func WriteConfig(dst ConfigWriter, iterator func(func(key, val []byte) error) er
 			dst.InnerRingCandidateFee(bigint.FromBytes(val).Uint64())
 		case withdrawFeeConfig:
 			dst.WithdrawFee(bigint.FromBytes(val).Uint64())
+		case maintenanceDurConfig:
+			dst.MaintenanceDuration(bigint.FromBytes(val).Uint64())
 		}
 
 		return nil
@@ -434,7 +434,13 @@ func (c *Client) notaryInvokeAsCommittee(method string, nonce, vub uint32, args
 	return c.notaryInvoke(true, true, designate, nonce, &vub, method, args...)
 }
 
-func (c *Client) notaryInvoke(committee, invokedByAlpha bool, contract util.Uint160, nonce uint32, vub *uint32, method string, args ...interface{}) error {
+func (c *Client) notaryInvoke(committee, invokedByAlpha bool, contract util.Uint160, nonce uint32, vub *uint32, method string, args ...interface{}) (err error) {
+	span := startInvocationSpan("NotaryInvoke", contract, method)
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+
 	alphabetList, err := c.notary.alphabetSource() // prepare arguments for test invocation
 	if err != nil {
 		return err
@@ -0,0 +1,97 @@
+package balance
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neo-go/pkg/rpc/response/result/subscriptions"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/client"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+)
+
+// TransferX structure of balance.TransferX notification from morph chain.
+type TransferX struct {
+	from    util.Uint160
+	to      util.Uint160
+	amount  int64 // Fixed16
+	details []byte
+
+	// txHash is used in notary environmental
+	// for calculating unique but same for
+	// all notification receivers values.
+	txHash util.Uint256
+}
+
+// MorphEvent implements Neo:Morph Event interface.
+func (TransferX) MorphEvent() {}
+
+// From returns script hash of the payer.
+func (t TransferX) From() util.Uint160 { return t.from }
+
+// To returns script hash of the payee.
+func (t TransferX) To() util.Uint160 { return t.to }
+
+// Amount of the transferred assets.
+func (t TransferX) Amount() int64 { return t.amount }
+
+// Details returns details of the transfer.
+func (t TransferX) Details() []byte { return t.details }
+
+// TxHash returns hash of the TX with transfer
+// notification.
+func (t TransferX) TxHash() util.Uint256 { return t.txHash }
+
+// ParseTransferX from notification into transfer structure.
+func ParseTransferX(e *subscriptions.NotificationEvent) (event.Event, error) {
+	var (
+		ev  TransferX
+		err error
+	)
+
+	params, err := event.ParseStackArray(e)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse stack items from notify event: %w", err)
+	}
+
+	if ln := len(params); ln != 4 {
+		return nil, event.WrongNumberOfParameters(4, ln)
+	}
+
+	// parse payer
+	from, err := client.BytesFromStackItem(params[0])
+	if err != nil {
+		return nil, fmt.Errorf("could not get transfer payer: %w", err)
+	}
+
+	ev.from, err = util.Uint160DecodeBytesBE(from)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert transfer payer to uint160: %w", err)
+	}
+
+	// parse payee
+	to, err := client.BytesFromStackItem(params[1])
+	if err != nil {
+		return nil, fmt.Errorf("could not get transfer payee: %w", err)
+	}
+
+	ev.to, err = util.Uint160DecodeBytesBE(to)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert transfer payee to uint160: %w", err)
+	}
+
+	// parse amount
+	ev.amount, err = client.IntFromStackItem(params[2])
+	if err != nil {
+		return nil, fmt.Errorf("could not get transfer amount: %w", err)
+	}
+
+	// parse details
+	ev.details, err = client.BytesFromStackItem(params[3])
+	if err != nil {
+		return nil, fmt.Errorf("could not get transfer details: %w", err)
+	}
+
+	ev.txHash = e.Container
+
+	return ev, nil
+}
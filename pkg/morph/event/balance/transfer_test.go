@@ -0,0 +1,92 @@
+package balance
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neo-go/pkg/vm/stackitem"
+	"github.com/nspcc-dev/neofs-node/pkg/morph/event"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTransferX(t *testing.T) {
+	var (
+		from    = util.Uint160{0x1, 0x2, 0x3}
+		to      = util.Uint160{0x3, 0x2, 0x1}
+		details = []byte{0x41, 0xCA, 0x07, 0, 0, 0, 0, 0, 0}
+
+		amount int64 = 10
+	)
+
+	t.Run("wrong number of parameters", func(t *testing.T) {
+		prms := []stackitem.Item{
+			stackitem.NewMap(),
+			stackitem.NewMap(),
+		}
+
+		_, err := ParseTransferX(createNotifyEventFromItems(prms))
+		require.EqualError(t, err, event.WrongNumberOfParameters(4, len(prms)).Error())
+	})
+
+	t.Run("wrong from parameter", func(t *testing.T) {
+		_, err := ParseTransferX(createNotifyEventFromItems([]stackitem.Item{
+			stackitem.NewMap(),
+			stackitem.NewByteArray(to.BytesBE()),
+			stackitem.NewBigInteger(new(big.Int).SetInt64(amount)),
+			stackitem.NewByteArray(details),
+		}))
+
+		require.Error(t, err)
+	})
+
+	t.Run("wrong to parameter", func(t *testing.T) {
+		_, err := ParseTransferX(createNotifyEventFromItems([]stackitem.Item{
+			stackitem.NewByteArray(from.BytesBE()),
+			stackitem.NewMap(),
+			stackitem.NewBigInteger(new(big.Int).SetInt64(amount)),
+			stackitem.NewByteArray(details),
+		}))
+
+		require.Error(t, err)
+	})
+
+	t.Run("wrong amount parameter", func(t *testing.T) {
+		_, err := ParseTransferX(createNotifyEventFromItems([]stackitem.Item{
+			stackitem.NewByteArray(from.BytesBE()),
+			stackitem.NewByteArray(to.BytesBE()),
+			stackitem.NewMap(),
+			stackitem.NewByteArray(details),
+		}))
+
+		require.Error(t, err)
+	})
+
+	t.Run("wrong details parameter", func(t *testing.T) {
+		_, err := ParseTransferX(createNotifyEventFromItems([]stackitem.Item{
+			stackitem.NewByteArray(from.BytesBE()),
+			stackitem.NewByteArray(to.BytesBE()),
+			stackitem.NewBigInteger(new(big.Int).SetInt64(amount)),
+			stackitem.NewMap(),
+		}))
+
+		require.Error(t, err)
+	})
+
+	t.Run("correct behavior", func(t *testing.T) {
+		ev, err := ParseTransferX(createNotifyEventFromItems([]stackitem.Item{
+			stackitem.NewByteArray(from.BytesBE()),
+			stackitem.NewByteArray(to.BytesBE()),
+			stackitem.NewBigInteger(new(big.Int).SetInt64(amount)),
+			stackitem.NewByteArray(details),
+		}))
+
+		require.NoError(t, err)
+		require.Equal(t, TransferX{
+			from:    from,
+			to:      to,
+			amount:  amount,
+			details: details,
+		}, ev)
+	})
+}
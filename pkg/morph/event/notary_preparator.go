@@ -83,13 +83,10 @@ func notaryPreparator(prm PreparatorPrm) NotaryPreparator {
 		panic("block counter must not be nil")
 	}
 
-	contractSysCall := make([]byte, 4)
-	binary.LittleEndian.PutUint32(contractSysCall, interopnames.ToID([]byte(interopnames.SystemContractCall)))
-
 	dummyInvocationScript := append([]byte{byte(opcode.PUSHDATA1), 64}, make([]byte, 64)...)
 
 	return Preparator{
-		contractSysCall:       contractSysCall,
+		contractSysCall:       contractSysCall(),
 		dummyInvocationScript: dummyInvocationScript,
 		alphaKeys:             prm.AlphaKeys,
 		blockCounter:          prm.BlockCounter,
@@ -154,18 +151,56 @@ func (p Preparator) Prepare(nr *payload.P2PNotaryRequest) (NotaryEvent, error) {
 		return nil, err
 	}
 
+	ev, err := parseContractCall(nr.MainTransaction.Script, p.contractSysCall)
+	if err != nil {
+		return nil, err
+	}
+
+	ev.raw = nr
+
+	return ev, nil
+}
+
+// ContractCallEvent converts the transaction calling a contract method
+// to NotaryEvent. Unlike Preparator, it does not check that the transaction
+// is a main transaction of the unsigned notary request, so it can be applied
+// to the already executed transactions, e.g. the ones taken from the blocks.
+//
+// Raw notary request of the resulting event contains main transaction only.
+func ContractCallEvent(tx *transaction.Transaction) (NotaryEvent, error) {
+	ev, err := parseContractCall(tx.Script, contractSysCall())
+	if err != nil {
+		return nil, err
+	}
+
+	ev.raw = &payload.P2PNotaryRequest{MainTransaction: tx}
+
+	return ev, nil
+}
+
+func contractSysCall() []byte {
+	res := make([]byte, 4)
+	binary.LittleEndian.PutUint32(res, interopnames.ToID([]byte(interopnames.SystemContractCall)))
+
+	return res
+}
+
+// parseContractCall decodes the script of a single contract method call
+// with the packed arguments.
+func parseContractCall(script, sysCall []byte) (parsedNotaryEvent, error) {
 	var (
 		opCode opcode.Opcode
 		param  []byte
+		err    error
 	)
 
-	ctx := vm.NewContext(nr.MainTransaction.Script)
+	ctx := vm.NewContext(script)
 	ops := make([]Op, 0, 10) // 10 is maximum num of opcodes for calling contracts with 4 args(no arrays of arrays)
 
 	for {
 		opCode, param, err = ctx.Next()
 		if err != nil {
-			return nil, fmt.Errorf("could not get next opcode in script: %w", err)
+			return parsedNotaryEvent{}, fmt.Errorf("could not get next opcode in script: %w", err)
 		}
 
 		if opCode == opcode.RET {
@@ -178,14 +213,14 @@ func (p Preparator) Prepare(nr *payload.P2PNotaryRequest) (NotaryEvent, error) {
 	opsLen := len(ops)
 
 	// check if it is tx with contract call
-	if !bytes.Equal(ops[opsLen-1].param, p.contractSysCall) {
-		return nil, errNotContractCall
+	if opsLen < 4 || !bytes.Equal(ops[opsLen-1].param, sysCall) {
+		return parsedNotaryEvent{}, errNotContractCall
 	}
 
 	// retrieve contract's script hash
 	contractHash, err := util.Uint160DecodeBytesBE(ops[opsLen-2].param)
 	if err != nil {
-		return nil, fmt.Errorf("could not decode contract hash: %w", err)
+		return parsedNotaryEvent{}, fmt.Errorf("could not decode contract hash: %w", err)
 	}
 
 	// retrieve contract's method
@@ -194,15 +229,15 @@ func (p Preparator) Prepare(nr *payload.P2PNotaryRequest) (NotaryEvent, error) {
 	// check if there is a call flag(must be in range [0:15))
 	callFlag := callflag.CallFlag(ops[opsLen-4].code - opcode.PUSH0)
 	if callFlag > callflag.All {
-		return nil, errIncorrectCallFlag
+		return parsedNotaryEvent{}, errIncorrectCallFlag
 	}
 
 	args := ops[:opsLen-4]
 
 	if len(args) != 0 {
-		err = p.validateParameterOpcodes(args)
+		err = validateParameterOpcodes(args)
 		if err != nil {
-			return nil, fmt.Errorf("could not validate arguments: %w", err)
+			return parsedNotaryEvent{}, fmt.Errorf("could not validate arguments: %w", err)
 		}
 
 		// without args packing opcodes
@@ -213,12 +248,14 @@ func (p Preparator) Prepare(nr *payload.P2PNotaryRequest) (NotaryEvent, error) {
 		hash:       contractHash,
 		notaryType: NotaryTypeFromString(contractMethod),
 		params:     args,
-		raw:        nr,
 	}, nil
 }
 
-func (p Preparator) validateParameterOpcodes(ops []Op) error {
+func validateParameterOpcodes(ops []Op) error {
 	l := len(ops)
+	if l < 2 {
+		return errIncorrectArgPacking
+	}
 
 	if ops[l-1].code != opcode.PACK {
 		return fmt.Errorf("unexpected packing opcode: %s", ops[l-1].code)
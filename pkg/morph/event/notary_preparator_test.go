@@ -410,6 +410,30 @@ func TestPrepare_CorrectNR(t *testing.T) {
 	}
 }
 
+func TestContractCallEvent(t *testing.T) {
+	t.Run("contract call", func(t *testing.T) {
+		tx := transaction.New(script(scriptHash, "test", int64(4), "test"), 0)
+
+		ev, err := ContractCallEvent(tx)
+		require.NoError(t, err)
+		require.Equal(t, "test", ev.Type().String())
+		require.Equal(t, scriptHash, ev.ScriptHash())
+		require.Len(t, ev.Params(), 2)
+		require.Equal(t, tx, ev.Raw().MainTransaction)
+	})
+
+	t.Run("not contract call", func(t *testing.T) {
+		for _, s := range [][]byte{
+			nil,
+			{byte(opcode.PUSH1)},
+			append(script(scriptHash, "test"), byte(opcode.ASSERT)),
+		} {
+			_, err := ContractCallEvent(transaction.New(s, 0))
+			require.Error(t, err)
+		}
+	})
+}
+
 func alphaKeysSource() client.AlphabetKeys {
 	return func() (keys.PublicKeys, error) {
 		return alphaKeys, nil
@@ -0,0 +1,45 @@
+package tlsutil
+
+import (
+	"context"
+	"crypto/x509"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// ClientCertificate returns verified certificate of the gRPC client
+// from the peer information stored in ctx.
+//
+// Returns nil if connection is not secured or client
+// certificate was not verified.
+func ClientCertificate(ctx context.Context) *x509.Certificate {
+	if ctx == nil {
+		return nil
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+
+	return info.State.VerifiedChains[0][0]
+}
+
+// ClientSubject returns string representation of the verified
+// client certificate subject (see ClientCertificate).
+//
+// Returns empty string if there is no verified certificate.
+func ClientSubject(ctx context.Context) string {
+	cert := ClientCertificate(ctx)
+	if cert == nil {
+		return ""
+	}
+
+	return cert.Subject.String()
+}
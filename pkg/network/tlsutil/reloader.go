@@ -0,0 +1,211 @@
+package tlsutil
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"go.uber.org/zap"
+)
+
+// Prm groups the required parameters of the CertificateReloader's constructor.
+type Prm struct {
+	// Path to PEM-encoded certificate file.
+	//
+	// Must not be empty.
+	CertificateFile string
+
+	// Path to PEM-encoded private key file.
+	//
+	// Must not be empty.
+	KeyFile string
+
+	// Path to PEM-encoded certificates of the CA
+	// which must sign client certificates.
+	//
+	// If empty, client certificates are not requested.
+	ClientCAFile string
+}
+
+// CertificateReloader is a holder of the TLS server certificate and
+// client CA pool which can be re-read from the files at runtime.
+//
+// For correct operation, CertificateReloader must be created
+// using the constructor (NewCertificateReloader).
+type CertificateReloader struct {
+	prm Prm
+
+	log *logger.Logger
+
+	mtx sync.RWMutex
+
+	cert *tls.Certificate
+
+	clientCAs *x509.CertPool
+
+	modTimes map[string]time.Time
+}
+
+var errNoCACerts = errors.New("no PEM certificates found")
+
+// NewCertificateReloader creates CertificateReloader and reads
+// the certificates from the files.
+//
+// Returns an error if any file is missing or invalid.
+func NewCertificateReloader(prm Prm, l *logger.Logger) (*CertificateReloader, error) {
+	r := &CertificateReloader{
+		prm:      prm,
+		log:      l,
+		modTimes: make(map[string]time.Time, 3),
+	}
+
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// Reload re-reads all files and replaces the current certificates
+// if all of them are valid.
+func (r *CertificateReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.prm.CertificateFile, r.prm.KeyFile)
+	if err != nil {
+		return fmt.Errorf("could not read certificate from file: %w", err)
+	}
+
+	var pool *x509.CertPool
+
+	if r.prm.ClientCAFile != "" {
+		data, err := os.ReadFile(r.prm.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("could not read client CA file: %w", err)
+		}
+
+		pool = x509.NewCertPool()
+
+		if !pool.AppendCertsFromPEM(data) {
+			return fmt.Errorf("invalid client CA file %s: %w", r.prm.ClientCAFile, errNoCACerts)
+		}
+	}
+
+	modTimes := make(map[string]time.Time, 3)
+
+	for _, f := range r.files() {
+		if info, err := os.Stat(f); err == nil {
+			modTimes[f] = info.ModTime()
+		}
+	}
+
+	r.mtx.Lock()
+	r.cert = &cert
+	r.clientCAs = pool
+	r.modTimes = modTimes
+	r.mtx.Unlock()
+
+	return nil
+}
+
+func (r *CertificateReloader) files() []string {
+	fs := []string{r.prm.CertificateFile, r.prm.KeyFile}
+
+	if r.prm.ClientCAFile != "" {
+		fs = append(fs, r.prm.ClientCAFile)
+	}
+
+	return fs
+}
+
+// changed returns true if modification time of any file
+// differs from the one at the last reload.
+func (r *CertificateReloader) changed() bool {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	for _, f := range r.files() {
+		info, err := os.Stat(f)
+		if err != nil {
+			// file can be replaced non-atomically, wait for the next check
+			continue
+		}
+
+		if !info.ModTime().Equal(r.modTimes[f]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Watch checks the files for modification with the given interval and
+// reloads the certificates on change. Blocks until ctx is done.
+//
+// Invalid files are logged and ignored, previous certificates
+// remain in use.
+func (r *CertificateReloader) Watch(ctx context.Context, interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			if !r.changed() {
+				continue
+			}
+
+			if err := r.Reload(); err != nil {
+				r.log.Error("could not reload TLS certificates",
+					zap.String("error", err.Error()),
+				)
+
+				continue
+			}
+
+			r.log.Info("TLS certificates reloaded",
+				zap.String("certificate", r.prm.CertificateFile),
+			)
+		}
+	}
+}
+
+func (r *CertificateReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.cert, nil
+}
+
+// Config returns server TLS configuration which always uses
+// the last loaded certificates. Base config is copied and completed
+// with certificate getters and client authentication settings.
+func (r *CertificateReloader) Config(base *tls.Config) *tls.Config {
+	cfg := base.Clone()
+	cfg.GetCertificate = r.getCertificate
+
+	if r.prm.ClientCAFile == "" {
+		return cfg
+	}
+
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	cfg.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		r.mtx.RLock()
+		pool := r.clientCAs
+		r.mtx.RUnlock()
+
+		c := cfg.Clone()
+		c.GetConfigForClient = nil
+		c.ClientCAs = pool
+
+		return c, nil
+	}
+
+	return cfg
+}
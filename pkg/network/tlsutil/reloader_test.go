@@ -0,0 +1,198 @@
+package tlsutil
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCert(t *testing.T, cn string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	sn, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          sn,
+		Subject:               pkix.Name{CommonName: cn, Organization: []string{"NeoFS"}},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		DNSNames:              []string{"localhost"},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+	}
+
+	signer, signerKey := tmpl, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, signer, &key.PublicKey, signerKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCert{cert: cert, key: key}
+}
+
+func (c *testCert) writeFiles(t *testing.T, certPath, keyPath string) {
+	require.NoError(t, os.WriteFile(certPath,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.cert.Raw}), 0600))
+
+	if keyPath == "" {
+		return
+	}
+
+	der, err := x509.MarshalECPrivateKey(c.key)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(keyPath,
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600))
+}
+
+func (c *testCert) tlsCertificate() tls.Certificate {
+	return tls.Certificate{
+		Certificate: [][]byte{c.cert.Raw},
+		PrivateKey:  c.key,
+	}
+}
+
+// handshake performs TLS handshake between the server with cfg and
+// client with the optional certificate and returns server connection state.
+func handshake(t *testing.T, cfg *tls.Config, clientCert *tls.Certificate) (tls.ConnectionState, error) {
+	cliConn, srvConn := net.Pipe()
+
+	t.Cleanup(func() {
+		_ = cliConn.Close()
+		_ = srvConn.Close()
+	})
+
+	cliCfg := &tls.Config{InsecureSkipVerify: true}
+	if clientCert != nil {
+		cliCfg.Certificates = []tls.Certificate{*clientCert}
+	}
+
+	cli := tls.Client(cliConn, cliCfg)
+
+	go func() {
+		if cli.Handshake() == nil {
+			// drain post-handshake messages until the connection is closed
+			_, _ = io.Copy(io.Discard, cli)
+		}
+	}()
+
+	srv := tls.Server(srvConn, cfg)
+	err := srv.Handshake()
+
+	return srv.ConnectionState(), err
+}
+
+func TestCertificateReloader(t *testing.T) {
+	dir := t.TempDir()
+
+	var (
+		certPath = filepath.Join(dir, "cert.pem")
+		keyPath  = filepath.Join(dir, "key.pem")
+		caPath   = filepath.Join(dir, "ca.pem")
+	)
+
+	ca := newTestCert(t, "CA", nil)
+	ca.writeFiles(t, caPath, "")
+
+	srvCert := newTestCert(t, "server", ca)
+	srvCert.writeFiles(t, certPath, keyPath)
+
+	r, err := NewCertificateReloader(Prm{
+		CertificateFile: certPath,
+		KeyFile:         keyPath,
+		ClientCAFile:    caPath,
+	}, zap.L())
+	require.NoError(t, err)
+
+	cfg := r.Config(&tls.Config{MinVersion: tls.VersionTLS12})
+
+	t.Run("client without certificate", func(t *testing.T) {
+		_, err := handshake(t, cfg, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("client certificate from another CA", func(t *testing.T) {
+		cliCert := newTestCert(t, "client", newTestCert(t, "other CA", nil)).tlsCertificate()
+
+		_, err := handshake(t, cfg, &cliCert)
+		require.Error(t, err)
+	})
+
+	cliCert := newTestCert(t, "client", ca).tlsCertificate()
+
+	t.Run("valid client certificate", func(t *testing.T) {
+		state, err := handshake(t, cfg, &cliCert)
+		require.NoError(t, err)
+		require.NotEmpty(t, state.VerifiedChains)
+
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: state},
+		})
+
+		require.Equal(t, "CN=client,O=NeoFS", ClientSubject(ctx))
+	})
+
+	t.Run("reload", func(t *testing.T) {
+		require.False(t, r.changed())
+
+		newCert := newTestCert(t, "server-new", ca)
+		newCert.writeFiles(t, certPath, keyPath)
+
+		// guarantee modification time change on coarse-grained filesystems
+		future := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(certPath, future, future))
+
+		require.True(t, r.changed())
+		require.NoError(t, r.Reload())
+		require.False(t, r.changed())
+
+		cert, err := r.getCertificate(nil)
+		require.NoError(t, err)
+		require.Equal(t, newCert.cert.Raw, cert.Certificate[0])
+	})
+
+	t.Run("invalid file on reload", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(caPath, []byte("not a certificate"), 0600))
+		require.ErrorIs(t, r.Reload(), errNoCACerts)
+
+		// previous certificates are still in use
+		_, err := handshake(t, cfg, &cliCert)
+		require.NoError(t, err)
+	})
+}
+
+func TestClientSubject(t *testing.T) {
+	require.Empty(t, ClientSubject(context.Background()))
+	require.Empty(t, ClientSubject(peer.NewContext(context.Background(), &peer.Peer{})))
+}
@@ -166,3 +166,40 @@ func (w *restoreShardResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 	w.RestoreShardResponse = r
 	return nil
 }
+
+type dumpShardStreamResponseWrapper struct {
+	*DumpShardStreamResponse
+}
+
+func (w *dumpShardStreamResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.DumpShardStreamResponse
+}
+
+func (w *dumpShardStreamResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	r, ok := m.(*DumpShardStreamResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, (*DumpShardStreamResponse)(nil))
+	}
+
+	w.DumpShardStreamResponse = r
+	return nil
+}
+
+type diagnosticsResponseWrapper struct {
+	m *DiagnosticsResponse
+}
+
+func (w *diagnosticsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *diagnosticsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*DiagnosticsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
@@ -32,3 +32,117 @@ func (w *healthCheckResponseWrapper) FromGRPCMessage(m grpc.Message) error {
 
 	return nil
 }
+
+type listEventsResponseWrapper struct {
+	m *ListEventsResponse
+}
+
+func (w *listEventsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *listEventsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*ListEventsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
+
+type listProcessorsResponseWrapper struct {
+	m *ListProcessorsResponse
+}
+
+func (w *listProcessorsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *listProcessorsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*ListProcessorsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
+
+type setProcessorStateResponseWrapper struct {
+	m *SetProcessorStateResponse
+}
+
+func (w *setProcessorStateResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *setProcessorStateResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*SetProcessorStateResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
+
+type nodeStatusResponseWrapper struct {
+	m *NodeStatusResponse
+}
+
+func (w *nodeStatusResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *nodeStatusResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*NodeStatusResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
+
+type tickEpochResponseWrapper struct {
+	m *TickEpochResponse
+}
+
+func (w *tickEpochResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *tickEpochResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*TickEpochResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
+
+type listNotaryRequestsResponseWrapper struct {
+	m *ListNotaryRequestsResponse
+}
+
+func (w *listNotaryRequestsResponseWrapper) ToGRPCMessage() grpc.Message {
+	return w.m
+}
+
+func (w *listNotaryRequestsResponseWrapper) FromGRPCMessage(m grpc.Message) error {
+	var ok bool
+
+	w.m, ok = m.(*ListNotaryRequestsResponse)
+	if !ok {
+		return message.NewUnexpectedMessageType(m, w.m)
+	}
+
+	return nil
+}
@@ -8,7 +8,13 @@ import (
 const serviceName = "ircontrol.ControlService"
 
 const (
-	rpcHealthCheck = "HealthCheck"
+	rpcHealthCheck        = "HealthCheck"
+	rpcListEvents         = "ListEvents"
+	rpcListProcessors     = "ListProcessors"
+	rpcSetProcessorState  = "SetProcessorState"
+	rpcNodeStatus         = "NodeStatus"
+	rpcTickEpoch          = "TickEpoch"
+	rpcListNotaryRequests = "ListNotaryRequests"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -32,3 +38,135 @@ func HealthCheck(
 
 	return wResp.m, nil
 }
+
+// ListEvents executes ControlService.ListEvents RPC.
+func ListEvents(
+	cli *client.Client,
+	req *ListEventsRequest,
+	opts ...client.CallOption,
+) (*ListEventsResponse, error) {
+	wResp := &listEventsResponseWrapper{
+		m: new(ListEventsResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListEvents), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// ListProcessors executes ControlService.ListProcessors RPC.
+func ListProcessors(
+	cli *client.Client,
+	req *ListProcessorsRequest,
+	opts ...client.CallOption,
+) (*ListProcessorsResponse, error) {
+	wResp := &listProcessorsResponseWrapper{
+		m: new(ListProcessorsResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListProcessors), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// SetProcessorState executes ControlService.SetProcessorState RPC.
+func SetProcessorState(
+	cli *client.Client,
+	req *SetProcessorStateRequest,
+	opts ...client.CallOption,
+) (*SetProcessorStateResponse, error) {
+	wResp := &setProcessorStateResponseWrapper{
+		m: new(SetProcessorStateResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcSetProcessorState), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// NodeStatus executes ControlService.NodeStatus RPC.
+func NodeStatus(
+	cli *client.Client,
+	req *NodeStatusRequest,
+	opts ...client.CallOption,
+) (*NodeStatusResponse, error) {
+	wResp := &nodeStatusResponseWrapper{
+		m: new(NodeStatusResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcNodeStatus), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// TickEpoch executes ControlService.TickEpoch RPC.
+func TickEpoch(
+	cli *client.Client,
+	req *TickEpochRequest,
+	opts ...client.CallOption,
+) (*TickEpochResponse, error) {
+	wResp := &tickEpochResponseWrapper{
+		m: new(TickEpochResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcTickEpoch), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
+
+// ListNotaryRequests executes ControlService.ListNotaryRequests RPC.
+func ListNotaryRequests(
+	cli *client.Client,
+	req *ListNotaryRequestsRequest,
+	opts ...client.CallOption,
+) (*ListNotaryRequestsResponse, error) {
+	wResp := &listNotaryRequestsResponseWrapper{
+		m: new(ListNotaryRequestsResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcListNotaryRequests), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
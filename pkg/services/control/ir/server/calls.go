@@ -2,7 +2,10 @@ package control
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/eventindex"
 	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,3 +35,277 @@ func (s *Server) HealthCheck(_ context.Context, req *control.HealthCheckRequest)
 
 	return resp, nil
 }
+
+// ListEvents returns NeoFS contract events from the local event index.
+//
+// If request is not signed with a key from white list, permission error returns.
+// If event index is disabled, unavailable error returns.
+func (s *Server) ListEvents(_ context.Context, req *control.ListEventsRequest) (*control.ListEventsResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	if s.eventIndex == nil {
+		return nil, status.Error(codes.Unavailable, "event index is disabled")
+	}
+
+	reqBody := req.GetBody()
+
+	events, err := s.eventIndex.Select(eventindex.Filter{
+		Container: reqBody.GetContainerId(),
+		Owner:     reqBody.GetOwnerId(),
+		Node:      reqBody.GetNodeKey(),
+		Epoch:     reqBody.GetEpoch(),
+		Limit:     reqBody.GetLimit(),
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// create and fill response
+	resp := new(control.ListEventsResponse)
+
+	body := new(control.ListEventsResponse_Body)
+	resp.SetBody(body)
+
+	list := make([]*control.ContractEvent, 0, len(events))
+
+	for i := range events {
+		e := new(control.ContractEvent)
+
+		e.SetChain(events[i].Chain)
+		e.SetHeight(events[i].Height)
+		e.SetTxHash(events[i].TxHash.BytesBE())
+		e.SetContract(events[i].Contract.BytesBE())
+		e.SetType(events[i].Type)
+		e.SetEpoch(events[i].Epoch)
+		e.SetContainerID(events[i].Container)
+		e.SetOwnerID(events[i].Owner)
+		e.SetNodeKey(events[i].Node)
+		e.SetDetails(events[i].Details)
+
+		list = append(list, e)
+	}
+
+	body.SetEvents(list)
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// ListProcessors returns states of the event processors of the local IR node.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) ListProcessors(_ context.Context, req *control.ListProcessorsRequest) (*control.ListProcessorsResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	processors := s.prm.processors.ListProcessors()
+
+	// create and fill response
+	resp := new(control.ListProcessorsResponse)
+
+	body := new(control.ListProcessorsResponse_Body)
+	resp.SetBody(body)
+
+	list := make([]*control.ProcessorInfo, 0, len(processors))
+
+	for i := range processors {
+		p := new(control.ProcessorInfo)
+
+		p.SetName(processors[i].Name)
+		p.SetQueueLength(uint32(processors[i].Running))
+		p.SetCapacity(uint32(processors[i].Capacity))
+		p.SetPaused(processors[i].Paused)
+
+		list = append(list, p)
+	}
+
+	body.SetProcessors(list)
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// SetProcessorState pauses or resumes the event processors of the local IR node.
+//
+// If request is not signed with a key from white list, permission error returns.
+// If any of the processors is unknown, not found error returns. If any of the
+// processors can't be paused, failed precondition error returns. The state of
+// the processors is not changed in both cases.
+func (s *Server) SetProcessorState(_ context.Context, req *control.SetProcessorStateRequest) (*control.SetProcessorStateResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	reqBody := req.GetBody()
+	names := reqBody.GetNames()
+
+	if len(names) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing processor names")
+	}
+
+	pausable := make(map[string]bool)
+
+	for _, p := range s.prm.processors.ListProcessors() {
+		pausable[p.Name] = p.Pausable
+	}
+
+	for _, name := range names {
+		ok, known := pausable[name]
+		if !known {
+			return nil, status.Error(codes.NotFound, fmt.Sprintf("%v: %s", ErrUnknownProcessor, name))
+		} else if !ok {
+			return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("%v: %s", ErrNotPausableProcessor, name))
+		}
+	}
+
+	for _, name := range names {
+		err := s.prm.processors.SetProcessorPaused(name, reqBody.GetPaused())
+		if err != nil {
+			switch {
+			case errors.Is(err, ErrUnknownProcessor):
+				return nil, status.Error(codes.NotFound, err.Error())
+			case errors.Is(err, ErrNotPausableProcessor):
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
+
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	// create and fill response
+	resp := new(control.SetProcessorStateResponse)
+	resp.SetBody(new(control.SetProcessorStateResponse_Body))
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// NodeStatus returns epoch, inner ring position and notary settings
+// of the local IR node.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) NodeStatus(_ context.Context, req *control.NodeStatusRequest) (*control.NodeStatusResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	// create and fill response
+	resp := new(control.NodeStatusResponse)
+
+	body := new(control.NodeStatusResponse_Body)
+	resp.SetBody(body)
+
+	sideNotary, mainNotary := s.prm.nodeState.NotaryEnabled()
+
+	body.SetEpoch(s.prm.nodeState.EpochCounter())
+	body.SetInnerRingIndex(int32(s.prm.nodeState.InnerRingIndex()))
+	body.SetInnerRingSize(uint32(s.prm.nodeState.InnerRingSize()))
+	body.SetAlphabetIndex(int32(s.prm.nodeState.AlphabetIndex()))
+	body.SetSideNotary(sideNotary)
+	body.SetMainNotary(mainNotary)
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// TickEpoch requests the new epoch by the local IR node. Response contains
+// the number of the requested epoch, the epoch is changed once the request
+// is accepted by the side chain.
+//
+// If request is not signed with a key from white list, permission error returns.
+// If the node is not an alphabet one, failed precondition error returns.
+// If the request can't be sent, internal error returns.
+func (s *Server) TickEpoch(_ context.Context, req *control.TickEpochRequest) (*control.TickEpochResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	epoch, err := s.prm.nodeState.TickEpoch()
+	if err != nil {
+		if errors.Is(err, ErrNotAlphabet) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// create and fill response
+	resp := new(control.TickEpochResponse)
+
+	body := new(control.TickEpochResponse_Body)
+	resp.SetBody(body)
+
+	body.SetEpoch(epoch)
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// ListNotaryRequests returns notary requests received by the local IR node
+// which have not been completed yet.
+//
+// If request is not signed with a key from white list, permission error returns.
+func (s *Server) ListNotaryRequests(_ context.Context, req *control.ListNotaryRequestsRequest) (*control.ListNotaryRequestsResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	requests := s.prm.nodeState.PendingNotaryRequests()
+
+	// create and fill response
+	resp := new(control.ListNotaryRequestsResponse)
+
+	body := new(control.ListNotaryRequestsResponse_Body)
+	resp.SetBody(body)
+
+	list := make([]*control.NotaryRequest, 0, len(requests))
+
+	for i := range requests {
+		r := new(control.NotaryRequest)
+
+		r.SetMainTxHash(requests[i].MainTx.BytesBE())
+		r.SetContract(requests[i].Contract.BytesBE())
+		r.SetMethod(requests[i].Method)
+		r.SetValidUntilBlock(requests[i].ValidUntilBlock)
+
+		list = append(list, r)
+	}
+
+	body.SetRequests(list)
+
+	// sign the response
+	if err := SignMessage(&s.prm.key.PrivateKey, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -1,6 +1,10 @@
 package control
 
-import control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+import (
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/innerring/eventindex"
+	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+)
 
 // HealthChecker is component interface for calculating
 // the current health status of a node.
@@ -11,3 +15,99 @@ type HealthChecker interface {
 	// control.HealthStatus_HEALTH_STATUS_UNDEFINED should be returned.
 	HealthStatus() control.HealthStatus
 }
+
+// EventIndex is component interface for querying
+// NeoFS contract events indexed by the IR node.
+type EventIndex interface {
+	// Must return indexed events matching the filter
+	// in chronological order.
+	Select(eventindex.Filter) ([]eventindex.Event, error)
+}
+
+// ProcessorInfo groups information about the state
+// of the IR event processor.
+type ProcessorInfo struct {
+	// Name of the processor.
+	Name string
+
+	// Running is a number of the events being processed.
+	Running int
+
+	// Capacity is a maximum number of the events
+	// processed simultaneously.
+	Capacity int
+
+	// Pausable is set if the processor can be paused.
+	Pausable bool
+
+	// Paused is set if incoming events are dropped
+	// by the processor.
+	Paused bool
+}
+
+// Processors is component interface for managing
+// event processors of the IR node.
+type Processors interface {
+	// Must return states of all event processors
+	// of the IR node.
+	ListProcessors() []ProcessorInfo
+
+	// Must pause or resume event processing by the
+	// processor with the given name.
+	//
+	// Must return ErrUnknownProcessor if there is
+	// no processor with such name. Must return
+	// ErrNotPausableProcessor if the processor can't
+	// be paused.
+	SetProcessorPaused(name string, paused bool) error
+}
+
+// NotaryRequest groups information about the notary
+// request which has not been completed yet.
+type NotaryRequest struct {
+	// MainTx is a hash of the main transaction.
+	MainTx util.Uint256
+
+	// Contract is a script hash of the called contract.
+	Contract util.Uint160
+
+	// Method is a name of the called contract method.
+	Method string
+
+	// ValidUntilBlock is an index of the last block
+	// the main transaction is valid in.
+	ValidUntilBlock uint32
+}
+
+// NodeState is component interface for querying and
+// changing the state of the IR node.
+type NodeState interface {
+	// Must return current epoch number.
+	EpochCounter() uint64
+
+	// Must return index of the node in the inner ring list
+	// or negative value if node is not in the list.
+	InnerRingIndex() int
+
+	// Must return size of the inner ring list.
+	InnerRingSize() int
+
+	// Must return index of the node in the alphabet list
+	// or negative value if node is not in the list.
+	AlphabetIndex() int
+
+	// Must return flags of the enabled notary
+	// in the side and main chains.
+	NotaryEnabled() (side, main bool)
+
+	// Must request new epoch and return its number.
+	// Epoch is not changed until the request is
+	// accepted by the side chain.
+	//
+	// Must return ErrNotAlphabet if node is not an alphabet one.
+	TickEpoch() (uint64, error)
+
+	// Must return notary requests received by the node
+	// which have not been completed yet.
+	PendingNotaryRequests() []NotaryRequest
+}
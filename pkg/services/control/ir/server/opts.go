@@ -5,6 +5,8 @@ type Option func(*options)
 
 type options struct {
 	allowedKeys [][]byte
+
+	eventIndex EventIndex
 }
 
 func defaultOptions() *options {
@@ -18,3 +20,11 @@ func WithAllowedKeys(keys [][]byte) Option {
 		o.allowedKeys = append(o.allowedKeys, keys...)
 	}
 }
+
+// WithEventIndex returns option to serve contract
+// events from the provided index.
+func WithEventIndex(x EventIndex) Option {
+	return func(o *options) {
+		o.eventIndex = x
+	}
+}
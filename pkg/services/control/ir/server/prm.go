@@ -10,6 +10,10 @@ type Prm struct {
 	key keys.PrivateKey
 
 	healthChecker HealthChecker
+
+	processors Processors
+
+	nodeState NodeState
 }
 
 // SetPrivateKey sets private key to sign responses.
@@ -22,3 +26,15 @@ func (x *Prm) SetPrivateKey(key keys.PrivateKey) {
 func (x *Prm) SetHealthChecker(hc HealthChecker) {
 	x.healthChecker = hc
 }
+
+// SetProcessors sets Processors to manage
+// event processors.
+func (x *Prm) SetProcessors(p Processors) {
+	x.processors = p
+}
+
+// SetNodeState sets NodeState to query and
+// change the state of the node.
+func (x *Prm) SetNodeState(ns NodeState) {
+	x.nodeState = ns
+}
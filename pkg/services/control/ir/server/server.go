@@ -1,6 +1,7 @@
 package control
 
 import (
+	"errors"
 	"fmt"
 )
 
@@ -13,8 +14,22 @@ type Server struct {
 	prm Prm
 
 	allowedKeys [][]byte
+
+	eventIndex EventIndex
 }
 
+// ErrUnknownProcessor is returned by Processors
+// if there is no processor with the requested name.
+var ErrUnknownProcessor = errors.New("unknown processor")
+
+// ErrNotPausableProcessor is returned by Processors
+// if the requested processor can't be paused.
+var ErrNotPausableProcessor = errors.New("processor can't be paused")
+
+// ErrNotAlphabet is returned by NodeState if the operation
+// requires the node to be an alphabet one.
+var ErrNotAlphabet = errors.New("node is not an alphabet one")
+
 func panicOnPrmValue(n string, v interface{}) {
 	const invalidPrmValFmt = "invalid %s parameter (%T): %v"
 	panic(fmt.Sprintf(invalidPrmValFmt, n, v, v))
@@ -24,7 +39,9 @@ func panicOnPrmValue(n string, v interface{}) {
 //
 // Panics if:
 //  - parameterized private key is nil;
-//  - parameterized HealthChecker is nil.
+//  - parameterized HealthChecker is nil;
+//  - parameterized Processors is nil;
+//  - parameterized NodeState is nil.
 //
 // Forms white list from all keys specified via
 // WithAllowedKeys option and a public key of
@@ -34,6 +51,10 @@ func New(prm Prm, opts ...Option) *Server {
 	switch {
 	case prm.healthChecker == nil:
 		panicOnPrmValue("health checker", prm.healthChecker)
+	case prm.processors == nil:
+		panicOnPrmValue("processors", prm.processors)
+	case prm.nodeState == nil:
+		panicOnPrmValue("node state", prm.nodeState)
 	}
 
 	// compute optional parameters
@@ -47,5 +68,7 @@ func New(prm Prm, opts ...Option) *Server {
 		prm: prm,
 
 		allowedKeys: append(o.allowedKeys, prm.key.PublicKey().Bytes()),
+
+		eventIndex: o.eventIndex,
 	}
 }
@@ -0,0 +1,232 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neo-go/pkg/crypto/keys"
+	"github.com/nspcc-dev/neo-go/pkg/util"
+	control "github.com/nspcc-dev/neofs-node/pkg/services/control/ir"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testHealthChecker struct{}
+
+func (testHealthChecker) HealthStatus() control.HealthStatus {
+	return control.HealthStatus_READY
+}
+
+type testProcessors struct {
+	list []ProcessorInfo
+}
+
+func (x *testProcessors) ListProcessors() []ProcessorInfo {
+	return x.list
+}
+
+func (x *testProcessors) SetProcessorPaused(name string, paused bool) error {
+	for i := range x.list {
+		if x.list[i].Name == name {
+			if !x.list[i].Pausable {
+				return ErrNotPausableProcessor
+			}
+
+			x.list[i].Paused = paused
+
+			return nil
+		}
+	}
+
+	return ErrUnknownProcessor
+}
+
+type testNodeState struct {
+	epoch uint64
+
+	tickErr error
+
+	requests []NotaryRequest
+}
+
+func (x *testNodeState) EpochCounter() uint64 { return x.epoch }
+
+func (x *testNodeState) InnerRingIndex() int { return 1 }
+
+func (x *testNodeState) InnerRingSize() int { return 4 }
+
+func (x *testNodeState) AlphabetIndex() int { return -1 }
+
+func (x *testNodeState) NotaryEnabled() (bool, bool) { return true, false }
+
+func (x *testNodeState) TickEpoch() (uint64, error) {
+	if x.tickErr != nil {
+		return 0, x.tickErr
+	}
+
+	return x.epoch + 1, nil
+}
+
+func (x *testNodeState) PendingNotaryRequests() []NotaryRequest {
+	return x.requests
+}
+
+func newTestServer(t *testing.T, processors Processors, nodeState NodeState) (*Server, *keys.PrivateKey) {
+	key, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+
+	var prm Prm
+
+	prm.SetPrivateKey(*key)
+	prm.SetHealthChecker(testHealthChecker{})
+	prm.SetProcessors(processors)
+	prm.SetNodeState(nodeState)
+
+	return New(prm), key
+}
+
+func signRequest(t *testing.T, key *keys.PrivateKey, req SignedMessage) {
+	require.NoError(t, SignMessage(&key.PrivateKey, req))
+}
+
+func requireStatus(t *testing.T, code codes.Code, err error) {
+	st, ok := status.FromError(err)
+	require.True(t, ok, err)
+	require.Equal(t, code, st.Code(), err)
+}
+
+func TestServer_Processors(t *testing.T) {
+	processors := &testProcessors{
+		list: []ProcessorInfo{
+			{Name: "netmap", Running: 1, Capacity: 10},
+			{Name: "audit", Running: 2, Capacity: 5, Pausable: true},
+			{Name: "settlement", Capacity: 5, Pausable: true},
+		},
+	}
+
+	s, key := newTestServer(t, processors, new(testNodeState))
+
+	setState := func(paused bool, names ...string) error {
+		body := new(control.SetProcessorStateRequest_Body)
+		body.SetNames(names)
+		body.SetPaused(paused)
+
+		req := new(control.SetProcessorStateRequest)
+		req.SetBody(body)
+
+		signRequest(t, key, req)
+
+		_, err := s.SetProcessorState(context.Background(), req)
+
+		return err
+	}
+
+	t.Run("list", func(t *testing.T) {
+		req := new(control.ListProcessorsRequest)
+		req.SetBody(new(control.ListProcessorsRequest_Body))
+
+		_, err := s.ListProcessors(context.Background(), req)
+		requireStatus(t, codes.PermissionDenied, err)
+
+		signRequest(t, key, req)
+
+		resp, err := s.ListProcessors(context.Background(), req)
+		require.NoError(t, err)
+
+		list := resp.GetBody().GetProcessors()
+		require.Len(t, list, 3)
+		require.Equal(t, "audit", list[1].GetName())
+		require.EqualValues(t, 2, list[1].GetQueueLength())
+		require.EqualValues(t, 5, list[1].GetCapacity())
+		require.False(t, list[1].GetPaused())
+	})
+
+	t.Run("pause", func(t *testing.T) {
+		requireStatus(t, codes.InvalidArgument, setState(true))
+		requireStatus(t, codes.NotFound, setState(true, "audit", "unknown"))
+		requireStatus(t, codes.FailedPrecondition, setState(true, "audit", "netmap"))
+
+		// state is not changed on failures
+		for _, p := range processors.list {
+			require.False(t, p.Paused, p.Name)
+		}
+
+		require.NoError(t, setState(true, "audit", "settlement"))
+		require.True(t, processors.list[1].Paused)
+		require.True(t, processors.list[2].Paused)
+
+		require.NoError(t, setState(false, "settlement"))
+		require.True(t, processors.list[1].Paused)
+		require.False(t, processors.list[2].Paused)
+	})
+}
+
+func TestServer_NodeState(t *testing.T) {
+	nodeState := &testNodeState{
+		epoch: 10,
+		requests: []NotaryRequest{{
+			MainTx:          util.Uint256{1, 2, 3},
+			Contract:        util.Uint160{4, 5, 6},
+			Method:          "newEpoch",
+			ValidUntilBlock: 100,
+		}},
+	}
+
+	s, key := newTestServer(t, new(testProcessors), nodeState)
+
+	t.Run("status", func(t *testing.T) {
+		req := new(control.NodeStatusRequest)
+		req.SetBody(new(control.NodeStatusRequest_Body))
+
+		signRequest(t, key, req)
+
+		resp, err := s.NodeStatus(context.Background(), req)
+		require.NoError(t, err)
+
+		body := resp.GetBody()
+		require.EqualValues(t, 10, body.GetEpoch())
+		require.EqualValues(t, 1, body.GetInnerRingIndex())
+		require.EqualValues(t, 4, body.GetInnerRingSize())
+		require.EqualValues(t, -1, body.GetAlphabetIndex())
+		require.True(t, body.GetSideNotary())
+		require.False(t, body.GetMainNotary())
+	})
+
+	t.Run("tick epoch", func(t *testing.T) {
+		req := new(control.TickEpochRequest)
+		req.SetBody(new(control.TickEpochRequest_Body))
+
+		signRequest(t, key, req)
+
+		resp, err := s.TickEpoch(context.Background(), req)
+		require.NoError(t, err)
+		require.EqualValues(t, 11, resp.GetBody().GetEpoch())
+
+		nodeState.tickErr = ErrNotAlphabet
+		_, err = s.TickEpoch(context.Background(), req)
+		requireStatus(t, codes.FailedPrecondition, err)
+
+		nodeState.tickErr = errors.New("invocation failed")
+		_, err = s.TickEpoch(context.Background(), req)
+		requireStatus(t, codes.Internal, err)
+	})
+
+	t.Run("notary requests", func(t *testing.T) {
+		req := new(control.ListNotaryRequestsRequest)
+		req.SetBody(new(control.ListNotaryRequestsRequest_Body))
+
+		signRequest(t, key, req)
+
+		resp, err := s.ListNotaryRequests(context.Background(), req)
+		require.NoError(t, err)
+
+		list := resp.GetBody().GetRequests()
+		require.Len(t, list, 1)
+		require.Equal(t, nodeState.requests[0].MainTx.BytesBE(), list[0].GetMainTxHash())
+		require.Equal(t, nodeState.requests[0].Contract.BytesBE(), list[0].GetContract())
+		require.Equal(t, "newEpoch", list[0].GetMethod())
+		require.EqualValues(t, 100, list[0].GetValidUntilBlock())
+	})
+}
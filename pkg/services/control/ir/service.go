@@ -146,3 +146,1102 @@ func (x *HealthCheckResponse) ReadSignedData(buf []byte) ([]byte, error) {
 func (x *HealthCheckResponse) SignedDataSize() int {
 	return x.GetBody().StableSize()
 }
+
+// SetContainerID sets binary ID of the container to filter events.
+func (x *ListEventsRequest_Body) SetContainerID(v []byte) {
+	if x != nil {
+		x.ContainerId = v
+	}
+}
+
+// SetOwnerID sets binary ID of the NeoFS user to filter events.
+func (x *ListEventsRequest_Body) SetOwnerID(v []byte) {
+	if x != nil {
+		x.OwnerId = v
+	}
+}
+
+// SetNodeKey sets public key of the storage node to filter events.
+func (x *ListEventsRequest_Body) SetNodeKey(v []byte) {
+	if x != nil {
+		x.NodeKey = v
+	}
+}
+
+// SetEpoch sets NeoFS epoch to filter events.
+func (x *ListEventsRequest_Body) SetEpoch(v uint64) {
+	if x != nil {
+		x.Epoch = v
+	}
+}
+
+// SetLimit sets maximum number of the latest events to return.
+func (x *ListEventsRequest_Body) SetLimit(v uint32) {
+	if x != nil {
+		x.Limit = v
+	}
+}
+
+const (
+	_ = iota
+	listEventsReqBodyContainerIDFNum
+	listEventsReqBodyOwnerIDFNum
+	listEventsReqBodyNodeKeyFNum
+	listEventsReqBodyEpochFNum
+	listEventsReqBodyLimitFNum
+)
+
+// StableMarshal reads binary representation of list events request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *ListEventsRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.BytesMarshal(listEventsReqBodyContainerIDFNum, buf[offset:], x.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BytesMarshal(listEventsReqBodyOwnerIDFNum, buf[offset:], x.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BytesMarshal(listEventsReqBodyNodeKeyFNum, buf[offset:], x.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(listEventsReqBodyEpochFNum, buf[offset:], x.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt32Marshal(listEventsReqBodyLimitFNum, buf[offset:], x.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of list events request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *ListEventsRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.BytesSize(listEventsReqBodyContainerIDFNum, x.ContainerId)
+	size += proto.BytesSize(listEventsReqBodyOwnerIDFNum, x.OwnerId)
+	size += proto.BytesSize(listEventsReqBodyNodeKeyFNum, x.NodeKey)
+	size += proto.UInt64Size(listEventsReqBodyEpochFNum, x.Epoch)
+	size += proto.UInt32Size(listEventsReqBodyLimitFNum, x.Limit)
+
+	return size
+}
+
+// SetBody sets list events request body.
+func (x *ListEventsRequest) SetBody(v *ListEventsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the list events request body.
+func (x *ListEventsRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of list events request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *ListEventsRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of list events request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *ListEventsRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetEvents sets list of the indexed contract events.
+func (x *ListEventsResponse_Body) SetEvents(v []*ContractEvent) {
+	if x != nil {
+		x.Events = v
+	}
+}
+
+const (
+	_ = iota
+	listEventsRespBodyEventsFNum
+)
+
+// StableMarshal reads binary representation of list events response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *ListEventsResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	for i := range x.Events {
+		n, err = proto.NestedStructureMarshal(listEventsRespBodyEventsFNum, buf[offset:], x.Events[i])
+		if err != nil {
+			return nil, err
+		}
+
+		offset += n
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of list events response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *ListEventsResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	for i := range x.Events {
+		size += proto.NestedStructureSize(listEventsRespBodyEventsFNum, x.Events[i])
+	}
+
+	return size
+}
+
+// SetBody sets list events response body.
+func (x *ListEventsResponse) SetBody(v *ListEventsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the list events response body.
+func (x *ListEventsResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of list events response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *ListEventsResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of list events response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *ListEventsResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// StableMarshal reads binary representation of list processors request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *ListProcessorsRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	return buf, nil
+}
+
+// StableSize returns binary size of list processors request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *ListProcessorsRequest_Body) StableSize() int {
+	return 0
+}
+
+// SetBody sets list processors request body.
+func (x *ListProcessorsRequest) SetBody(v *ListProcessorsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the list processors request body.
+func (x *ListProcessorsRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of list processors request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *ListProcessorsRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of list processors request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *ListProcessorsRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetProcessors sets list of the event processors.
+func (x *ListProcessorsResponse_Body) SetProcessors(v []*ProcessorInfo) {
+	if x != nil {
+		x.Processors = v
+	}
+}
+
+const (
+	_ = iota
+	listProcessorsRespBodyProcessorsFNum
+)
+
+// StableMarshal reads binary representation of list processors response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *ListProcessorsResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	for i := range x.Processors {
+		n, err = proto.NestedStructureMarshal(listProcessorsRespBodyProcessorsFNum, buf[offset:], x.Processors[i])
+		if err != nil {
+			return nil, err
+		}
+
+		offset += n
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of list processors response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *ListProcessorsResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	for i := range x.Processors {
+		size += proto.NestedStructureSize(listProcessorsRespBodyProcessorsFNum, x.Processors[i])
+	}
+
+	return size
+}
+
+// SetBody sets list processors response body.
+func (x *ListProcessorsResponse) SetBody(v *ListProcessorsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the list processors response body.
+func (x *ListProcessorsResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of list processors response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *ListProcessorsResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of list processors response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *ListProcessorsResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetNames sets names of the event processors to change state of.
+func (x *SetProcessorStateRequest_Body) SetNames(v []string) {
+	if x != nil {
+		x.Names = v
+	}
+}
+
+// SetPaused sets flag to pause or resume the processors.
+func (x *SetProcessorStateRequest_Body) SetPaused(v bool) {
+	if x != nil {
+		x.Paused = v
+	}
+}
+
+const (
+	_ = iota
+	setProcessorStateReqBodyNamesFNum
+	setProcessorStateReqBodyPausedFNum
+)
+
+// StableMarshal reads binary representation of set processor state request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *SetProcessorStateRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.RepeatedStringMarshal(setProcessorStateReqBodyNamesFNum, buf[offset:], x.Names)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.BoolMarshal(setProcessorStateReqBodyPausedFNum, buf[offset:], x.Paused)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of set processor state request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *SetProcessorStateRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.RepeatedStringSize(setProcessorStateReqBodyNamesFNum, x.Names)
+	size += proto.BoolSize(setProcessorStateReqBodyPausedFNum, x.Paused)
+
+	return size
+}
+
+// SetBody sets set processor state request body.
+func (x *SetProcessorStateRequest) SetBody(v *SetProcessorStateRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the set processor state request body.
+func (x *SetProcessorStateRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of set processor state request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *SetProcessorStateRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of set processor state request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *SetProcessorStateRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// StableMarshal reads binary representation of set processor state response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *SetProcessorStateResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	return buf, nil
+}
+
+// StableSize returns binary size of set processor state response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *SetProcessorStateResponse_Body) StableSize() int {
+	return 0
+}
+
+// SetBody sets set processor state response body.
+func (x *SetProcessorStateResponse) SetBody(v *SetProcessorStateResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the set processor state response body.
+func (x *SetProcessorStateResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of set processor state response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *SetProcessorStateResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of set processor state response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *SetProcessorStateResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// StableMarshal reads binary representation of node status request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *NodeStatusRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	return buf, nil
+}
+
+// StableSize returns binary size of node status request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *NodeStatusRequest_Body) StableSize() int {
+	return 0
+}
+
+// SetBody sets node status request body.
+func (x *NodeStatusRequest) SetBody(v *NodeStatusRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the node status request body.
+func (x *NodeStatusRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of node status request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *NodeStatusRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of node status request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *NodeStatusRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetEpoch sets current NeoFS epoch known by the node.
+func (x *NodeStatusResponse_Body) SetEpoch(v uint64) {
+	if x != nil {
+		x.Epoch = v
+	}
+}
+
+// SetInnerRingIndex sets index of the node in the inner ring list.
+func (x *NodeStatusResponse_Body) SetInnerRingIndex(v int32) {
+	if x != nil {
+		x.InnerRingIndex = v
+	}
+}
+
+// SetInnerRingSize sets size of the inner ring list.
+func (x *NodeStatusResponse_Body) SetInnerRingSize(v uint32) {
+	if x != nil {
+		x.InnerRingSize = v
+	}
+}
+
+// SetAlphabetIndex sets index of the node in the alphabet list.
+func (x *NodeStatusResponse_Body) SetAlphabetIndex(v int32) {
+	if x != nil {
+		x.AlphabetIndex = v
+	}
+}
+
+// SetSideNotary sets flag of the enabled notary in the side chain.
+func (x *NodeStatusResponse_Body) SetSideNotary(v bool) {
+	if x != nil {
+		x.SideNotary = v
+	}
+}
+
+// SetMainNotary sets flag of the enabled notary in the main chain.
+func (x *NodeStatusResponse_Body) SetMainNotary(v bool) {
+	if x != nil {
+		x.MainNotary = v
+	}
+}
+
+const (
+	_ = iota
+	nodeStatusRespBodyEpochFNum
+	nodeStatusRespBodyInnerRingIndexFNum
+	nodeStatusRespBodyInnerRingSizeFNum
+	nodeStatusRespBodyAlphabetIndexFNum
+	nodeStatusRespBodySideNotaryFNum
+	nodeStatusRespBodyMainNotaryFNum
+)
+
+// StableMarshal reads binary representation of node status response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *NodeStatusResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.UInt64Marshal(nodeStatusRespBodyEpochFNum, buf[offset:], x.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.EnumMarshal(nodeStatusRespBodyInnerRingIndexFNum, buf[offset:], x.InnerRingIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt32Marshal(nodeStatusRespBodyInnerRingSizeFNum, buf[offset:], x.InnerRingSize)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.EnumMarshal(nodeStatusRespBodyAlphabetIndexFNum, buf[offset:], x.AlphabetIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BoolMarshal(nodeStatusRespBodySideNotaryFNum, buf[offset:], x.SideNotary)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.BoolMarshal(nodeStatusRespBodyMainNotaryFNum, buf[offset:], x.MainNotary)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of node status response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *NodeStatusResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.UInt64Size(nodeStatusRespBodyEpochFNum, x.Epoch)
+	size += proto.EnumSize(nodeStatusRespBodyInnerRingIndexFNum, x.InnerRingIndex)
+	size += proto.UInt32Size(nodeStatusRespBodyInnerRingSizeFNum, x.InnerRingSize)
+	size += proto.EnumSize(nodeStatusRespBodyAlphabetIndexFNum, x.AlphabetIndex)
+	size += proto.BoolSize(nodeStatusRespBodySideNotaryFNum, x.SideNotary)
+	size += proto.BoolSize(nodeStatusRespBodyMainNotaryFNum, x.MainNotary)
+
+	return size
+}
+
+// SetBody sets node status response body.
+func (x *NodeStatusResponse) SetBody(v *NodeStatusResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the node status response body.
+func (x *NodeStatusResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of node status response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *NodeStatusResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of node status response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *NodeStatusResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// StableMarshal reads binary representation of tick epoch request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *TickEpochRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	return buf, nil
+}
+
+// StableSize returns binary size of tick epoch request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *TickEpochRequest_Body) StableSize() int {
+	return 0
+}
+
+// SetBody sets tick epoch request body.
+func (x *TickEpochRequest) SetBody(v *TickEpochRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the tick epoch request body.
+func (x *TickEpochRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of tick epoch request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *TickEpochRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of tick epoch request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *TickEpochRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetEpoch sets number of the requested epoch.
+func (x *TickEpochResponse_Body) SetEpoch(v uint64) {
+	if x != nil {
+		x.Epoch = v
+	}
+}
+
+const (
+	_ = iota
+	tickEpochRespBodyEpochFNum
+)
+
+// StableMarshal reads binary representation of tick epoch response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *TickEpochResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	_, err := proto.UInt64Marshal(tickEpochRespBodyEpochFNum, buf, x.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of tick epoch response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *TickEpochResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.UInt64Size(tickEpochRespBodyEpochFNum, x.Epoch)
+
+	return size
+}
+
+// SetBody sets tick epoch response body.
+func (x *TickEpochResponse) SetBody(v *TickEpochResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the tick epoch response body.
+func (x *TickEpochResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of tick epoch response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *TickEpochResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of tick epoch response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *TickEpochResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// StableMarshal reads binary representation of list notary requests request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *ListNotaryRequestsRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	return buf, nil
+}
+
+// StableSize returns binary size of list notary requests request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *ListNotaryRequestsRequest_Body) StableSize() int {
+	return 0
+}
+
+// SetBody sets list notary requests request body.
+func (x *ListNotaryRequestsRequest) SetBody(v *ListNotaryRequestsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the list notary requests request body.
+func (x *ListNotaryRequestsRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of list notary requests request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *ListNotaryRequestsRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of list notary requests request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *ListNotaryRequestsRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetRequests sets list of the pending notary requests.
+func (x *ListNotaryRequestsResponse_Body) SetRequests(v []*NotaryRequest) {
+	if x != nil {
+		x.Requests = v
+	}
+}
+
+const (
+	_ = iota
+	listNotaryRequestsRespBodyRequestsFNum
+)
+
+// StableMarshal reads binary representation of list notary requests response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *ListNotaryRequestsResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	for i := range x.Requests {
+		n, err = proto.NestedStructureMarshal(listNotaryRequestsRespBodyRequestsFNum, buf[offset:], x.Requests[i])
+		if err != nil {
+			return nil, err
+		}
+
+		offset += n
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of list notary requests response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *ListNotaryRequestsResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	for i := range x.Requests {
+		size += proto.NestedStructureSize(listNotaryRequestsRespBodyRequestsFNum, x.Requests[i])
+	}
+
+	return size
+}
+
+// SetBody sets list notary requests response body.
+func (x *ListNotaryRequestsResponse) SetBody(v *ListNotaryRequestsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the list notary requests response body.
+func (x *ListNotaryRequestsResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of list notary requests response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *ListNotaryRequestsResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of list notary requests response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *ListNotaryRequestsResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
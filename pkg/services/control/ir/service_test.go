@@ -45,3 +45,149 @@ func generateHealthCheckResponseBody() *control.HealthCheckResponse_Body {
 func equalHealthCheckResponseBodies(b1, b2 *control.HealthCheckResponse_Body) bool {
 	return b1.GetHealthStatus() == b2.GetHealthStatus()
 }
+
+func TestListEventsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateListEventsResponseBody(),
+		new(control.ListEventsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalListEventsResponseBodies(
+				m1.(*control.ListEventsResponse_Body),
+				m2.(*control.ListEventsResponse_Body),
+			)
+		},
+	)
+}
+
+func generateListEventsResponseBody() *control.ListEventsResponse_Body {
+	body := new(control.ListEventsResponse_Body)
+
+	e1 := new(control.ContractEvent)
+	e1.SetChain("side")
+	e1.SetHeight(10)
+	e1.SetTxHash([]byte{1, 2, 3})
+	e1.SetContract([]byte{4, 5, 6})
+	e1.SetType("containerPut")
+	e1.SetEpoch(100)
+	e1.SetContainerID([]byte{7, 8, 9})
+	e1.SetOwnerID([]byte{10, 11, 12})
+
+	e2 := new(control.ContractEvent)
+	e2.SetChain("side")
+	e2.SetHeight(11)
+	e2.SetType("UpdateState")
+	e2.SetNodeKey([]byte{13, 14, 15})
+	e2.SetDetails("state=offline")
+
+	body.SetEvents([]*control.ContractEvent{e1, e2})
+
+	return body
+}
+
+func equalListEventsResponseBodies(b1, b2 *control.ListEventsResponse_Body) bool {
+	if len(b1.Events) != len(b2.Events) {
+		return false
+	}
+
+	for i := range b1.Events {
+		if !proto.Equal(b1.Events[i], b2.Events[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestListProcessorsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateListProcessorsResponseBody(),
+		new(control.ListProcessorsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalListProcessorsResponseBodies(
+				m1.(*control.ListProcessorsResponse_Body),
+				m2.(*control.ListProcessorsResponse_Body),
+			)
+		},
+	)
+}
+
+func generateListProcessorsResponseBody() *control.ListProcessorsResponse_Body {
+	body := new(control.ListProcessorsResponse_Body)
+
+	p1 := new(control.ProcessorInfo)
+	p1.SetName("netmap")
+	p1.SetQueueLength(3)
+	p1.SetCapacity(10)
+
+	p2 := new(control.ProcessorInfo)
+	p2.SetName("container")
+	p2.SetCapacity(10)
+	p2.SetPaused(true)
+
+	body.SetProcessors([]*control.ProcessorInfo{p1, p2})
+
+	return body
+}
+
+func equalListProcessorsResponseBodies(b1, b2 *control.ListProcessorsResponse_Body) bool {
+	if len(b1.Processors) != len(b2.Processors) {
+		return false
+	}
+
+	for i := range b1.Processors {
+		if !proto.Equal(b1.Processors[i], b2.Processors[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestNodeStatusResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateNodeStatusResponseBody(),
+		new(control.NodeStatusResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func generateNodeStatusResponseBody() *control.NodeStatusResponse_Body {
+	body := new(control.NodeStatusResponse_Body)
+	body.SetEpoch(100)
+	body.SetInnerRingIndex(2)
+	body.SetInnerRingSize(7)
+	body.SetAlphabetIndex(-1)
+	body.SetSideNotary(true)
+
+	return body
+}
+
+func TestListNotaryRequestsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateListNotaryRequestsResponseBody(),
+		new(control.ListNotaryRequestsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			return proto.Equal(m1, m2)
+		},
+	)
+}
+
+func generateListNotaryRequestsResponseBody() *control.ListNotaryRequestsResponse_Body {
+	body := new(control.ListNotaryRequestsResponse_Body)
+
+	r1 := new(control.NotaryRequest)
+	r1.SetMainTxHash([]byte{1, 2, 3})
+	r1.SetContract([]byte{4, 5, 6})
+	r1.SetMethod("addPeer")
+	r1.SetValidUntilBlock(1000)
+
+	r2 := new(control.NotaryRequest)
+	r2.SetMainTxHash([]byte{7, 8, 9})
+	r2.SetMethod("put")
+
+	body.SetRequests([]*control.NotaryRequest{r1, r2})
+
+	return body
+}
@@ -1,5 +1,9 @@
 package control
 
+import (
+	"github.com/nspcc-dev/neofs-api-go/v2/util/proto"
+)
+
 // SetKey sets public key used for signing.
 func (x *Signature) SetKey(v []byte) {
 	if x != nil {
@@ -13,3 +17,420 @@ func (x *Signature) SetSign(v []byte) {
 		x.Sign = v
 	}
 }
+
+// SetChain sets name of the chain the notification was produced in.
+func (x *ContractEvent) SetChain(v string) {
+	if x != nil {
+		x.Chain = v
+	}
+}
+
+// SetHeight sets index of the block with the notification.
+func (x *ContractEvent) SetHeight(v uint32) {
+	if x != nil {
+		x.Height = v
+	}
+}
+
+// SetTxHash sets hash of the transaction with the notification.
+func (x *ContractEvent) SetTxHash(v []byte) {
+	if x != nil {
+		x.TxHash = v
+	}
+}
+
+// SetContract sets script hash of the contract emitted the notification.
+func (x *ContractEvent) SetContract(v []byte) {
+	if x != nil {
+		x.Contract = v
+	}
+}
+
+// SetType sets notification name.
+func (x *ContractEvent) SetType(v string) {
+	if x != nil {
+		x.Type = v
+	}
+}
+
+// SetEpoch sets NeoFS epoch at the moment of notification.
+func (x *ContractEvent) SetEpoch(v uint64) {
+	if x != nil {
+		x.Epoch = v
+	}
+}
+
+// SetContainerID sets binary ID of the related container.
+func (x *ContractEvent) SetContainerID(v []byte) {
+	if x != nil {
+		x.ContainerId = v
+	}
+}
+
+// SetOwnerID sets binary ID of the related NeoFS user.
+func (x *ContractEvent) SetOwnerID(v []byte) {
+	if x != nil {
+		x.OwnerId = v
+	}
+}
+
+// SetNodeKey sets public key of the related storage node.
+func (x *ContractEvent) SetNodeKey(v []byte) {
+	if x != nil {
+		x.NodeKey = v
+	}
+}
+
+// SetDetails sets human-readable description of the event parameters.
+func (x *ContractEvent) SetDetails(v string) {
+	if x != nil {
+		x.Details = v
+	}
+}
+
+const (
+	_ = iota
+	contractEventChainFNum
+	contractEventHeightFNum
+	contractEventTxHashFNum
+	contractEventContractFNum
+	contractEventTypeFNum
+	contractEventEpochFNum
+	contractEventContainerIDFNum
+	contractEventOwnerIDFNum
+	contractEventNodeKeyFNum
+	contractEventDetailsFNum
+)
+
+// StableMarshal reads binary representation of contract event
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *ContractEvent) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.StringMarshal(contractEventChainFNum, buf[offset:], x.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt32Marshal(contractEventHeightFNum, buf[offset:], x.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BytesMarshal(contractEventTxHashFNum, buf[offset:], x.TxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BytesMarshal(contractEventContractFNum, buf[offset:], x.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.StringMarshal(contractEventTypeFNum, buf[offset:], x.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(contractEventEpochFNum, buf[offset:], x.Epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BytesMarshal(contractEventContainerIDFNum, buf[offset:], x.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BytesMarshal(contractEventOwnerIDFNum, buf[offset:], x.OwnerId)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BytesMarshal(contractEventNodeKeyFNum, buf[offset:], x.NodeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.StringMarshal(contractEventDetailsFNum, buf[offset:], x.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of contract event
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *ContractEvent) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.StringSize(contractEventChainFNum, x.Chain)
+	size += proto.UInt32Size(contractEventHeightFNum, x.Height)
+	size += proto.BytesSize(contractEventTxHashFNum, x.TxHash)
+	size += proto.BytesSize(contractEventContractFNum, x.Contract)
+	size += proto.StringSize(contractEventTypeFNum, x.Type)
+	size += proto.UInt64Size(contractEventEpochFNum, x.Epoch)
+	size += proto.BytesSize(contractEventContainerIDFNum, x.ContainerId)
+	size += proto.BytesSize(contractEventOwnerIDFNum, x.OwnerId)
+	size += proto.BytesSize(contractEventNodeKeyFNum, x.NodeKey)
+	size += proto.StringSize(contractEventDetailsFNum, x.Details)
+
+	return size
+}
+
+// SetName sets name of the processor.
+func (x *ProcessorInfo) SetName(v string) {
+	if x != nil {
+		x.Name = v
+	}
+}
+
+// SetQueueLength sets number of the events being processed.
+func (x *ProcessorInfo) SetQueueLength(v uint32) {
+	if x != nil {
+		x.QueueLength = v
+	}
+}
+
+// SetCapacity sets maximum number of the events processed simultaneously.
+func (x *ProcessorInfo) SetCapacity(v uint32) {
+	if x != nil {
+		x.Capacity = v
+	}
+}
+
+// SetPaused sets flag of the paused processor.
+func (x *ProcessorInfo) SetPaused(v bool) {
+	if x != nil {
+		x.Paused = v
+	}
+}
+
+const (
+	_ = iota
+	processorInfoNameFNum
+	processorInfoQueueLengthFNum
+	processorInfoCapacityFNum
+	processorInfoPausedFNum
+)
+
+// StableMarshal reads binary representation of processor info
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *ProcessorInfo) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.StringMarshal(processorInfoNameFNum, buf[offset:], x.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt32Marshal(processorInfoQueueLengthFNum, buf[offset:], x.QueueLength)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt32Marshal(processorInfoCapacityFNum, buf[offset:], x.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.BoolMarshal(processorInfoPausedFNum, buf[offset:], x.Paused)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of processor info
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *ProcessorInfo) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.StringSize(processorInfoNameFNum, x.Name)
+	size += proto.UInt32Size(processorInfoQueueLengthFNum, x.QueueLength)
+	size += proto.UInt32Size(processorInfoCapacityFNum, x.Capacity)
+	size += proto.BoolSize(processorInfoPausedFNum, x.Paused)
+
+	return size
+}
+
+// SetMainTxHash sets hash of the main transaction.
+func (x *NotaryRequest) SetMainTxHash(v []byte) {
+	if x != nil {
+		x.MainTxHash = v
+	}
+}
+
+// SetContract sets script hash of the called contract.
+func (x *NotaryRequest) SetContract(v []byte) {
+	if x != nil {
+		x.Contract = v
+	}
+}
+
+// SetMethod sets name of the called contract method.
+func (x *NotaryRequest) SetMethod(v string) {
+	if x != nil {
+		x.Method = v
+	}
+}
+
+// SetValidUntilBlock sets index of the last block the main transaction is valid in.
+func (x *NotaryRequest) SetValidUntilBlock(v uint32) {
+	if x != nil {
+		x.ValidUntilBlock = v
+	}
+}
+
+const (
+	_ = iota
+	notaryRequestMainTxHashFNum
+	notaryRequestContractFNum
+	notaryRequestMethodFNum
+	notaryRequestValidUntilBlockFNum
+)
+
+// StableMarshal reads binary representation of notary request
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *NotaryRequest) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.BytesMarshal(notaryRequestMainTxHashFNum, buf[offset:], x.MainTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BytesMarshal(notaryRequestContractFNum, buf[offset:], x.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.StringMarshal(notaryRequestMethodFNum, buf[offset:], x.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt32Marshal(notaryRequestValidUntilBlockFNum, buf[offset:], x.ValidUntilBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of notary request
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *NotaryRequest) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.BytesSize(notaryRequestMainTxHashFNum, x.MainTxHash)
+	size += proto.BytesSize(notaryRequestContractFNum, x.Contract)
+	size += proto.StringSize(notaryRequestMethodFNum, x.Method)
+	size += proto.UInt32Size(notaryRequestValidUntilBlockFNum, x.ValidUntilBlock)
+
+	return size
+}
@@ -16,6 +16,10 @@ const (
 	rpcSetShardMode    = "SetShardMode"
 	rpcDumpShard       = "DumpShard"
 	rpcRestoreShard    = "RestoreShard"
+	rpcDiagnostics     = "Diagnostics"
+
+	rpcDumpShardStream    = "DumpShardStream"
+	rpcRestoreShardStream = "RestoreShardStream"
 )
 
 // HealthCheck executes ControlService.HealthCheck RPC.
@@ -172,3 +176,86 @@ func RestoreShard(cli *client.Client, req *RestoreShardRequest, opts ...client.C
 
 	return wResp.RestoreShardResponse, nil
 }
+
+// DumpShardStreamReader reads the dump streamed by the server.
+type DumpShardStreamReader struct {
+	r client.MessageReader
+}
+
+// Read reads next message of the stream. Returns io.EOF
+// after the last message.
+func (x *DumpShardStreamReader) Read() (*DumpShardStreamResponse, error) {
+	wResp := &dumpShardStreamResponseWrapper{new(DumpShardStreamResponse)}
+
+	if err := x.r.ReadMessage(wResp); err != nil {
+		return nil, err
+	}
+
+	return wResp.DumpShardStreamResponse, nil
+}
+
+// DumpShardStream executes ControlService.DumpShardStream RPC.
+func DumpShardStream(cli *client.Client, req *DumpShardRequest, opts ...client.CallOption) (*DumpShardStreamReader, error) {
+	wReq := &requestWrapper{m: req}
+
+	r, err := client.OpenServerStream(cli, common.CallMethodInfoServerStream(serviceName, rpcDumpShardStream), wReq, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DumpShardStreamReader{r: r}, nil
+}
+
+// RestoreShardStreamWriter writes the dump to the server.
+type RestoreShardStreamWriter struct {
+	w client.MessageWriterCloser
+
+	resp *restoreShardResponseWrapper
+}
+
+// Write sends next message of the stream.
+func (x *RestoreShardStreamWriter) Write(req *RestoreShardStreamRequest) error {
+	return x.w.WriteMessage(&requestWrapper{m: req})
+}
+
+// Close finishes the stream and returns the server response.
+func (x *RestoreShardStreamWriter) Close() (*RestoreShardResponse, error) {
+	if err := x.w.Close(); err != nil {
+		return nil, err
+	}
+
+	return x.resp.RestoreShardResponse, nil
+}
+
+// RestoreShardStream executes ControlService.RestoreShardStream RPC.
+func RestoreShardStream(cli *client.Client, opts ...client.CallOption) (*RestoreShardStreamWriter, error) {
+	wResp := &restoreShardResponseWrapper{new(RestoreShardResponse)}
+
+	w, err := client.OpenClientStream(cli, common.CallMethodInfoClientStream(serviceName, rpcRestoreShardStream), wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RestoreShardStreamWriter{w: w, resp: wResp}, nil
+}
+
+// Diagnostics executes ControlService.Diagnostics RPC.
+func Diagnostics(
+	cli *client.Client,
+	req *DiagnosticsRequest,
+	opts ...client.CallOption,
+) (*DiagnosticsResponse, error) {
+	wResp := &diagnosticsResponseWrapper{
+		m: new(DiagnosticsResponse),
+	}
+
+	wReq := &requestWrapper{
+		m: req,
+	}
+	err := client.SendUnary(cli, common.CallMethodInfoUnary(serviceName, rpcDiagnostics), wReq, wResp, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return wResp.m, nil
+}
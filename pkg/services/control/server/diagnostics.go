@@ -0,0 +1,75 @@
+package control
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
+	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// diagnosticsShardCategory is a category of the shard probe checks.
+const diagnosticsShardCategory = "shard"
+
+// Diagnostics performs self-test of the storage node.
+//
+// Each shard of the local storage is probed with a test object.
+// Other checks are performed by the component set via WithDiagnostics
+// option.
+//
+// If request is unsigned or signed by disallowed key, permission error returns.
+func (s *Server) Diagnostics(ctx context.Context, req *control.DiagnosticsRequest) (*control.DiagnosticsResponse, error) {
+	// verify request
+	if err := s.isValidRequest(req); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	info := s.s.DumpInfo()
+
+	checks := make([]*control.DiagnosticCheck, 0, len(info.Shards))
+
+	for _, sh := range info.Shards {
+		c := new(control.DiagnosticCheck)
+		c.SetCategory(diagnosticsShardCategory)
+		c.SetSubject(sh.ID.String())
+
+		start := time.Now()
+		err := s.s.ProbeShard(sh.ID)
+		c.SetDuration(uint64(time.Since(start).Milliseconds()))
+
+		switch {
+		case err == nil:
+			c.SetStatus(control.DiagnosticStatus_PASSED)
+		case errors.Is(err, shard.ErrReadOnlyMode):
+			c.SetStatus(control.DiagnosticStatus_WARNING)
+			c.SetDetails("shard is in read-only mode, write check skipped")
+		default:
+			c.SetStatus(control.DiagnosticStatus_FAILED)
+			c.SetDetails(err.Error())
+		}
+
+		checks = append(checks, c)
+	}
+
+	if s.diagnostics != nil {
+		checks = append(checks, s.diagnostics.Diagnose(ctx)...)
+	}
+
+	// create and fill response
+	resp := new(control.DiagnosticsResponse)
+
+	body := new(control.DiagnosticsResponse_Body)
+	resp.SetBody(body)
+
+	body.SetChecks(checks)
+
+	// sign the response
+	if err := SignMessage(s.key, resp); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
@@ -2,13 +2,21 @@ package control
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/shard"
 	"github.com/nspcc-dev/neofs-node/pkg/services/control"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// dumpStreamChunkSize is a maximum size of the dump portion
+// in the single DumpShardStream message.
+const dumpStreamChunkSize = 1 << 20
+
 func (s *Server) DumpShard(_ context.Context, req *control.DumpShardRequest) (*control.DumpShardResponse, error) {
 	err := s.isValidRequest(req)
 	if err != nil {
@@ -17,17 +25,24 @@ func (s *Server) DumpShard(_ context.Context, req *control.DumpShardRequest) (*c
 
 	shardID := shard.NewIDFromBytes(req.GetBody().GetShard_ID())
 
-	prm := new(shard.DumpPrm)
+	prm, err := dumpPrmFromRequest(req.GetBody())
+	if err != nil {
+		return nil, err
+	}
+
 	prm.WithPath(req.GetBody().GetFilepath())
-	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
 
-	err = s.s.DumpShard(shardID, prm)
+	res, err := s.s.DumpShard(shardID, prm)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	body := new(control.DumpShardResponse_Body)
+	body.SetCount(uint32(res.Count()))
+	body.SetWatermark(res.Watermark())
+
 	resp := new(control.DumpShardResponse)
-	resp.SetBody(new(control.DumpShardResponse_Body))
+	resp.SetBody(body)
 
 	err = SignMessage(s.key, resp)
 	if err != nil {
@@ -35,3 +50,129 @@ func (s *Server) DumpShard(_ context.Context, req *control.DumpShardRequest) (*c
 	}
 	return resp, nil
 }
+
+func (s *Server) DumpShardStream(req *control.DumpShardRequest, srv control.ControlService_DumpShardStreamServer) error {
+	err := s.isValidRequest(req)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	shardID := shard.NewIDFromBytes(req.GetBody().GetShard_ID())
+
+	prm, err := dumpPrmFromRequest(req.GetBody())
+	if err != nil {
+		return err
+	}
+
+	w := &dumpStreamWriter{
+		srv: srv,
+		key: s.key,
+		buf: make([]byte, 0, dumpStreamChunkSize),
+	}
+
+	prm.WithStream(w)
+
+	res, err := s.s.DumpShard(shardID, prm)
+	if err == nil {
+		err = w.flush()
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	body := new(control.DumpShardStreamResponse_Body)
+	body.SetCount(uint32(res.Count()))
+	body.SetWatermark(res.Watermark())
+
+	if err := w.send(body); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func dumpPrmFromRequest(body *control.DumpShardRequest_Body) (*shard.DumpPrm, error) {
+	prm := new(shard.DumpPrm)
+	prm.WithIgnoreErrors(body.GetIgnoreErrors())
+	prm.WithEpochRange(body.GetFromEpoch(), body.GetToEpoch())
+	prm.WithCompression(body.GetCompress())
+
+	if rawIDs := body.GetContainerId(); len(rawIDs) != 0 {
+		ids := make([]*cid.ID, 0, len(rawIDs))
+
+		for i := range rawIDs {
+			if len(rawIDs[i]) != sha256.Size {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid container ID #%d length %d", i, len(rawIDs[i])))
+			}
+
+			var cs [sha256.Size]byte
+			copy(cs[:], rawIDs[i])
+
+			id := cid.New()
+			id.SetSHA256(cs)
+
+			ids = append(ids, id)
+		}
+
+		prm.WithContainers(ids...)
+	}
+
+	if body.GetIncremental() {
+		prm.WithIncremental(body.GetSince())
+	}
+
+	return prm, nil
+}
+
+// dumpStreamWriter is an io.Writer which sends the written data
+// to the client in signed messages of limited size.
+type dumpStreamWriter struct {
+	srv control.ControlService_DumpShardStreamServer
+	key *ecdsa.PrivateKey
+	buf []byte
+}
+
+func (w *dumpStreamWriter) Write(p []byte) (int, error) {
+	n := len(p)
+
+	for len(p) > 0 {
+		k := copy(w.buf[len(w.buf):cap(w.buf)], p)
+		w.buf = w.buf[:len(w.buf)+k]
+		p = p[k:]
+
+		if len(w.buf) == cap(w.buf) {
+			if err := w.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return n, nil
+}
+
+// flush sends the buffered data.
+func (w *dumpStreamWriter) flush() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	body := new(control.DumpShardStreamResponse_Body)
+	body.SetChunk(w.buf)
+
+	// message is serialized by Send, so buffer can be reused
+	err := w.send(body)
+	w.buf = w.buf[:0]
+
+	return err
+}
+
+func (w *dumpStreamWriter) send(body *control.DumpShardStreamResponse_Body) error {
+	resp := new(control.DumpShardStreamResponse)
+	resp.SetBody(body)
+
+	if err := SignMessage(w.key, resp); err != nil {
+		return err
+	}
+
+	return w.srv.Send(resp)
+}
@@ -21,13 +21,22 @@ func (s *Server) RestoreShard(_ context.Context, req *control.RestoreShardReques
 	prm.WithPath(req.GetBody().GetFilepath())
 	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
 
-	err = s.s.RestoreShard(shardID, prm)
+	if req.GetBody().GetCheckWatermark() {
+		prm.WithBaseWatermark(req.GetBody().GetBaseWatermark())
+	}
+
+	res, err := s.s.RestoreShard(shardID, prm)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	body := new(control.RestoreShardResponse_Body)
+	body.SetCount(uint32(res.Count()))
+	body.SetFailed(uint32(res.FailCount()))
+	body.SetWatermark(res.Watermark())
+
 	resp := new(control.RestoreShardResponse)
-	resp.SetBody(new(control.RestoreShardResponse_Body))
+	resp.SetBody(body)
 
 	err = SignMessage(s.key, resp)
 	if err != nil {
@@ -35,3 +44,86 @@ func (s *Server) RestoreShard(_ context.Context, req *control.RestoreShardReques
 	}
 	return resp, nil
 }
+
+func (s *Server) RestoreShardStream(srv control.ControlService_RestoreShardStreamServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	err = s.isValidRequest(req)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	shardID := shard.NewIDFromBytes(req.GetBody().GetShard_ID())
+
+	r := &restoreStreamReader{
+		srv:   srv,
+		s:     s,
+		chunk: req.GetBody().GetChunk(),
+	}
+
+	prm := new(shard.RestorePrm)
+	prm.WithStream(r)
+	prm.WithIgnoreErrors(req.GetBody().GetIgnoreErrors())
+
+	if req.GetBody().GetCheckWatermark() {
+		prm.WithBaseWatermark(req.GetBody().GetBaseWatermark())
+	}
+
+	res, err := s.s.RestoreShard(shardID, prm)
+	if err != nil {
+		if r.errAuth != nil {
+			return status.Error(codes.PermissionDenied, r.errAuth.Error())
+		}
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	body := new(control.RestoreShardResponse_Body)
+	body.SetCount(uint32(res.Count()))
+	body.SetFailed(uint32(res.FailCount()))
+	body.SetWatermark(res.Watermark())
+
+	resp := new(control.RestoreShardResponse)
+	resp.SetBody(body)
+
+	err = SignMessage(s.key, resp)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return srv.SendAndClose(resp)
+}
+
+// restoreStreamReader is an io.Reader which reads the dump
+// from the messages sent by the client.
+type restoreStreamReader struct {
+	srv control.ControlService_RestoreShardStreamServer
+	s   *Server
+
+	chunk []byte
+
+	errAuth error
+}
+
+func (r *restoreStreamReader) Read(p []byte) (int, error) {
+	for len(r.chunk) == 0 {
+		req, err := r.srv.Recv()
+		if err != nil {
+			return 0, err // io.EOF at the end of the stream
+		}
+
+		if err := r.s.isValidRequest(req); err != nil {
+			r.errAuth = err
+			return 0, err
+		}
+
+		r.chunk = req.GetBody().GetChunk()
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+
+	return n, nil
+}
@@ -1,6 +1,7 @@
 package control
 
 import (
+	"context"
 	"crypto/ecdsa"
 
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
@@ -36,6 +37,17 @@ type NodeState interface {
 	SetNetmapStatus(control.NetmapStatus) error
 }
 
+// Diagnostics is an interface of the storage node
+// self-test which is not related to the local storage.
+type Diagnostics interface {
+	// Must perform node self-test and return the results
+	// of the performed checks.
+	//
+	// Checks of the local storage shards are performed
+	// by the Server and must not be included.
+	Diagnose(context.Context) []*control.DiagnosticCheck
+}
+
 // Option of the Server's constructor.
 type Option func(*cfg)
 
@@ -52,6 +64,8 @@ type cfg struct {
 
 	delObjHandler DeletedObjectHandler
 
+	diagnostics Diagnostics
+
 	s *engine.StorageEngine
 }
 
@@ -125,3 +139,11 @@ func WithLocalStorage(engine *engine.StorageEngine) Option {
 		c.s = engine
 	}
 }
+
+// WithDiagnostics returns option to set component
+// which performs node self-test.
+func WithDiagnostics(d Diagnostics) Option {
+	return func(c *cfg) {
+		c.diagnostics = d
+	}
+}
@@ -940,11 +940,40 @@ func (x *DumpShardRequest_Body) SetIgnoreErrors(ignore bool) {
 	x.IgnoreErrors = ignore
 }
 
+// SetContainerId sets list of containers to dump objects from.
+func (x *DumpShardRequest_Body) SetContainerId(v [][]byte) {
+	x.ContainerId = v
+}
+
+// SetEpochRange sets creation epoch range of the dumped objects.
+func (x *DumpShardRequest_Body) SetEpochRange(from, to uint64) {
+	x.FromEpoch = from
+	x.ToEpoch = to
+}
+
+// SetIncremental sets incremental flag and the watermark
+// of the previous dump for the dump shard request.
+func (x *DumpShardRequest_Body) SetIncremental(since uint64) {
+	x.Incremental = true
+	x.Since = since
+}
+
+// SetCompress sets compression flag for the dump shard request.
+func (x *DumpShardRequest_Body) SetCompress(compress bool) {
+	x.Compress = compress
+}
+
 const (
 	_ = iota
 	dumpShardReqBodyShardIDFNum
 	dumpShardReqBodyFilepathFNum
 	dumpShardReqBodyIgnoreErrorsFNum
+	dumpShardReqBodyContainerIDFNum
+	dumpShardReqBodyFromEpochFNum
+	dumpShardReqBodyToEpochFNum
+	dumpShardReqBodyIncrementalFNum
+	dumpShardReqBodySinceFNum
+	dumpShardReqBodyCompressFNum
 )
 
 // StableMarshal reads binary representation of request body binary format.
@@ -983,7 +1012,49 @@ func (x *DumpShardRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
 
 	offset += n
 
-	_, err = proto.BoolMarshal(dumpShardReqBodyIgnoreErrorsFNum, buf[offset:], x.IgnoreErrors)
+	n, err = proto.BoolMarshal(dumpShardReqBodyIgnoreErrorsFNum, buf[offset:], x.IgnoreErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.RepeatedBytesMarshal(dumpShardReqBodyContainerIDFNum, buf[offset:], x.ContainerId)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(dumpShardReqBodyFromEpochFNum, buf[offset:], x.FromEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(dumpShardReqBodyToEpochFNum, buf[offset:], x.ToEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BoolMarshal(dumpShardReqBodyIncrementalFNum, buf[offset:], x.Incremental)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(dumpShardReqBodySinceFNum, buf[offset:], x.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.BoolMarshal(dumpShardReqBodyCompressFNum, buf[offset:], x.Compress)
 	if err != nil {
 		return nil, err
 	}
@@ -1004,6 +1075,12 @@ func (x *DumpShardRequest_Body) StableSize() int {
 	size += proto.BytesSize(dumpShardReqBodyShardIDFNum, x.Shard_ID)
 	size += proto.StringSize(dumpShardReqBodyFilepathFNum, x.Filepath)
 	size += proto.BoolSize(dumpShardReqBodyIgnoreErrorsFNum, x.IgnoreErrors)
+	size += proto.RepeatedBytesSize(dumpShardReqBodyContainerIDFNum, x.ContainerId)
+	size += proto.UInt64Size(dumpShardReqBodyFromEpochFNum, x.FromEpoch)
+	size += proto.UInt64Size(dumpShardReqBodyToEpochFNum, x.ToEpoch)
+	size += proto.BoolSize(dumpShardReqBodyIncrementalFNum, x.Incremental)
+	size += proto.UInt64Size(dumpShardReqBodySinceFNum, x.Since)
+	size += proto.BoolSize(dumpShardReqBodyCompressFNum, x.Compress)
 
 	return size
 }
@@ -1041,6 +1118,22 @@ func (x *DumpShardRequest) SignedDataSize() int {
 	return x.GetBody().StableSize()
 }
 
+// SetCount sets number of the dumped objects.
+func (x *DumpShardResponse_Body) SetCount(v uint32) {
+	x.Count = v
+}
+
+// SetWatermark sets watermark of the incremental dump.
+func (x *DumpShardResponse_Body) SetWatermark(v uint64) {
+	x.Watermark = v
+}
+
+const (
+	_ = iota
+	dumpShardRespBodyCountFNum
+	dumpShardRespBodyWatermarkFNum
+)
+
 // StableMarshal reads binary representation of the response body in protobuf binary format.
 //
 // If buffer length is less than x.StableSize(), new buffer is allocated.
@@ -1050,6 +1143,24 @@ func (x *DumpShardRequest) SignedDataSize() int {
 //
 // Structures with the same field values have the same binary format.
 func (x *DumpShardResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	n, err := proto.UInt32Marshal(dumpShardRespBodyCountFNum, buf, x.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = proto.UInt64Marshal(dumpShardRespBodyWatermarkFNum, buf[n:], x.Watermark)
+	if err != nil {
+		return nil, err
+	}
+
 	return buf, nil
 }
 
@@ -1058,7 +1169,16 @@ func (x *DumpShardResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
 //
 // Structures with the same field values have the same binary size.
 func (x *DumpShardResponse_Body) StableSize() int {
-	return 0
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.UInt32Size(dumpShardRespBodyCountFNum, x.Count)
+	size += proto.UInt64Size(dumpShardRespBodyWatermarkFNum, x.Watermark)
+
+	return size
 }
 
 // SetBody sets response body.
@@ -1109,11 +1229,19 @@ func (x *RestoreShardRequest_Body) SetIgnoreErrors(ignore bool) {
 	x.IgnoreErrors = ignore
 }
 
+// SetBaseWatermark sets watermark of the previously restored dump.
+func (x *RestoreShardRequest_Body) SetBaseWatermark(v uint64) {
+	x.CheckWatermark = true
+	x.BaseWatermark = v
+}
+
 const (
 	_ = iota
 	restoreShardReqBodyShardIDFNum
 	restoreShardReqBodyFilepathFNum
 	restoreShardReqBodyIgnoreErrorsFNum
+	restoreShardReqBodyCheckWatermarkFNum
+	restoreShardReqBodyBaseWatermarkFNum
 )
 
 // StableMarshal reads binary representation of request body binary format.
@@ -1152,7 +1280,21 @@ func (x *RestoreShardRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
 
 	offset += n
 
-	_, err = proto.BoolMarshal(restoreShardReqBodyIgnoreErrorsFNum, buf[offset:], x.IgnoreErrors)
+	n, err = proto.BoolMarshal(restoreShardReqBodyIgnoreErrorsFNum, buf[offset:], x.IgnoreErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BoolMarshal(restoreShardReqBodyCheckWatermarkFNum, buf[offset:], x.CheckWatermark)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt64Marshal(restoreShardReqBodyBaseWatermarkFNum, buf[offset:], x.BaseWatermark)
 	if err != nil {
 		return nil, err
 	}
@@ -1173,6 +1315,8 @@ func (x *RestoreShardRequest_Body) StableSize() int {
 	size += proto.BytesSize(restoreShardReqBodyShardIDFNum, x.Shard_ID)
 	size += proto.StringSize(restoreShardReqBodyFilepathFNum, x.Filepath)
 	size += proto.BoolSize(restoreShardReqBodyIgnoreErrorsFNum, x.IgnoreErrors)
+	size += proto.BoolSize(restoreShardReqBodyCheckWatermarkFNum, x.CheckWatermark)
+	size += proto.UInt64Size(restoreShardReqBodyBaseWatermarkFNum, x.BaseWatermark)
 
 	return size
 }
@@ -1210,6 +1354,28 @@ func (x *RestoreShardRequest) SignedDataSize() int {
 	return x.GetBody().StableSize()
 }
 
+// SetCount sets number of the restored objects.
+func (x *RestoreShardResponse_Body) SetCount(v uint32) {
+	x.Count = v
+}
+
+// SetFailed sets number of the skipped objects.
+func (x *RestoreShardResponse_Body) SetFailed(v uint32) {
+	x.Failed = v
+}
+
+// SetWatermark sets watermark of the restored incremental dump.
+func (x *RestoreShardResponse_Body) SetWatermark(v uint64) {
+	x.Watermark = v
+}
+
+const (
+	_ = iota
+	restoreShardRespBodyCountFNum
+	restoreShardRespBodyFailedFNum
+	restoreShardRespBodyWatermarkFNum
+)
+
 // StableMarshal reads binary representation of the response body in protobuf binary format.
 //
 // If buffer length is less than x.StableSize(), new buffer is allocated.
@@ -1219,6 +1385,38 @@ func (x *RestoreShardRequest) SignedDataSize() int {
 //
 // Structures with the same field values have the same binary format.
 func (x *RestoreShardResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.UInt32Marshal(restoreShardRespBodyCountFNum, buf, x.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt32Marshal(restoreShardRespBodyFailedFNum, buf[offset:], x.Failed)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt64Marshal(restoreShardRespBodyWatermarkFNum, buf[offset:], x.Watermark)
+	if err != nil {
+		return nil, err
+	}
+
 	return buf, nil
 }
 
@@ -1227,7 +1425,17 @@ func (x *RestoreShardResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
 //
 // Structures with the same field values have the same binary size.
 func (x *RestoreShardResponse_Body) StableSize() int {
-	return 0
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.UInt32Size(restoreShardRespBodyCountFNum, x.Count)
+	size += proto.UInt32Size(restoreShardRespBodyFailedFNum, x.Failed)
+	size += proto.UInt64Size(restoreShardRespBodyWatermarkFNum, x.Watermark)
+
+	return size
 }
 
 // SetBody sets response body.
@@ -1262,3 +1470,414 @@ func (x *RestoreShardResponse) ReadSignedData(buf []byte) ([]byte, error) {
 func (x *RestoreShardResponse) SignedDataSize() int {
 	return x.GetBody().StableSize()
 }
+
+// SetChunk sets next portion of the dump.
+func (x *DumpShardStreamResponse_Body) SetChunk(v []byte) {
+	x.Chunk = v
+}
+
+// SetCount sets number of the dumped objects.
+func (x *DumpShardStreamResponse_Body) SetCount(v uint32) {
+	x.Count = v
+}
+
+// SetWatermark sets watermark of the incremental dump.
+func (x *DumpShardStreamResponse_Body) SetWatermark(v uint64) {
+	x.Watermark = v
+}
+
+const (
+	_ = iota
+	dumpShardStreamRespBodyChunkFNum
+	dumpShardStreamRespBodyCountFNum
+	dumpShardStreamRespBodyWatermarkFNum
+)
+
+// StableMarshal reads binary representation of the response body in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *DumpShardStreamResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.BytesMarshal(dumpShardStreamRespBodyChunkFNum, buf, x.Chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt32Marshal(dumpShardStreamRespBodyCountFNum, buf[offset:], x.Count)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt64Marshal(dumpShardStreamRespBodyWatermarkFNum, buf[offset:], x.Watermark)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *DumpShardStreamResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.BytesSize(dumpShardStreamRespBodyChunkFNum, x.Chunk)
+	size += proto.UInt32Size(dumpShardStreamRespBodyCountFNum, x.Count)
+	size += proto.UInt64Size(dumpShardStreamRespBodyWatermarkFNum, x.Watermark)
+
+	return size
+}
+
+// SetBody sets response body.
+func (x *DumpShardStreamResponse) SetBody(v *DumpShardStreamResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets response body signature.
+func (x *DumpShardStreamResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data from response to buf.
+//
+// If buffer length is less than SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *DumpShardStreamResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data.
+//
+// Structures with the same field values have the same signed data size.
+func (x *DumpShardStreamResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetShardID sets shard ID for the restore shard stream request.
+func (x *RestoreShardStreamRequest_Body) SetShardID(id []byte) {
+	x.Shard_ID = id
+}
+
+// SetIgnoreErrors sets ignore errors flag for the restore shard stream request.
+func (x *RestoreShardStreamRequest_Body) SetIgnoreErrors(ignore bool) {
+	x.IgnoreErrors = ignore
+}
+
+// SetBaseWatermark sets watermark of the previously restored dump.
+func (x *RestoreShardStreamRequest_Body) SetBaseWatermark(v uint64) {
+	x.CheckWatermark = true
+	x.BaseWatermark = v
+}
+
+// SetChunk sets next portion of the dump.
+func (x *RestoreShardStreamRequest_Body) SetChunk(v []byte) {
+	x.Chunk = v
+}
+
+const (
+	_ = iota
+	restoreShardStreamReqBodyShardIDFNum
+	restoreShardStreamReqBodyIgnoreErrorsFNum
+	restoreShardStreamReqBodyCheckWatermarkFNum
+	restoreShardStreamReqBodyBaseWatermarkFNum
+	restoreShardStreamReqBodyChunkFNum
+)
+
+// StableMarshal reads binary representation of request body binary format.
+//
+// If buffer length is less than StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *RestoreShardStreamRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.BytesMarshal(restoreShardStreamReqBodyShardIDFNum, buf, x.Shard_ID)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BoolMarshal(restoreShardStreamReqBodyIgnoreErrorsFNum, buf[offset:], x.IgnoreErrors)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.BoolMarshal(restoreShardStreamReqBodyCheckWatermarkFNum, buf[offset:], x.CheckWatermark)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.UInt64Marshal(restoreShardStreamReqBodyBaseWatermarkFNum, buf[offset:], x.BaseWatermark)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.BytesMarshal(restoreShardStreamReqBodyChunkFNum, buf[offset:], x.Chunk)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of the request body in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *RestoreShardStreamRequest_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.BytesSize(restoreShardStreamReqBodyShardIDFNum, x.Shard_ID)
+	size += proto.BoolSize(restoreShardStreamReqBodyIgnoreErrorsFNum, x.IgnoreErrors)
+	size += proto.BoolSize(restoreShardStreamReqBodyCheckWatermarkFNum, x.CheckWatermark)
+	size += proto.UInt64Size(restoreShardStreamReqBodyBaseWatermarkFNum, x.BaseWatermark)
+	size += proto.BytesSize(restoreShardStreamReqBodyChunkFNum, x.Chunk)
+
+	return size
+}
+
+// SetBody sets request body.
+func (x *RestoreShardStreamRequest) SetBody(v *RestoreShardStreamRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets body signature of the request.
+func (x *RestoreShardStreamRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data from request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *RestoreShardStreamRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns size of the request signed data in bytes.
+//
+// Structures with the same field values have the same signed data size.
+func (x *RestoreShardStreamRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// StableMarshal reads binary representation of diagnostics request body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *DiagnosticsRequest_Body) StableMarshal(buf []byte) ([]byte, error) {
+	return buf, nil
+}
+
+// StableSize returns binary size of diagnostics request body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *DiagnosticsRequest_Body) StableSize() int {
+	return 0
+}
+
+// SetBody sets diagnostics request body.
+func (x *DiagnosticsRequest) SetBody(v *DiagnosticsRequest_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the diagnostics request body.
+func (x *DiagnosticsRequest) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of diagnostics request to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *DiagnosticsRequest) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of diagnostics request.
+//
+// Structures with the same field values have the same signed data size.
+func (x *DiagnosticsRequest) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
+
+// SetChecks sets results of the diagnostic checks.
+func (x *DiagnosticsResponse_Body) SetChecks(v []*DiagnosticCheck) {
+	if x != nil {
+		x.Checks = v
+	}
+}
+
+const (
+	_ = iota
+	diagnosticsRespBodyChecksFNum
+)
+
+// StableMarshal reads binary representation of diagnostics response body
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *DiagnosticsResponse_Body) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	for i := range x.Checks {
+		n, err = proto.NestedStructureMarshal(diagnosticsRespBodyChecksFNum, buf[offset:], x.Checks[i])
+		if err != nil {
+			return nil, err
+		}
+
+		offset += n
+	}
+
+	return buf, nil
+}
+
+// StableSize returns binary size of diagnostics response body
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *DiagnosticsResponse_Body) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	for i := range x.Checks {
+		size += proto.NestedStructureSize(diagnosticsRespBodyChecksFNum, x.Checks[i])
+	}
+
+	return size
+}
+
+// SetBody sets diagnostics response body.
+func (x *DiagnosticsResponse) SetBody(v *DiagnosticsResponse_Body) {
+	if x != nil {
+		x.Body = v
+	}
+}
+
+// SetSignature sets signature of the diagnostics response body.
+func (x *DiagnosticsResponse) SetSignature(v *Signature) {
+	if x != nil {
+		x.Signature = v
+	}
+}
+
+// ReadSignedData reads signed data of diagnostics response to buf.
+//
+// If buffer length is less than x.SignedDataSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same signed data.
+func (x *DiagnosticsResponse) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.GetBody().StableMarshal(buf)
+}
+
+// SignedDataSize returns binary size of the signed data
+// of diagnostics response.
+//
+// Structures with the same field values have the same signed data size.
+func (x *DiagnosticsResponse) SignedDataSize() int {
+	return x.GetBody().StableSize()
+}
@@ -149,3 +149,225 @@ func equalSetShardModeRequestBodies(b1, b2 *control.SetShardModeRequest_Body) bo
 
 	return true
 }
+
+func TestDumpShardRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateDumpShardRequestBody(),
+		new(control.DumpShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			return equalDumpShardRequestBodies(
+				m1.(*control.DumpShardRequest_Body),
+				m2.(*control.DumpShardRequest_Body),
+			)
+		},
+	)
+}
+
+func generateDumpShardRequestBody() *control.DumpShardRequest_Body {
+	body := new(control.DumpShardRequest_Body)
+	body.SetShardID([]byte{1, 2, 3})
+	body.SetFilepath("/path/to/dump")
+	body.SetIgnoreErrors(true)
+	body.SetContainerId([][]byte{{4, 5, 6}, {7, 8, 9}})
+	body.SetEpochRange(10, 20)
+	body.SetIncremental(15)
+	body.SetCompress(true)
+
+	return body
+}
+
+func equalDumpShardRequestBodies(b1, b2 *control.DumpShardRequest_Body) bool {
+	if !bytes.Equal(b1.GetShard_ID(), b2.GetShard_ID()) ||
+		b1.GetFilepath() != b2.GetFilepath() ||
+		b1.GetIgnoreErrors() != b2.GetIgnoreErrors() ||
+		b1.GetFromEpoch() != b2.GetFromEpoch() ||
+		b1.GetToEpoch() != b2.GetToEpoch() ||
+		b1.GetIncremental() != b2.GetIncremental() ||
+		b1.GetSince() != b2.GetSince() ||
+		b1.GetCompress() != b2.GetCompress() ||
+		len(b1.GetContainerId()) != len(b2.GetContainerId()) {
+		return false
+	}
+
+	for i := range b1.GetContainerId() {
+		if !bytes.Equal(b1.GetContainerId()[i], b2.GetContainerId()[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestDumpShardResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateDumpShardResponseBody(),
+		new(control.DumpShardResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.DumpShardResponse_Body)
+			b2 := m2.(*control.DumpShardResponse_Body)
+
+			return b1.GetCount() == b2.GetCount() &&
+				b1.GetWatermark() == b2.GetWatermark()
+		},
+	)
+}
+
+func generateDumpShardResponseBody() *control.DumpShardResponse_Body {
+	body := new(control.DumpShardResponse_Body)
+	body.SetCount(42)
+	body.SetWatermark(100)
+
+	return body
+}
+
+func TestRestoreShardRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateRestoreShardRequestBody(),
+		new(control.RestoreShardRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.RestoreShardRequest_Body)
+			b2 := m2.(*control.RestoreShardRequest_Body)
+
+			return bytes.Equal(b1.GetShard_ID(), b2.GetShard_ID()) &&
+				b1.GetFilepath() == b2.GetFilepath() &&
+				b1.GetIgnoreErrors() == b2.GetIgnoreErrors() &&
+				b1.GetCheckWatermark() == b2.GetCheckWatermark() &&
+				b1.GetBaseWatermark() == b2.GetBaseWatermark()
+		},
+	)
+}
+
+func generateRestoreShardRequestBody() *control.RestoreShardRequest_Body {
+	body := new(control.RestoreShardRequest_Body)
+	body.SetShardID([]byte{1, 2, 3})
+	body.SetFilepath("/path/to/dump")
+	body.SetIgnoreErrors(true)
+	body.SetBaseWatermark(100)
+
+	return body
+}
+
+func TestRestoreShardResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateRestoreShardResponseBody(),
+		new(control.RestoreShardResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.RestoreShardResponse_Body)
+			b2 := m2.(*control.RestoreShardResponse_Body)
+
+			return b1.GetCount() == b2.GetCount() &&
+				b1.GetFailed() == b2.GetFailed() &&
+				b1.GetWatermark() == b2.GetWatermark()
+		},
+	)
+}
+
+func generateRestoreShardResponseBody() *control.RestoreShardResponse_Body {
+	body := new(control.RestoreShardResponse_Body)
+	body.SetCount(42)
+	body.SetFailed(2)
+	body.SetWatermark(100)
+
+	return body
+}
+
+func TestDumpShardStreamResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateDumpShardStreamResponseBody(),
+		new(control.DumpShardStreamResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.DumpShardStreamResponse_Body)
+			b2 := m2.(*control.DumpShardStreamResponse_Body)
+
+			return bytes.Equal(b1.GetChunk(), b2.GetChunk()) &&
+				b1.GetCount() == b2.GetCount() &&
+				b1.GetWatermark() == b2.GetWatermark()
+		},
+	)
+}
+
+func generateDumpShardStreamResponseBody() *control.DumpShardStreamResponse_Body {
+	body := new(control.DumpShardStreamResponse_Body)
+	body.SetChunk([]byte{1, 2, 3})
+	body.SetCount(42)
+	body.SetWatermark(100)
+
+	return body
+}
+
+func TestRestoreShardStreamRequest_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateRestoreShardStreamRequestBody(),
+		new(control.RestoreShardStreamRequest_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.RestoreShardStreamRequest_Body)
+			b2 := m2.(*control.RestoreShardStreamRequest_Body)
+
+			return bytes.Equal(b1.GetShard_ID(), b2.GetShard_ID()) &&
+				b1.GetIgnoreErrors() == b2.GetIgnoreErrors() &&
+				b1.GetCheckWatermark() == b2.GetCheckWatermark() &&
+				b1.GetBaseWatermark() == b2.GetBaseWatermark() &&
+				bytes.Equal(b1.GetChunk(), b2.GetChunk())
+		},
+	)
+}
+
+func generateRestoreShardStreamRequestBody() *control.RestoreShardStreamRequest_Body {
+	body := new(control.RestoreShardStreamRequest_Body)
+	body.SetShardID([]byte{1, 2, 3})
+	body.SetIgnoreErrors(true)
+	body.SetBaseWatermark(100)
+	body.SetChunk([]byte{4, 5, 6})
+
+	return body
+}
+
+func TestDiagnosticsResponse_Body_StableMarshal(t *testing.T) {
+	testStableMarshal(t,
+		generateDiagnosticsResponseBody(),
+		new(control.DiagnosticsResponse_Body),
+		func(m1, m2 protoMessage) bool {
+			b1 := m1.(*control.DiagnosticsResponse_Body)
+			b2 := m2.(*control.DiagnosticsResponse_Body)
+
+			if len(b1.GetChecks()) != len(b2.GetChecks()) {
+				return false
+			}
+
+			for i := range b1.GetChecks() {
+				c1, c2 := b1.GetChecks()[i], b2.GetChecks()[i]
+
+				if c1.GetCategory() != c2.GetCategory() ||
+					c1.GetSubject() != c2.GetSubject() ||
+					c1.GetStatus() != c2.GetStatus() ||
+					c1.GetDetails() != c2.GetDetails() ||
+					c1.GetDuration() != c2.GetDuration() {
+					return false
+				}
+			}
+
+			return true
+		},
+	)
+}
+
+func generateDiagnosticsResponseBody() *control.DiagnosticsResponse_Body {
+	body := new(control.DiagnosticsResponse_Body)
+	body.SetChecks([]*control.DiagnosticCheck{
+		generateDiagnosticCheck(),
+		generateDiagnosticCheck(),
+	})
+
+	return body
+}
+
+func generateDiagnosticCheck() *control.DiagnosticCheck {
+	c := new(control.DiagnosticCheck)
+	c.SetCategory(testString())
+	c.SetSubject(testString())
+	c.SetStatus(control.DiagnosticStatus_WARNING)
+	c.SetDetails(testString())
+	c.SetDuration(42)
+
+	return c
+}
@@ -443,3 +443,116 @@ func (x *ShardInfo) StableMarshal(buf []byte) ([]byte, error) {
 
 	return buf, nil
 }
+
+// SetCategory sets group of the diagnostic check.
+func (x *DiagnosticCheck) SetCategory(v string) {
+	x.Category = v
+}
+
+// SetSubject sets item checked by the diagnostic check.
+func (x *DiagnosticCheck) SetSubject(v string) {
+	x.Subject = v
+}
+
+// SetStatus sets result of the diagnostic check.
+func (x *DiagnosticCheck) SetStatus(v DiagnosticStatus) {
+	x.Status = v
+}
+
+// SetDetails sets description of the diagnostic check result.
+func (x *DiagnosticCheck) SetDetails(v string) {
+	x.Details = v
+}
+
+// SetDuration sets duration of the diagnostic check in milliseconds.
+func (x *DiagnosticCheck) SetDuration(v uint64) {
+	x.Duration = v
+}
+
+const (
+	_ = iota
+	diagnosticCheckCategoryFNum
+	diagnosticCheckSubjectFNum
+	diagnosticCheckStatusFNum
+	diagnosticCheckDetailsFNum
+	diagnosticCheckDurationFNum
+)
+
+// StableSize returns binary size of diagnostic check
+// in protobuf binary format.
+//
+// Structures with the same field values have the same binary size.
+func (x *DiagnosticCheck) StableSize() int {
+	if x == nil {
+		return 0
+	}
+
+	size := 0
+
+	size += proto.StringSize(diagnosticCheckCategoryFNum, x.Category)
+	size += proto.StringSize(diagnosticCheckSubjectFNum, x.Subject)
+	size += proto.EnumSize(diagnosticCheckStatusFNum, int32(x.Status))
+	size += proto.StringSize(diagnosticCheckDetailsFNum, x.Details)
+	size += proto.UInt64Size(diagnosticCheckDurationFNum, x.Duration)
+
+	return size
+}
+
+// StableMarshal reads binary representation of diagnostic check
+// in protobuf binary format.
+//
+// If buffer length is less than x.StableSize(), new buffer is allocated.
+//
+// Returns any error encountered which did not allow writing the data completely.
+// Otherwise, returns the buffer in which the data is written.
+//
+// Structures with the same field values have the same binary format.
+func (x *DiagnosticCheck) StableMarshal(buf []byte) ([]byte, error) {
+	if x == nil {
+		return []byte{}, nil
+	}
+
+	if sz := x.StableSize(); len(buf) < sz {
+		buf = make([]byte, sz)
+	}
+
+	var (
+		offset, n int
+		err       error
+	)
+
+	n, err = proto.StringMarshal(diagnosticCheckCategoryFNum, buf[offset:], x.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.StringMarshal(diagnosticCheckSubjectFNum, buf[offset:], x.Subject)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.EnumMarshal(diagnosticCheckStatusFNum, buf[offset:], int32(x.Status))
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	n, err = proto.StringMarshal(diagnosticCheckDetailsFNum, buf[offset:], x.Details)
+	if err != nil {
+		return nil, err
+	}
+
+	offset += n
+
+	_, err = proto.UInt64Marshal(diagnosticCheckDurationFNum, buf[offset:], x.Duration)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
@@ -5,9 +5,16 @@ import (
 
 	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
 	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
 	"github.com/nspcc-dev/neofs-sdk-go/version"
 )
 
+// XHeaderAnnouncedNodeInfo is a key of the LocalNodeInfo request X-header
+// which makes the node return the information it announces to the network
+// map instead of the one from the current network map. Header value must
+// be "true".
+const XHeaderAnnouncedNodeInfo = "__NEOFS__ANNOUNCED_NODE_INFO"
+
 type executorSvc struct {
 	version *version.Version
 	state   NodeState
@@ -21,6 +28,11 @@ type NodeState interface {
 	// Must return current node state
 	// in NeoFS API v2 NodeInfo structure.
 	LocalNodeInfo() (*netmap.NodeInfo, error)
+
+	// Must return the information which node
+	// announces to the network map in NeoFS API
+	// v2 NodeInfo structure.
+	AnnouncedNodeInfo() (*netmap.NodeInfo, error)
 }
 
 // NetworkInfo encapsulates source of the
@@ -50,7 +62,17 @@ func (s *executorSvc) LocalNodeInfo(
 	req *netmap.LocalNodeInfoRequest) (*netmap.LocalNodeInfoResponse, error) {
 	ver := version.NewFromV2(req.GetMetaHeader().GetVersion())
 
-	ni, err := s.state.LocalNodeInfo()
+	var (
+		ni  *netmap.NodeInfo
+		err error
+	)
+
+	if announcedInfoRequested(req.GetMetaHeader()) {
+		ni, err = s.state.AnnouncedNodeInfo()
+	} else {
+		ni, err = s.state.LocalNodeInfo()
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -78,6 +100,18 @@ func (s *executorSvc) LocalNodeInfo(
 	return resp, nil
 }
 
+// announcedInfoRequested checks if XHeaderAnnouncedNodeInfo
+// is set in the request meta header.
+func announcedInfoRequested(meta *session.RequestMetaHeader) bool {
+	for _, h := range meta.GetXHeaders() {
+		if h.GetKey() == XHeaderAnnouncedNodeInfo {
+			return h.GetValue() == "true"
+		}
+	}
+
+	return false
+}
+
 func (s *executorSvc) NetworkInfo(
 	_ context.Context,
 	req *netmap.NetworkInfoRequest) (*netmap.NetworkInfoResponse, error) {
@@ -16,6 +16,7 @@ import (
 	core "github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/network/tlsutil"
 	objectSvc "github.com/nspcc-dev/neofs-node/pkg/services/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl"
 	eaclV2 "github.com/nspcc-dev/neofs-node/pkg/services/object/acl/eacl/v2"
@@ -37,6 +38,8 @@ type (
 		source *Service
 		next   objectSvc.PutObjectStream
 
+		ctx context.Context
+
 		*eACLCfg
 	}
 
@@ -80,6 +83,8 @@ type (
 		bearer *bearer.BearerToken // bearer token of request
 
 		srcRequest interface{}
+
+		clientSubject string // subject of the verified TLS client certificate
 	}
 )
 
@@ -148,10 +153,11 @@ func (b Service) Get(request *object.GetRequest, stream objectSvc.GetObjectStrea
 	sTok := originalSessionToken(request.GetMetaHeader())
 
 	req := metaWithToken{
-		vheader: request.GetVerificationHeader(),
-		token:   sTok,
-		bearer:  originalBearerToken(request.GetMetaHeader()),
-		src:     request,
+		vheader:       request.GetVerificationHeader(),
+		token:         sTok,
+		bearer:        originalBearerToken(request.GetMetaHeader()),
+		src:           request,
+		clientSubject: tlsutil.ClientSubject(stream.Context()),
 	}
 
 	reqInfo, err := b.findRequestInfo(req, cid, eaclSDK.OperationGet)
@@ -181,6 +187,7 @@ func (b Service) Put(ctx context.Context) (objectSvc.PutObjectStream, error) {
 	return putStreamBasicChecker{
 		source:  &b,
 		next:    streamer,
+		ctx:     ctx,
 		eACLCfg: b.eACLCfg,
 	}, err
 }
@@ -196,10 +203,11 @@ func (b Service) Head(
 	sTok := originalSessionToken(request.GetMetaHeader())
 
 	req := metaWithToken{
-		vheader: request.GetVerificationHeader(),
-		token:   sTok,
-		bearer:  originalBearerToken(request.GetMetaHeader()),
-		src:     request,
+		vheader:       request.GetVerificationHeader(),
+		token:         sTok,
+		bearer:        originalBearerToken(request.GetMetaHeader()),
+		src:           request,
+		clientSubject: tlsutil.ClientSubject(ctx),
 	}
 
 	reqInfo, err := b.findRequestInfo(req, cid, eaclSDK.OperationHead)
@@ -235,10 +243,11 @@ func (b Service) Search(request *object.SearchRequest, stream objectSvc.SearchSt
 	}
 
 	req := metaWithToken{
-		vheader: request.GetVerificationHeader(),
-		token:   originalSessionToken(request.GetMetaHeader()),
-		bearer:  originalBearerToken(request.GetMetaHeader()),
-		src:     request,
+		vheader:       request.GetVerificationHeader(),
+		token:         originalSessionToken(request.GetMetaHeader()),
+		bearer:        originalBearerToken(request.GetMetaHeader()),
+		src:           request,
+		clientSubject: tlsutil.ClientSubject(stream.Context()),
 	}
 
 	reqInfo, err := b.findRequestInfo(req, id, eaclSDK.OperationSearch)
@@ -272,10 +281,11 @@ func (b Service) Delete(
 	sTok := originalSessionToken(request.GetMetaHeader())
 
 	req := metaWithToken{
-		vheader: request.GetVerificationHeader(),
-		token:   sTok,
-		bearer:  originalBearerToken(request.GetMetaHeader()),
-		src:     request,
+		vheader:       request.GetVerificationHeader(),
+		token:         sTok,
+		bearer:        originalBearerToken(request.GetMetaHeader()),
+		src:           request,
+		clientSubject: tlsutil.ClientSubject(ctx),
 	}
 
 	reqInfo, err := b.findRequestInfo(req, cid, eaclSDK.OperationDelete)
@@ -304,10 +314,11 @@ func (b Service) GetRange(request *object.GetRangeRequest, stream objectSvc.GetO
 	sTok := originalSessionToken(request.GetMetaHeader())
 
 	req := metaWithToken{
-		vheader: request.GetVerificationHeader(),
-		token:   sTok,
-		bearer:  originalBearerToken(request.GetMetaHeader()),
-		src:     request,
+		vheader:       request.GetVerificationHeader(),
+		token:         sTok,
+		bearer:        originalBearerToken(request.GetMetaHeader()),
+		src:           request,
+		clientSubject: tlsutil.ClientSubject(stream.Context()),
 	}
 
 	reqInfo, err := b.findRequestInfo(req, cid, eaclSDK.OperationRange)
@@ -342,10 +353,11 @@ func (b Service) GetRangeHash(
 	sTok := originalSessionToken(request.GetMetaHeader())
 
 	req := metaWithToken{
-		vheader: request.GetVerificationHeader(),
-		token:   sTok,
-		bearer:  originalBearerToken(request.GetMetaHeader()),
-		src:     request,
+		vheader:       request.GetVerificationHeader(),
+		token:         sTok,
+		bearer:        originalBearerToken(request.GetMetaHeader()),
+		src:           request,
+		clientSubject: tlsutil.ClientSubject(ctx),
 	}
 
 	reqInfo, err := b.findRequestInfo(req, cid, eaclSDK.OperationRangeHash)
@@ -386,10 +398,11 @@ func (p putStreamBasicChecker) Send(request *object.PutRequest) error {
 		sTok := request.GetMetaHeader().GetSessionToken()
 
 		req := metaWithToken{
-			vheader: request.GetVerificationHeader(),
-			token:   sTok,
-			bearer:  originalBearerToken(request.GetMetaHeader()),
-			src:     request,
+			vheader:       request.GetVerificationHeader(),
+			token:         sTok,
+			bearer:        originalBearerToken(request.GetMetaHeader()),
+			src:           request,
+			clientSubject: tlsutil.ClientSubject(p.ctx),
 		}
 
 		reqInfo, err := p.source.findRequestInfo(req, cid, eaclSDK.OperationPut)
@@ -478,6 +491,8 @@ func (b Service) findRequestInfo(
 
 	info.srcRequest = req.src
 
+	info.clientSubject = req.clientSubject
+
 	return info, nil
 }
 
@@ -630,7 +645,7 @@ func eACLCheck(msg interface{}, reqInfo requestInfo, cfg *eACLCfg) bool {
 		return false
 	}
 
-	hdrSrcOpts := make([]eaclV2.Option, 0, 3)
+	hdrSrcOpts := make([]eaclV2.Option, 0, 4)
 
 	addr := objectSDKAddress.NewAddress()
 	addr.SetContainerID(reqInfo.cid)
@@ -638,6 +653,7 @@ func eACLCheck(msg interface{}, reqInfo requestInfo, cfg *eACLCfg) bool {
 
 	hdrSrcOpts = append(hdrSrcOpts,
 		eaclV2.WithLocalObjectStorage(cfg.localStorage),
+		eaclV2.WithClientCertificateSubject(reqInfo.clientSubject),
 		eaclV2.WithAddress(addr.ToV2()),
 	)
 
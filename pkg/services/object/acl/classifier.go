@@ -31,6 +31,8 @@ type (
 		token   *session.SessionToken
 		bearer  *bearer.BearerToken
 		src     interface{}
+
+		clientSubject string
 	}
 
 	SenderClassifier struct {
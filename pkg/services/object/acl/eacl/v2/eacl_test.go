@@ -143,3 +143,105 @@ func TestHeadRequest(t *testing.T) {
 
 	require.Equal(t, eaclSDK.ActionAllow, validator.CalculateAction(unit))
 }
+
+func TestClientCertificateSubject(t *testing.T) {
+	const (
+		subject      = "CN=admin"
+		otherSubject = "CN=user"
+	)
+
+	req := new(objectV2.HeadRequest)
+
+	meta := new(session.RequestMetaHeader)
+	req.SetMetaHeader(meta)
+
+	body := new(objectV2.HeadRequestBody)
+	req.SetBody(body)
+
+	addr := testAddress(t)
+	body.SetAddress(addr.ToV2())
+
+	priv, err := keys.NewPrivateKey()
+	require.NoError(t, err)
+	senderKey := priv.PublicKey()
+
+	r := eaclSDK.NewRecord()
+	r.SetOperation(eaclSDK.OperationHead)
+	r.SetAction(eaclSDK.ActionDeny)
+	r.AddFilter(eaclSDK.HeaderFromRequest, eaclSDK.MatchStringEqual, XHeaderClientSubject, subject)
+	eaclSDK.AddFormedTarget(r, eaclSDK.RoleUnknown, (ecdsa.PublicKey)(*senderKey))
+
+	table := new(eaclSDK.Table)
+	table.AddRecord(r)
+
+	lStorage := &testLocalStorage{
+		t:       t,
+		expAddr: addr,
+		obj:     object.NewRaw().Object(),
+	}
+
+	validator := eaclSDK.NewValidator()
+
+	calculate := func(clientSubject string) eaclSDK.Action {
+		unit := new(eaclSDK.ValidationUnit).
+			WithContainerID(addr.ContainerID()).
+			WithOperation(eaclSDK.OperationHead).
+			WithSenderKey(senderKey.Bytes()).
+			WithHeaderSource(
+				NewMessageHeaderSource(
+					WithObjectStorage(lStorage),
+					WithServiceRequest(req),
+					WithClientCertificateSubject(clientSubject),
+				),
+			).
+			WithEACLTable(table)
+
+		return validator.CalculateAction(unit)
+	}
+
+	subjectHeaders := func(clientSubject string) []string {
+		var res []string
+
+		hs, _ := NewMessageHeaderSource(
+			WithServiceRequest(req),
+			WithClientCertificateSubject(clientSubject),
+		).HeadersOfType(eaclSDK.HeaderFromRequest)
+
+		for i := range hs {
+			if hs[i].Key() == XHeaderClientSubject {
+				res = append(res, hs[i].Value())
+			}
+		}
+
+		return res
+	}
+
+	t.Run("real subject", func(t *testing.T) {
+		meta.SetXHeaders(nil)
+		meta.SetOrigin(nil)
+
+		require.Equal(t, []string{subject}, subjectHeaders(subject))
+		require.Equal(t, eaclSDK.ActionDeny, calculate(subject))
+		require.Equal(t, eaclSDK.ActionAllow, calculate(otherSubject))
+		require.Equal(t, eaclSDK.ActionAllow, calculate(""))
+	})
+
+	t.Run("spoofed subject", func(t *testing.T) {
+		origin := new(session.RequestMetaHeader)
+		origin.SetXHeaders(testXHeaders(XHeaderClientSubject, subject))
+
+		meta.SetXHeaders(testXHeaders(XHeaderClientSubject, subject))
+		meta.SetOrigin(origin)
+
+		// headers sent by the client are removed
+		require.Empty(t, subjectHeaders(""))
+		require.Equal(t, eaclSDK.ActionAllow, calculate(""))
+
+		// only the real subject is exposed
+		require.Equal(t, []string{otherSubject}, subjectHeaders(otherSubject))
+		require.Equal(t, eaclSDK.ActionAllow, calculate(otherSubject))
+
+		require.Equal(t, []string{subject}, subjectHeaders(subject))
+		require.Equal(t, eaclSDK.ActionDeny, calculate(subject))
+	})
+}
@@ -23,6 +23,8 @@ type cfg struct {
 	msg xHeaderSource
 
 	addr *refs.Address
+
+	clientSubject string
 }
 
 type ObjectStorage interface {
@@ -64,21 +66,38 @@ func (h *headerSource) HeadersOfType(typ eaclSDK.FilterHeaderType) ([]eaclSDK.He
 	default:
 		return nil, true
 	case eaclSDK.HeaderFromRequest:
-		return requestHeaders(h.msg), true
+		return requestHeaders(h.msg, h.clientSubject), true
 	case eaclSDK.HeaderFromObject:
 		return h.objectHeaders()
 	}
 }
 
-func requestHeaders(msg xHeaderSource) []eaclSDK.Header {
+// XHeaderClientSubject is a key of the request header which carries
+// the subject of the verified TLS client certificate. Headers with
+// this key sent by the client are ignored.
+const XHeaderClientSubject = "__NEOFS__TLS_CLIENT_SUBJECT"
+
+func requestHeaders(msg xHeaderSource, clientSubject string) []eaclSDK.Header {
 	xHdrs := msg.GetXHeaders()
 
-	res := make([]eaclSDK.Header, 0, len(xHdrs))
+	res := make([]eaclSDK.Header, 0, len(xHdrs)+1)
 
 	for i := range xHdrs {
+		if xHdrs[i].GetKey() == XHeaderClientSubject {
+			continue
+		}
+
 		res = append(res, sessionSDK.NewXHeaderFromV2(xHdrs[i]))
 	}
 
+	if clientSubject != "" {
+		xHdr := new(session.XHeader)
+		xHdr.SetKey(XHeaderClientSubject)
+		xHdr.SetValue(clientSubject)
+
+		res = append(res, sessionSDK.NewXHeaderFromV2(xHdr))
+	}
+
 	return res
 }
 
@@ -41,3 +41,11 @@ func WithAddress(v *refs.Address) Option {
 		c.addr = v
 	}
 }
+
+// WithClientCertificateSubject sets the subject of the verified TLS
+// client certificate which is exposed as a request header.
+func WithClientCertificateSubject(v string) Option {
+	return func(c *cfg) {
+		c.clientSubject = v
+	}
+}
@@ -85,6 +85,14 @@ func (exec *execCtx) formSplitInfo() bool {
 	var err error
 
 	exec.splitInfo, err = exec.svc.header.splitInfo(exec)
+	if err != nil && exec.isErasureCoded() {
+		// header of the erasure-coded object is stored in its parts only,
+		// such objects are not split
+		parts, sErr := exec.svc.searcher.erasureParts(exec, exec.address().ObjectID())
+		if sErr == nil && len(parts) > 0 {
+			exec.splitInfo, err = nil, nil
+		}
+	}
 
 	switch {
 	default:
@@ -206,6 +214,60 @@ func (exec *execCtx) supplementBySplitID() bool {
 	}
 }
 
+// isErasureCoded checks if the objects of the container are erasure-coded.
+func (exec *execCtx) isErasureCoded() bool {
+	if exec.svc.erasureSchemes == nil {
+		return false
+	}
+
+	scheme, err := exec.svc.erasureSchemes.scheme(exec.containerID())
+	if err != nil {
+		exec.log.Debug("could not get erasure code scheme of the container",
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+
+	return scheme != nil
+}
+
+// collectErasureParts supplements the members by the erasure code parts
+// of each member, so the parts are removed along with the object.
+func (exec *execCtx) collectErasureParts() bool {
+	if !exec.isErasureCoded() {
+		return true
+	}
+
+	exec.log.Debug("collecting erasure code parts...")
+
+	members := exec.tombstone.Members()
+
+	for i := range members {
+		parts, err := exec.svc.searcher.erasureParts(exec, members[i])
+
+		switch {
+		default:
+			exec.status = statusUndefined
+			exec.err = err
+
+			exec.log.Debug("could not search for erasure code parts",
+				zap.Stringer("id", members[i]),
+				zap.String("error", err.Error()),
+			)
+
+			return false
+		case err == nil:
+			exec.status = statusOK
+			exec.err = nil
+
+			exec.addMembers(parts)
+		}
+	}
+
+	return true
+}
+
 func (exec *execCtx) addMembers(incoming []*oidSDK.ID) {
 	members := exec.tombstone.Members()
 
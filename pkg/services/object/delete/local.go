@@ -61,6 +61,11 @@ func (exec *execCtx) formTombstone() (ok bool) {
 		return
 	}
 
+	ok = exec.collectErasureParts()
+	if !ok {
+		return
+	}
+
 	exec.log.Debug("members successfully collected")
 
 	ok = exec.initTombstoneObject()
@@ -1,11 +1,14 @@
 package deletesvc
 
 import (
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/owner"
@@ -48,6 +51,9 @@ type cfg struct {
 
 	searcher interface {
 		splitMembers(*execCtx) ([]*oidSDK.ID, error)
+
+		// must return identifiers of the erasure code parts of the object
+		erasureParts(*execCtx, *oidSDK.ID) ([]*oidSDK.ID, error)
 	}
 
 	placer interface {
@@ -55,6 +61,10 @@ type cfg struct {
 	}
 
 	netInfo NetworkInfo
+
+	erasureSchemes interface {
+		scheme(*cid.ID) (*erasure.Scheme, error)
+	}
 }
 
 func defaultCfg() *cfg {
@@ -112,3 +122,15 @@ func WithNetworkInfo(netInfo NetworkInfo) Option {
 		c.netInfo = netInfo
 	}
 }
+
+// WithContainerSource returns option to set container source
+// to remove the parts of the erasure-coded objects.
+//
+// Parts of the erasure-coded objects are not removed if the source is not set.
+func WithContainerSource(src container.Source) Option {
+	return func(c *cfg) {
+		c.erasureSchemes = &cnrSrcWrapper{
+			cnrSrc: src,
+		}
+	}
+}
@@ -3,11 +3,14 @@ package deletesvc
 import (
 	"errors"
 
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	putsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/put"
 	searchsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/search"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -19,6 +22,10 @@ type searchSvcWrapper searchsvc.Service
 
 type putSvcWrapper putsvc.Service
 
+type cnrSrcWrapper struct {
+	cnrSrc container.Source
+}
+
 type simpleIDWriter struct {
 	ids []*oidSDK.ID
 }
@@ -97,6 +104,23 @@ func (w *searchSvcWrapper) splitMembers(exec *execCtx) ([]*oidSDK.ID, error) {
 	return wr.ids, nil
 }
 
+func (w *searchSvcWrapper) erasureParts(exec *execCtx, id *oidSDK.ID) ([]*oidSDK.ID, error) {
+	wr := new(simpleIDWriter)
+
+	p := searchsvc.Prm{}
+	p.SetWriter(wr)
+	p.SetCommonParameters(exec.commonParameters())
+	p.WithContainerID(exec.containerID())
+	p.WithSearchFilters(erasure.SearchFilters(id, -1))
+
+	err := (*searchsvc.Service)(w).Search(exec.context(), p)
+	if err != nil {
+		return nil, err
+	}
+
+	return wr.ids, nil
+}
+
 func (s *simpleIDWriter) WriteIDs(ids []*oidSDK.ID) error {
 	s.ids = append(s.ids, ids...)
 
@@ -136,3 +160,12 @@ func (w *putSvcWrapper) put(exec *execCtx, broadcast bool) (*oidSDK.ID, error) {
 
 	return r.ObjectID(), nil
 }
+
+func (c *cnrSrcWrapper) scheme(id *cid.ID) (*erasure.Scheme, error) {
+	cnr, err := c.cnrSrc.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return erasure.SchemeFromContainer(cnr)
+}
@@ -0,0 +1,160 @@
+package erasure_test
+
+import (
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	meta "github.com/nspcc-dev/neofs-node/pkg/local_object_storage/metabase"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
+	"github.com/nspcc-dev/neofs-node/pkg/util/test"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/stretchr/testify/require"
+)
+
+// testNode is an in-process storage node: object headers are indexed
+// in the metabase, payloads are kept in memory.
+type testNode struct {
+	db *meta.DB
+
+	payloads map[string][]byte
+}
+
+func newTestNode(t *testing.T, name string) *testNode {
+	db := meta.New(
+		meta.WithPath(filepath.Join(t.TempDir(), name)),
+		meta.WithPermissions(0600),
+	)
+
+	require.NoError(t, db.Open())
+	require.NoError(t, db.Init())
+
+	t.Cleanup(func() {
+		_ = db.Close()
+	})
+
+	return &testNode{
+		db:       db,
+		payloads: make(map[string][]byte),
+	}
+}
+
+func (n *testNode) put(t *testing.T, obj *object.Object) {
+	require.NoError(t, meta.Put(n.db, obj, nil))
+
+	n.payloads[obj.Address().String()] = obj.Payload()
+}
+
+func (n *testNode) get(t *testing.T, addr *addressSDK.Address) *object.Object {
+	hdr, err := meta.Get(n.db, addr)
+	require.NoError(t, err)
+
+	obj := object.NewRawFromObject(hdr)
+	obj.SetPayload(n.payloads[addr.String()])
+
+	return obj.Object()
+}
+
+// parts collects the parts of the object stored on the nodes.
+func parts(t *testing.T, nodes []*testNode, obj *object.Object) []*object.Object {
+	var res []*object.Object
+
+	for _, n := range nodes {
+		addrs, err := meta.Select(n.db, obj.ContainerID(), erasure.SearchFilters(obj.ID(), -1))
+		require.NoError(t, err)
+
+		for i := range addrs {
+			res = append(res, n.get(t, addrs[i]))
+		}
+	}
+
+	return res
+}
+
+func TestErasureCodedCluster(t *testing.T) {
+	s, err := erasure.NewScheme(3, 2)
+	require.NoError(t, err)
+
+	nodes := make([]*testNode, s.Total())
+	for i := range nodes {
+		nodes[i] = newTestNode(t, strconv.Itoa(i))
+	}
+
+	obj := generateObject(t, 1000)
+
+	// PUT: i-th node stores i-th part
+	stored, err := erasure.Split(obj, s, test.DecodeKey(-1))
+	require.NoError(t, err)
+
+	for i := range nodes {
+		nodes[i].put(t, stored[i])
+	}
+
+	byAttr := objectSDK.SearchFilters{}
+	byAttr.AddFilter(objectV2.SysAttributeExpEpoch, "100", objectSDK.MatchStringEqual)
+
+	for i := range nodes {
+		// the object is found by its attributes, parts are hidden
+		res, err := meta.Select(nodes[i].db, obj.ContainerID(), byAttr)
+		require.NoError(t, err)
+		require.Equal(t, []*addressSDK.Address{obj.Address()}, res)
+	}
+
+	// lose the parity-many nodes
+	lost := []int{0, 3}
+	alive := []*testNode{nodes[1], nodes[2], nodes[4]}
+
+	// GET
+	res, err := erasure.Join(parts(t, alive, obj))
+	require.NoError(t, err)
+	require.Equal(t, obj.ID(), res.ID())
+	require.Equal(t, obj.Payload(), res.Payload())
+
+	// lost parts are restored by another node with the same identifiers
+	restored, err := erasure.Split(res, s, test.DecodeKey(-1))
+	require.NoError(t, err)
+
+	for _, i := range lost {
+		require.Equal(t, stored[i].ID(), restored[i].ID())
+
+		nodes[i] = newTestNode(t, "restored"+strconv.Itoa(i))
+		nodes[i].put(t, restored[i])
+	}
+
+	// restored part is not duplicated on the repeated restoration
+	nodes[0].put(t, restored[0])
+	require.Len(t, parts(t, nodes, obj), s.Total())
+
+	// DELETE: tombstone contains the object and its parts and is
+	// broadcast to all container nodes
+	members := []*addressSDK.Address{obj.Address()}
+
+	for _, part := range parts(t, nodes, obj) {
+		members = append(members, part.Address())
+	}
+
+	tomb := addressSDK.NewAddress()
+	tomb.SetContainerID(obj.ContainerID())
+	tomb.SetObjectID(generateObject(t, 0).ID())
+
+	for i := range nodes {
+		_, err := nodes[i].db.Inhume(new(meta.InhumePrm).
+			WithAddresses(members...).
+			WithTombstoneAddress(tomb),
+		)
+		require.NoError(t, err)
+
+		res, err := meta.Select(nodes[i].db, obj.ContainerID(), byAttr)
+		require.NoError(t, err)
+		require.Empty(t, res)
+	}
+
+	// the object can not be restored anymore
+	require.Empty(t, parts(t, nodes, obj))
+
+	_, err = erasure.Join(parts(t, nodes, obj))
+	require.ErrorIs(t, err, erasure.ErrNotEnoughParts)
+}
@@ -0,0 +1,194 @@
+package erasure
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotEnoughParts is returned when the number of the available parts
+// is less than the number of data parts of the scheme.
+var ErrNotEnoughParts = errors.New("not enough parts to reconstruct the data")
+
+// Coder is a systematic Reed-Solomon coder over GF(2^8).
+//
+// The data is split into the data shards of equal length, parity shards
+// are calculated so that any combination of the data shards number of
+// shards is enough to reconstruct all the others.
+type Coder struct {
+	data, parity int
+
+	// matrix is an encoding matrix, the top data x data
+	// submatrix of which is an identity matrix.
+	matrix [][]byte
+}
+
+// NewCoder creates, initializes and returns Coder instance
+// for the specified scheme.
+func NewCoder(s *Scheme) *Coder {
+	total := s.Total()
+
+	// Vandermonde matrix has any data x data submatrix invertible,
+	// multiplying it by the inverted top submatrix keeps this property
+	// and makes the code systematic
+	vm := make([][]byte, total)
+
+	for i := range vm {
+		vm[i] = make([]byte, s.data)
+
+		for j := range vm[i] {
+			vm[i][j] = gfPow(byte(i), j)
+		}
+	}
+
+	top, err := invertMatrix(vm[:s.data])
+	if err != nil {
+		// never happens: Vandermonde matrix with distinct rows is invertible
+		panic(err)
+	}
+
+	return &Coder{
+		data:   s.data,
+		parity: s.parity,
+		matrix: mulMatrix(vm, top),
+	}
+}
+
+// ShardLen returns length of each shard of the data of the specified size.
+func (c *Coder) ShardLen(size uint64) int {
+	return int((size + uint64(c.data) - 1) / uint64(c.data))
+}
+
+// Encode splits data into the data shards and calculates parity shards.
+// The last data shard is padded with zeros.
+//
+// Returns data+parity shards of equal length.
+func (c *Coder) Encode(data []byte) [][]byte {
+	ln := c.ShardLen(uint64(len(data)))
+
+	shards := make([][]byte, c.data+c.parity)
+
+	for i := range shards {
+		shards[i] = make([]byte, ln)
+
+		if i < c.data && i*ln < len(data) {
+			copy(shards[i], data[i*ln:])
+		}
+	}
+
+	c.encodeRows(shards, c.data, c.data+c.parity, shards[:c.data])
+
+	return shards
+}
+
+// Reconstruct restores missing shards in place. Missing shards
+// must be nil, present shards must have equal length.
+//
+// Returns ErrNotEnoughParts if less than data shards are present.
+func (c *Coder) Reconstruct(shards [][]byte) error {
+	if len(shards) != c.data+c.parity {
+		return fmt.Errorf("wrong number of shards %d, expected %d", len(shards), c.data+c.parity)
+	}
+
+	var (
+		ln      = -1
+		present = make([]int, 0, c.data)
+		missing bool
+	)
+
+	for i := range shards {
+		if shards[i] == nil {
+			missing = true
+			continue
+		}
+
+		if ln < 0 {
+			ln = len(shards[i])
+		} else if len(shards[i]) != ln {
+			return fmt.Errorf("shard #%d has wrong length %d, expected %d", i, len(shards[i]), ln)
+		}
+
+		if len(present) < c.data {
+			present = append(present, i)
+		}
+	}
+
+	if len(present) < c.data {
+		return ErrNotEnoughParts
+	}
+
+	if !missing {
+		return nil
+	}
+
+	sub := make([][]byte, c.data)
+	src := make([][]byte, c.data)
+
+	for i, ind := range present {
+		sub[i] = c.matrix[ind]
+		src[i] = shards[ind]
+	}
+
+	dec, err := invertMatrix(sub)
+	if err != nil {
+		return fmt.Errorf("could not invert decoding matrix: %w", err)
+	}
+
+	data := make([][]byte, c.data)
+
+	for i := range data {
+		if shards[i] != nil {
+			data[i] = shards[i]
+			continue
+		}
+
+		data[i] = make([]byte, ln)
+
+		for j := range src {
+			gfMulAdd(dec[i][j], src[j], data[i])
+		}
+	}
+
+	for i := c.data; i < len(shards); i++ {
+		if shards[i] == nil {
+			shards[i] = make([]byte, ln)
+			c.encodeRows(shards, i, i+1, data)
+		}
+	}
+
+	copy(shards, data)
+
+	return nil
+}
+
+// Join concatenates data shards and cuts the result to the specified size.
+func (c *Coder) Join(shards [][]byte, size uint64) ([]byte, error) {
+	if len(shards) < c.data {
+		return nil, ErrNotEnoughParts
+	}
+
+	data := make([]byte, 0, c.data*c.ShardLen(size))
+
+	for i := 0; i < c.data; i++ {
+		if shards[i] == nil {
+			return nil, fmt.Errorf("missing data shard #%d", i)
+		}
+
+		data = append(data, shards[i]...)
+	}
+
+	if uint64(len(data)) < size {
+		return nil, fmt.Errorf("data shards are too short: %d < %d", len(data), size)
+	}
+
+	return data[:size], nil
+}
+
+// encodeRows calculates shards [from:to) from data shards
+// using the corresponding rows of the encoding matrix.
+func (c *Coder) encodeRows(shards [][]byte, from, to int, data [][]byte) {
+	for i := from; i < to; i++ {
+		for j := range data {
+			gfMulAdd(c.matrix[i][j], data[j], shards[i])
+		}
+	}
+}
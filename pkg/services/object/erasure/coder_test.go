@@ -0,0 +1,73 @@
+package erasure
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoder(t *testing.T) {
+	for _, tc := range []struct {
+		data, parity int
+		size         int
+	}{
+		{1, 1, 10},
+		{2, 1, 0},
+		{3, 2, 1000},
+		{4, 2, 1 << 16},
+		{10, 4, 12345},
+	} {
+		s, err := NewScheme(tc.data, tc.parity)
+		require.NoError(t, err)
+
+		t.Run(s.String(), func(t *testing.T) {
+			payload := make([]byte, tc.size)
+			_, _ = rand.Read(payload)
+
+			c := NewCoder(s)
+
+			shards := c.Encode(payload)
+			require.Len(t, shards, s.Total())
+
+			for i := range shards {
+				require.Len(t, shards[i], c.ShardLen(uint64(tc.size)))
+			}
+
+			// drop every combination of parity-many consecutive shards
+			for from := 0; from < s.Total(); from++ {
+				broken := make([][]byte, len(shards))
+				copy(broken, shards)
+
+				for i := 0; i < s.Parity(); i++ {
+					broken[(from+i)%s.Total()] = nil
+				}
+
+				require.NoError(t, c.Reconstruct(broken))
+				require.Equal(t, shards, broken)
+
+				res, err := c.Join(broken, uint64(tc.size))
+				require.NoError(t, err)
+				require.Equal(t, payload, res)
+			}
+
+			broken := make([][]byte, len(shards))
+			copy(broken, shards[:s.Data()-1])
+
+			require.ErrorIs(t, c.Reconstruct(broken), ErrNotEnoughParts)
+		})
+	}
+}
+
+func TestParseScheme(t *testing.T) {
+	s, err := ParseScheme("4/2")
+	require.NoError(t, err)
+	require.Equal(t, 4, s.Data())
+	require.Equal(t, 2, s.Parity())
+	require.Equal(t, "4/2", s.String())
+
+	for _, v := range []string{"", "4", "4/", "a/2", "0/2", "4/0", "-1/2", "200/57", "4/2/1"} {
+		_, err := ParseScheme(v)
+		require.Error(t, err, v)
+	}
+}
@@ -0,0 +1,132 @@
+package erasure
+
+import (
+	"errors"
+)
+
+// gfPoly is a primitive polynomial x^8 + x^4 + x^3 + x^2 + 1
+// generating GF(2^8).
+const gfPoly = 0x11d
+
+var (
+	gfExp [2 * 255]byte
+	gfLog [256]byte
+)
+
+func init() {
+	x := 1
+
+	for i := 0; i < 255; i++ {
+		gfExp[i] = byte(x)
+		gfLog[x] = byte(i)
+
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= gfPoly
+		}
+	}
+
+	for i := 255; i < len(gfExp); i++ {
+		gfExp[i] = gfExp[i-255]
+	}
+}
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+// gfInv returns multiplicative inverse of a, a must not be zero.
+func gfInv(a byte) byte {
+	return gfExp[255-int(gfLog[a])]
+}
+
+func gfPow(a byte, n int) byte {
+	switch {
+	case n == 0:
+		return 1
+	case a == 0:
+		return 0
+	default:
+		return gfExp[int(gfLog[a])*n%255]
+	}
+}
+
+// gfMulAdd adds c * src to dst.
+func gfMulAdd(c byte, src, dst []byte) {
+	if c == 0 {
+		return
+	}
+
+	for i := range src {
+		dst[i] ^= gfMul(c, src[i])
+	}
+}
+
+var errSingularMatrix = errors.New("matrix is singular")
+
+// invertMatrix returns inverse of the square matrix m.
+// The source matrix is not changed.
+func invertMatrix(m [][]byte) ([][]byte, error) {
+	n := len(m)
+
+	// augmented matrix [m | I]
+	a := make([][]byte, n)
+
+	for i := range a {
+		a[i] = make([]byte, 2*n)
+		copy(a[i], m[i])
+		a[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for pivot < n && a[pivot][col] == 0 {
+			pivot++
+		}
+
+		if pivot == n {
+			return nil, errSingularMatrix
+		}
+
+		a[col], a[pivot] = a[pivot], a[col]
+
+		if inv := gfInv(a[col][col]); inv != 1 {
+			for j := range a[col] {
+				a[col][j] = gfMul(a[col][j], inv)
+			}
+		}
+
+		for i := 0; i < n; i++ {
+			if i != col && a[i][col] != 0 {
+				gfMulAdd(a[i][col], a[col], a[i])
+			}
+		}
+	}
+
+	res := make([][]byte, n)
+
+	for i := range res {
+		res[i] = a[i][n:]
+	}
+
+	return res, nil
+}
+
+// mulMatrix returns product of the matrices a and b.
+func mulMatrix(a, b [][]byte) [][]byte {
+	res := make([][]byte, len(a))
+
+	for i := range a {
+		res[i] = make([]byte, len(b[0]))
+
+		for j := range b {
+			gfMulAdd(a[i][j], b[j], res[i])
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,372 @@
+package erasure
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/nspcc-dev/tzhash/tz"
+)
+
+// Attributes of the part objects.
+const (
+	// AttributeParent contains identifier of the erasure-coded object.
+	AttributeParent = object.AttributeECParent
+
+	// AttributeIndex contains index of the part, data parts go first.
+	AttributeIndex = object.AttributeECIndex
+
+	// AttributeScheme contains erasure code scheme in "<data>/<parity>" format.
+	AttributeScheme = object.AttributeECScheme
+
+	// AttributeHeader contains base64-encoded header of the erasure-coded object.
+	AttributeHeader = object.AttributeECHeader
+)
+
+// ErrNotPart is returned when object is not a part of the erasure-coded object.
+var ErrNotPart = errors.New("object is not an erasure code part")
+
+// Encodable checks if the object is erasure-coded in the containers with
+// erasure coding enabled.
+//
+// Only regular objects are erasure-coded, including the children of
+// the split objects. Linking objects are replicated since they carry
+// no payload and list the children of the parent, parts are not encoded
+// again.
+func Encodable(obj *object.Object) bool {
+	return obj.Type() == objectSDK.TypeRegular && len(obj.Children()) == 0 && !IsPart(obj)
+}
+
+// IsPart checks if the object is a part of the erasure-coded object.
+func IsPart(obj *object.Object) bool {
+	return object.IsErasureCodePart(obj)
+}
+
+// PartInfo groups erasure code information of the part object.
+type PartInfo struct {
+	parent *oidSDK.ID
+
+	index int
+
+	scheme *Scheme
+
+	header string
+}
+
+// ReadPartInfo reads erasure code information from the part object header.
+//
+// Returns ErrNotPart if object is not a part of the erasure-coded object.
+func ReadPartInfo(obj *object.Object) (*PartInfo, error) {
+	var (
+		info PartInfo
+		err  error
+	)
+
+	info.index = -1
+
+	for _, a := range obj.Attributes() {
+		switch a.Key() {
+		case AttributeParent:
+			info.parent = oidSDK.NewID()
+
+			if err = info.parent.Parse(a.Value()); err != nil {
+				return nil, fmt.Errorf("invalid parent ID: %w", err)
+			}
+		case AttributeIndex:
+			info.index, err = strconv.Atoi(a.Value())
+			if err != nil {
+				return nil, fmt.Errorf("invalid part index: %w", err)
+			}
+		case AttributeScheme:
+			info.scheme, err = ParseScheme(a.Value())
+			if err != nil {
+				return nil, err
+			}
+		case AttributeHeader:
+			info.header = a.Value()
+		}
+	}
+
+	switch {
+	case info.parent == nil:
+		return nil, ErrNotPart
+	case info.scheme == nil:
+		return nil, errors.New("missing erasure code scheme")
+	case info.index < 0 || info.index >= info.scheme.Total():
+		return nil, fmt.Errorf("invalid part index %d", info.index)
+	case info.header == "":
+		return nil, errors.New("missing parent header")
+	}
+
+	return &info, nil
+}
+
+// Parent returns identifier of the erasure-coded object.
+func (i *PartInfo) Parent() *oidSDK.ID {
+	return i.parent
+}
+
+// Index returns index of the part.
+func (i *PartInfo) Index() int {
+	return i.index
+}
+
+// Scheme returns erasure code scheme of the object.
+func (i *PartInfo) Scheme() *Scheme {
+	return i.scheme
+}
+
+// ParentHeader decodes and verifies the header of the erasure-coded object.
+func (i *PartInfo) ParentHeader() (*object.Object, error) {
+	data, err := base64.StdEncoding.DecodeString(i.header)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode parent header: %w", err)
+	}
+
+	hdr := object.New()
+
+	if err := hdr.Unmarshal(data); err != nil {
+		return nil, fmt.Errorf("could not unmarshal parent header: %w", err)
+	}
+
+	if err := objectSDK.CheckHeaderVerificationFields(hdr.SDK()); err != nil {
+		return nil, fmt.Errorf("invalid parent header: %w", err)
+	}
+
+	if !hdr.ID().Equal(i.parent) {
+		return nil, errors.New("parent header does not match parent ID")
+	}
+
+	return hdr, nil
+}
+
+// VerifyPart checks the header verification fields and the payload
+// checksum of the part object.
+func VerifyPart(part *object.Object) error {
+	if err := objectSDK.CheckHeaderVerificationFields(part.SDK()); err != nil {
+		return fmt.Errorf("invalid part header: %w", err)
+	}
+
+	return verifyPayload(part, part.Payload())
+}
+
+// Split encodes payload of the object and returns its parts signed
+// with the key. Parts inherit the owner and other header fields from
+// the object, so the part identifiers do not depend on the key: the
+// parts restored by any node are the same objects.
+func Split(obj *object.Object, s *Scheme, key *ecdsa.PrivateKey) ([]*object.Object, error) {
+	hdr, err := object.NewRawFromObject(obj).CutPayload().Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal object header: %w", err)
+	}
+
+	common := []*objectSDK.Attribute{
+		newAttribute(AttributeParent, obj.ID().String()),
+		newAttribute(AttributeScheme, s.String()),
+		newAttribute(AttributeHeader, base64.StdEncoding.EncodeToString(hdr)),
+	}
+
+	for _, a := range obj.Attributes() {
+		// parts are removed along with the object
+		if a.Key() == objectV2.SysAttributeExpEpoch {
+			common = append(common, newAttribute(a.Key(), a.Value()))
+		}
+	}
+
+	var (
+		shards = NewCoder(s).Encode(obj.Payload())
+		parts  = make([]*object.Object, len(shards))
+	)
+
+	for i := range shards {
+		part := object.NewRaw()
+		part.SetVersion(version.Current())
+		part.SetContainerID(obj.ContainerID())
+		part.SetOwnerID(obj.OwnerID())
+		part.SetCreationEpoch(obj.CreationEpoch())
+		part.SetType(objectSDK.TypeRegular)
+		part.SetPayload(shards[i])
+		part.SetPayloadSize(uint64(len(shards[i])))
+		part.SetPayloadChecksum(sha256Checksum(shards[i]))
+
+		attrs := make([]*objectSDK.Attribute, 0, len(common)+1)
+		attrs = append(attrs, common...)
+		attrs = append(attrs, newAttribute(AttributeIndex, strconv.Itoa(i)))
+
+		part.SetAttributes(attrs...)
+
+		if err := objectSDK.SetIDWithSignature(key, part.SDK()); err != nil {
+			return nil, fmt.Errorf("could not sign part #%d: %w", i, err)
+		}
+
+		parts[i] = part.Object()
+	}
+
+	return parts, nil
+}
+
+// Join reconstructs the erasure-coded object from its parts.
+// Parts must belong to the same object, duplicates are allowed.
+//
+// Returns ErrNotEnoughParts if the number of distinct parts is less
+// than the number of data parts.
+func Join(parts []*object.Object) (*object.Object, error) {
+	if len(parts) == 0 {
+		return nil, ErrNotEnoughParts
+	}
+
+	first, err := ReadPartInfo(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	hdr, err := first.ParentHeader()
+	if err != nil {
+		return nil, err
+	}
+
+	shards := make([][]byte, first.scheme.Total())
+
+	for i := range parts {
+		info, err := ReadPartInfo(parts[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.parent.Equal(first.parent) || info.scheme.String() != first.scheme.String() {
+			return nil, errors.New("parts belong to different objects")
+		}
+
+		if payload := parts[i].Payload(); payload != nil {
+			shards[info.index] = payload
+		} else {
+			shards[info.index] = []byte{}
+		}
+	}
+
+	c := NewCoder(first.scheme)
+
+	if err := c.Reconstruct(shards); err != nil {
+		return nil, err
+	}
+
+	payload, err := c.Join(shards, hdr.PayloadSize())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyPayload(hdr, payload); err != nil {
+		return nil, err
+	}
+
+	obj := object.NewRawFromObject(hdr)
+	obj.SetPayload(payload)
+
+	return obj.Object(), nil
+}
+
+// Nodes returns the nodes to store the parts of the object with the
+// specified placement vectors: i-th part is stored on the i-th node.
+// Nodes are taken in the placement order, nodes in maintenance are skipped.
+//
+// Returns an error if there are not enough nodes.
+func Nodes(vectors []netmap.Nodes, s *Scheme) (netmap.Nodes, error) {
+	var (
+		res  = make(netmap.Nodes, 0, s.Total())
+		seen = make(map[string]struct{}, s.Total())
+	)
+
+	for i := range vectors {
+		for j := range vectors[i] {
+			if len(res) == s.Total() {
+				return res, nil
+			}
+
+			if netmapcore.IsMaintenance(vectors[i][j].NodeInfo) {
+				continue
+			}
+
+			key := hex.EncodeToString(vectors[i][j].PublicKey())
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			res = append(res, vectors[i][j])
+		}
+	}
+
+	if len(res) < s.Total() {
+		return nil, fmt.Errorf("not enough nodes to store %d parts: %d", s.Total(), len(res))
+	}
+
+	return res, nil
+}
+
+// SearchFilters returns filters to search the parts of the object.
+// Negative index matches parts with any index.
+func SearchFilters(parent *oidSDK.ID, index int) objectSDK.SearchFilters {
+	fs := objectSDK.NewSearchFilters()
+	fs.AddFilter(AttributeParent, parent.String(), objectSDK.MatchStringEqual)
+
+	if index >= 0 {
+		fs.AddFilter(AttributeIndex, strconv.Itoa(index), objectSDK.MatchStringEqual)
+	}
+
+	return fs
+}
+
+func newAttribute(key, value string) *objectSDK.Attribute {
+	a := objectSDK.NewAttribute()
+	a.SetKey(key)
+	a.SetValue(value)
+
+	return a
+}
+
+func sha256Checksum(data []byte) *checksum.Checksum {
+	cs := checksum.New()
+	cs.SetSHA256(sha256.Sum256(data))
+
+	return cs
+}
+
+// verifyPayload checks payload checksums of the object header.
+func verifyPayload(hdr *object.Object, payload []byte) error {
+	cs := hdr.PayloadChecksum()
+	if cs == nil {
+		return errors.New("missing payload checksum")
+	}
+
+	var sum []byte
+
+	switch typ := cs.Type(); typ {
+	default:
+		return fmt.Errorf("unsupported payload checksum type %v", typ)
+	case checksum.SHA256:
+		h := sha256.Sum256(payload)
+		sum = h[:]
+	case checksum.TZ:
+		h := tz.Sum(payload)
+		sum = h[:]
+	}
+
+	if !bytes.Equal(sum, cs.Sum()) {
+		return errors.New("incorrect payload checksum")
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+package erasure_test
+
+import (
+	"crypto/sha256"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	objectV2 "github.com/nspcc-dev/neofs-api-go/v2/object"
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
+	"github.com/nspcc-dev/neofs-node/pkg/util/test"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	"github.com/nspcc-dev/neofs-sdk-go/owner"
+	"github.com/nspcc-dev/neofs-sdk-go/version"
+	"github.com/stretchr/testify/require"
+)
+
+func generateObject(t *testing.T, size int) *object.Object {
+	key := test.DecodeKey(-1)
+
+	payload := make([]byte, size)
+	_, _ = rand.Read(payload)
+
+	cs := checksum.New()
+	cs.SetSHA256(sha256.Sum256(payload))
+
+	exp := objectSDK.NewAttribute()
+	exp.SetKey(objectV2.SysAttributeExpEpoch)
+	exp.SetValue("100")
+
+	obj := object.NewRaw()
+	obj.SetVersion(version.Current())
+	obj.SetContainerID(cidtest.ID())
+	obj.SetOwnerID(owner.NewIDFromPublicKey(&key.PublicKey))
+	obj.SetCreationEpoch(10)
+	obj.SetPayload(payload)
+	obj.SetPayloadSize(uint64(size))
+	obj.SetPayloadChecksum(cs)
+	obj.SetAttributes(exp)
+
+	require.NoError(t, objectSDK.SetIDWithSignature(key, obj.SDK()))
+
+	return obj.Object()
+}
+
+func TestSplitJoin(t *testing.T) {
+	s, err := erasure.NewScheme(3, 2)
+	require.NoError(t, err)
+
+	obj := generateObject(t, 1000)
+	key := test.DecodeKey(-1)
+
+	parts, err := erasure.Split(obj, s, key)
+	require.NoError(t, err)
+	require.Len(t, parts, s.Total())
+
+	for i := range parts {
+		require.True(t, erasure.IsPart(parts[i]))
+		require.False(t, erasure.Encodable(parts[i]))
+		require.NoError(t, objectSDK.CheckHeaderVerificationFields(parts[i].SDK()))
+		require.True(t, parts[i].OwnerID().Equal(obj.OwnerID()))
+		require.Equal(t, obj.ContainerID(), parts[i].ContainerID())
+
+		info, err := erasure.ReadPartInfo(parts[i])
+		require.NoError(t, err)
+		require.Equal(t, i, info.Index())
+		require.Equal(t, obj.ID(), info.Parent())
+		require.Equal(t, s.String(), info.Scheme().String())
+
+		hdr, err := info.ParentHeader()
+		require.NoError(t, err)
+		require.Equal(t, obj.ID(), hdr.ID())
+
+		var exp string
+
+		for _, a := range parts[i].Attributes() {
+			if a.Key() == objectV2.SysAttributeExpEpoch {
+				exp = a.Value()
+			}
+		}
+
+		require.Equal(t, "100", exp)
+	}
+
+	t.Run("deterministic IDs", func(t *testing.T) {
+		other, err := erasure.Split(obj, s, test.DecodeKey(-1))
+		require.NoError(t, err)
+
+		for i := range parts {
+			require.Equal(t, parts[i].ID(), other[i].ID())
+			require.NotEqual(t, parts[i].Signature().Key(), other[i].Signature().Key())
+		}
+	})
+
+	t.Run("any data parts", func(t *testing.T) {
+		for _, ind := range [][]int{{0, 1, 2}, {2, 3, 4}, {0, 2, 4}, {4, 1, 3, 1}} {
+			list := make([]*object.Object, 0, len(ind))
+			for _, i := range ind {
+				list = append(list, parts[i])
+			}
+
+			res, err := erasure.Join(list)
+			require.NoError(t, err)
+			require.Equal(t, obj.ID(), res.ID())
+			require.Equal(t, obj.Payload(), res.Payload())
+		}
+	})
+
+	t.Run("not enough parts", func(t *testing.T) {
+		_, err := erasure.Join(parts[3:])
+		require.ErrorIs(t, err, erasure.ErrNotEnoughParts)
+
+		_, err = erasure.Join([]*object.Object{parts[0], parts[0], parts[0]})
+		require.ErrorIs(t, err, erasure.ErrNotEnoughParts)
+	})
+
+	t.Run("different objects", func(t *testing.T) {
+		other, err := erasure.Split(generateObject(t, 1000), s, key)
+		require.NoError(t, err)
+
+		_, err = erasure.Join([]*object.Object{parts[0], parts[1], other[2]})
+		require.Error(t, err)
+	})
+
+	t.Run("corrupted part", func(t *testing.T) {
+		raw := object.NewRawFromObject(parts[0])
+		payload := append([]byte{}, raw.Payload()...)
+		payload[0]++
+
+		corrupted := object.NewRaw()
+		corrupted.SetAttributes(raw.Attributes()...)
+		corrupted.SetPayload(payload)
+
+		_, err = erasure.Join([]*object.Object{corrupted.Object(), parts[1], parts[2]})
+		require.Error(t, err)
+	})
+
+	t.Run("not a part", func(t *testing.T) {
+		require.True(t, erasure.Encodable(obj))
+
+		child := object.NewRaw()
+		child.SetContainerID(obj.ContainerID())
+		child.SetParent(obj.SDK())
+
+		// the last child of the split object is erasure-coded
+		require.True(t, erasure.Encodable(child.Object()))
+
+		// the linking object is replicated
+		child.SetChildren(obj.ID())
+		require.False(t, erasure.Encodable(child.Object()))
+
+		_, err := erasure.ReadPartInfo(obj)
+		require.ErrorIs(t, err, erasure.ErrNotPart)
+	})
+}
+
+func TestNodes(t *testing.T) {
+	infos := make([]netmap.NodeInfo, 5)
+
+	for i := range infos {
+		infos[i] = *netmap.NewNodeInfo()
+		infos[i].SetPublicKey([]byte(strconv.Itoa(i)))
+	}
+
+	netmapcore.SetMaintenance(&infos[2], 1)
+
+	nodes := netmap.NodesFromInfo(infos)
+
+	s, err := erasure.NewScheme(2, 1)
+	require.NoError(t, err)
+
+	vectors := []netmap.Nodes{
+		{nodes[0], nodes[1]},
+		{nodes[1], nodes[2], nodes[3], nodes[4]},
+	}
+
+	res, err := erasure.Nodes(vectors, s)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+
+	for i, exp := range []string{"0", "1", "3"} {
+		require.Equal(t, exp, string(res[i].PublicKey()))
+	}
+
+	_, err = erasure.Nodes(vectors[:1], s)
+	require.Error(t, err)
+}
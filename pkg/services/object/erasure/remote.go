@@ -0,0 +1,138 @@
+package erasure
+
+import (
+	"context"
+	"fmt"
+
+	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+)
+
+// ClientConstructor is an interface of NeoFS API client constructor.
+type ClientConstructor interface {
+	Get(clientcore.NodeInfo) (clientcore.Client, error)
+}
+
+// RemoteStorage represents utility for searching, reading and saving
+// the parts of erasure-coded objects stored on the remote hosts.
+type RemoteStorage struct {
+	keyStorage *util.KeyStorage
+
+	clientCache ClientConstructor
+}
+
+const remoteOpTTL = 1
+
+// NewRemoteStorage creates, initializes and returns new RemoteStorage instance.
+func NewRemoteStorage(keyStorage *util.KeyStorage, cache ClientConstructor) *RemoteStorage {
+	return &RemoteStorage{
+		keyStorage:  keyStorage,
+		clientCache: cache,
+	}
+}
+
+// SearchParts returns identifiers of the parts of the erasure-coded object
+// stored on the remote node. Negative index matches parts with any index.
+func (s *RemoteStorage) SearchParts(ctx context.Context, node *netmap.NodeInfo, parent *addressSDK.Address, index int) ([]*oidSDK.ID, error) {
+	key, err := s.keyStorage.GetKey(nil)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not receive private key: %w", s, err)
+	}
+
+	c, info, err := s.client(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.SearchObjectsPrm
+
+	prm.SetContext(ctx)
+	prm.SetClient(c)
+	prm.SetPrivateKey(key)
+	prm.SetTTL(remoteOpTTL)
+	prm.SetContainerID(parent.ContainerID())
+	prm.SetFilters(SearchFilters(parent.ObjectID(), index))
+
+	res, err := internalclient.SearchObjects(prm)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not search parts in %s: %w", s, info.AddressGroup(), err)
+	}
+
+	return res.IDList(), nil
+}
+
+// GetObject reads the object from the remote node.
+func (s *RemoteStorage) GetObject(ctx context.Context, node *netmap.NodeInfo, addr *addressSDK.Address) (*object.Object, error) {
+	key, err := s.keyStorage.GetKey(nil)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not receive private key: %w", s, err)
+	}
+
+	c, info, err := s.client(node)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.GetObjectPrm
+
+	prm.SetContext(ctx)
+	prm.SetClient(c)
+	prm.SetPrivateKey(key)
+	prm.SetTTL(remoteOpTTL)
+	prm.SetAddress(addr)
+
+	res, err := internalclient.GetObject(prm)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not get object from %s: %w", s, info.AddressGroup(), err)
+	}
+
+	return object.NewFromSDK(res.Object()), nil
+}
+
+// PutObject saves the object on the remote node.
+func (s *RemoteStorage) PutObject(ctx context.Context, node *netmap.NodeInfo, obj *object.Object) error {
+	key, err := s.keyStorage.GetKey(nil)
+	if err != nil {
+		return fmt.Errorf("(%T) could not receive private key: %w", s, err)
+	}
+
+	c, info, err := s.client(node)
+	if err != nil {
+		return err
+	}
+
+	var prm internalclient.PutObjectPrm
+
+	prm.SetContext(ctx)
+	prm.SetClient(c)
+	prm.SetPrivateKey(key)
+	prm.SetObject(obj.SDK())
+
+	_, err = internalclient.PutObject(prm)
+	if err != nil {
+		return fmt.Errorf("(%T) could not put object to %s: %w", s, info.AddressGroup(), err)
+	}
+
+	return nil
+}
+
+func (s *RemoteStorage) client(node *netmap.NodeInfo) (clientcore.Client, clientcore.NodeInfo, error) {
+	var info clientcore.NodeInfo
+
+	err := clientcore.NodeInfoFromRawNetmapElement(&info, node)
+	if err != nil {
+		return nil, info, fmt.Errorf("parse client node info: %w", err)
+	}
+
+	c, err := s.clientCache.Get(info)
+	if err != nil {
+		return nil, info, fmt.Errorf("(%T) could not create SDK client %s: %w", s, info.AddressGroup(), err)
+	}
+
+	return c, info, nil
+}
@@ -0,0 +1,93 @@
+package erasure
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+)
+
+// ContainerAttribute is a container attribute which enables erasure coding
+// of the container objects instead of the replication. The value has
+// "<data>/<parity>" format, e.g. "4/2".
+const ContainerAttribute = "__NEOFS__ERASURE_CODE"
+
+// MaxParts is the maximum total number of data and parity parts.
+const MaxParts = 256
+
+// Scheme describes the number of data and parity parts
+// the object is split into.
+type Scheme struct {
+	data, parity int
+}
+
+var errInvalidScheme = errors.New("invalid erasure code scheme")
+
+// NewScheme creates, initializes and returns Scheme instance.
+//
+// Both numbers must be positive, their sum must not exceed MaxParts.
+func NewScheme(data, parity int) (*Scheme, error) {
+	if data <= 0 || parity <= 0 || data+parity > MaxParts {
+		return nil, fmt.Errorf("%w: %d data and %d parity parts", errInvalidScheme, data, parity)
+	}
+
+	return &Scheme{
+		data:   data,
+		parity: parity,
+	}, nil
+}
+
+// ParseScheme parses Scheme from the "<data>/<parity>" string.
+func ParseScheme(s string) (*Scheme, error) {
+	ss := strings.Split(s, "/")
+	if len(ss) != 2 {
+		return nil, fmt.Errorf("%w: %q", errInvalidScheme, s)
+	}
+
+	data, err := strconv.Atoi(ss[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid number of data parts: %v", errInvalidScheme, err)
+	}
+
+	parity, err := strconv.Atoi(ss[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid number of parity parts: %v", errInvalidScheme, err)
+	}
+
+	return NewScheme(data, parity)
+}
+
+// SchemeFromContainer returns erasure code scheme of the container objects.
+//
+// Returns nil if erasure coding is not enabled in the container.
+func SchemeFromContainer(cnr *container.Container) (*Scheme, error) {
+	for _, a := range cnr.Attributes() {
+		if a.Key() == ContainerAttribute {
+			return ParseScheme(a.Value())
+		}
+	}
+
+	return nil, nil
+}
+
+// Data returns number of data parts.
+func (s *Scheme) Data() int {
+	return s.data
+}
+
+// Parity returns number of parity parts.
+func (s *Scheme) Parity() int {
+	return s.parity
+}
+
+// Total returns total number of parts.
+func (s *Scheme) Total() int {
+	return s.data + s.parity
+}
+
+// String returns string representation of Scheme in "<data>/<parity>" format.
+func (s *Scheme) String() string {
+	return strconv.Itoa(s.data) + "/" + strconv.Itoa(s.parity)
+}
@@ -2,6 +2,7 @@ package getsvc
 
 import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
@@ -21,6 +22,23 @@ func (exec *execCtx) assemble() {
 
 	exec.log.Debug("trying to assemble the object...")
 
+	// child requests are traced as a part of the assembly
+	parentCtx := exec.ctx
+
+	var span *tracing.Span
+
+	exec.ctx, span = tracing.StartSpan(parentCtx, "getsvc.assemble")
+
+	defer func() {
+		if exec.status != statusOK {
+			span.SetError(exec.err)
+		}
+
+		span.End()
+
+		exec.ctx = parentCtx
+	}()
+
 	splitInfo := exec.splitInfo()
 
 	childID := splitInfo.Link()
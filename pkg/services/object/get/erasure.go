@@ -0,0 +1,258 @@
+package getsvc
+
+import (
+	"context"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
+	"go.uber.org/zap"
+)
+
+// canRestore checks if the requested object can be restored
+// from the erasure code parts.
+func (exec *execCtx) canRestore() bool {
+	if exec.isLocal() || exec.isRaw() || exec.svc.erasureSchemes == nil {
+		return false
+	}
+
+	scheme, err := exec.svc.erasureSchemes.scheme(exec.containerID())
+	if err != nil {
+		exec.log.Debug("could not get erasure code scheme of the container",
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+
+	return scheme != nil
+}
+
+// restore collects the parts of the erasure-coded object from the container
+// nodes and reconstructs the object from them.
+func (exec *execCtx) restore() {
+	if !exec.canRestore() {
+		return
+	}
+
+	// parts are requested directly from the nodes storing them
+	exec.disableForwarding()
+
+	exec.log.Debug("trying to restore erasure-coded object...")
+
+	if ok := exec.initEpoch(); !ok {
+		return
+	}
+
+	traverser, ok := exec.generateTraverser(exec.address())
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(exec.context())
+	defer cancel()
+
+	var (
+		parts []*object.Object
+		seen  = make(map[int]struct{})
+		data  int
+	)
+
+	for {
+		addrs := traverser.Next()
+		if len(addrs) == 0 {
+			break
+		}
+
+		for i := range addrs {
+			select {
+			case <-ctx.Done():
+				exec.log.Debug("interrupt placement iteration by context",
+					zap.String("error", ctx.Err().Error()),
+				)
+
+				return
+			default:
+			}
+
+			var info client.NodeInfo
+
+			client.NodeInfoFromNetmapElement(&info, addrs[i])
+
+			for _, part := range exec.collectParts(info) {
+				partInfo, err := erasure.ReadPartInfo(part)
+				if err != nil || !partInfo.Parent().Equal(exec.address().ObjectID()) {
+					exec.log.Debug("received object is not a part of the requested object")
+					continue
+				}
+
+				if _, ok := seen[partInfo.Index()]; ok {
+					continue
+				}
+
+				if exec.headOnly() {
+					seen[partInfo.Index()] = struct{}{}
+
+					exec.writeParentHeader(partInfo)
+
+					if exec.status == statusOK {
+						return
+					}
+
+					continue
+				}
+
+				// corrupted copy must not prevent the valid one from being used
+				if err := erasure.VerifyPart(part); err != nil {
+					exec.log.Debug("received invalid part of the requested object",
+						zap.String("error", err.Error()),
+					)
+
+					continue
+				}
+
+				seen[partInfo.Index()] = struct{}{}
+				data = partInfo.Scheme().Data()
+				parts = append(parts, part)
+
+				if len(parts) < data {
+					continue
+				}
+
+				obj, err := joinParts(parts, data)
+				if err != nil {
+					exec.log.Debug("could not restore the object from parts",
+						zap.Int("parts", len(parts)),
+						zap.String("error", err.Error()),
+					)
+
+					continue
+				}
+
+				exec.writeRestoredObject(obj)
+
+				return
+			}
+		}
+	}
+
+	exec.status = statusUndefined
+	exec.err = object.ErrNotFound
+
+	exec.log.Debug("not enough parts to restore the object",
+		zap.Int("parts", len(parts)),
+	)
+}
+
+// joinParts restores the object from the subsets of data parts which
+// include the last part: the subsets without it have already been tried.
+func joinParts(parts []*object.Object, data int) (*object.Object, error) {
+	var (
+		last   = parts[len(parts)-1]
+		subset = make([]*object.Object, 0, data)
+		obj    *object.Object
+		err    error
+	)
+
+	var try func(from int) bool
+
+	try = func(from int) bool {
+		if len(subset) == data-1 {
+			obj, err = erasure.Join(append(subset, last))
+			return err == nil
+		}
+
+		for i := from; i < len(parts)-1; i++ {
+			subset = append(subset, parts[i])
+
+			if try(i + 1) {
+				return true
+			}
+
+			subset = subset[:len(subset)-1]
+		}
+
+		return false
+	}
+
+	if !try(0) {
+		if err == nil {
+			err = erasure.ErrNotEnoughParts
+		}
+
+		return nil, err
+	}
+
+	return obj, nil
+}
+
+// collectParts reads all parts of the requested object stored on the node.
+func (exec *execCtx) collectParts(info client.NodeInfo) []*object.Object {
+	c, ok := exec.remoteClient(info)
+	if !ok {
+		return nil
+	}
+
+	ids, err := c.searchParts(exec, info)
+	if err != nil {
+		exec.log.Debug("could not search parts on the node",
+			zap.String("error", err.Error()),
+		)
+
+		return nil
+	}
+
+	res := make([]*object.Object, 0, len(ids))
+
+	for i := range ids {
+		part, err := c.getPart(exec, info, ids[i])
+		if err != nil {
+			exec.log.Debug("could not get part from the node",
+				zap.Stringer("part ID", ids[i]),
+				zap.String("error", err.Error()),
+			)
+
+			continue
+		}
+
+		res = append(res, object.NewFromSDK(part))
+	}
+
+	return res
+}
+
+func (exec *execCtx) writeParentHeader(info *erasure.PartInfo) {
+	hdr, err := info.ParentHeader()
+	if err != nil {
+		exec.status = statusUndefined
+		exec.err = err
+
+		exec.log.Debug("could not decode parent header",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	exec.collectedObject = hdr
+	exec.writeCollectedHeader()
+}
+
+func (exec *execCtx) writeRestoredObject(obj *object.Object) {
+	if rng := exec.ctxRange(); rng != nil {
+		from := rng.GetOffset()
+		to := from + rng.GetLength()
+
+		if pLen := obj.PayloadSize(); to < from || pLen < from || pLen < to {
+			exec.status = statusOutOfRange
+			exec.err = object.ErrRangeOutOfBounds
+
+			return
+		}
+
+		obj = object.NewFromSDK(payloadOnlyObject(obj.Payload()[from:to]))
+	}
+
+	exec.collectedObject = obj
+	exec.writeCollectedObject()
+}
@@ -65,16 +65,19 @@ func withPayloadRange(r *objectSDK.Range) execOption {
 	}
 }
 
-func (exec *execCtx) setLogger(l *logger.Logger) {
-	req := "GET"
+func (exec *execCtx) requestType() string {
 	if exec.headOnly() {
-		req = "HEAD"
+		return "HEAD"
 	} else if exec.ctxRange() != nil {
-		req = "GET_RANGE"
+		return "GET_RANGE"
 	}
 
+	return "GET"
+}
+
+func (exec *execCtx) setLogger(l *logger.Logger) {
 	exec.log = l.With(
-		zap.String("request", req),
+		zap.String("request", exec.requestType()),
 		zap.Stringer("address", exec.address()),
 		zap.Bool("raw", exec.isRaw()),
 		zap.Bool("local", exec.isLocal()),
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
 )
@@ -78,8 +79,21 @@ func (s *Service) get(ctx context.Context, prm commonPrm, opts ...execOption) st
 
 	exec.setLogger(s.log)
 
+	var span *tracing.Span
+
+	exec.ctx, span = tracing.StartSpan(exec.ctx, "getsvc."+exec.requestType(),
+		tracing.Attribute{Key: "address", Value: exec.address()},
+		tracing.Attribute{Key: "raw", Value: exec.isRaw()},
+		tracing.Attribute{Key: "local", Value: exec.isLocal()},
+	)
+	defer span.End()
+
 	exec.execute()
 
+	if exec.status != statusOK {
+		span.SetError(exec.err)
+	}
+
 	return exec.statusError
 }
 
@@ -112,6 +126,8 @@ func (exec *execCtx) analyzeStatus(execCnr bool) {
 		if execCnr {
 			exec.executeOnContainer()
 			exec.analyzeStatus(false)
+		} else {
+			exec.restore()
 		}
 	}
 }
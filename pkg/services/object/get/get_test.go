@@ -12,9 +12,12 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	testkey "github.com/nspcc-dev/neofs-node/pkg/util/test"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
@@ -22,6 +25,7 @@ import (
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
+	"github.com/nspcc-dev/neofs-sdk-go/owner"
 	"github.com/stretchr/testify/require"
 )
 
@@ -55,6 +59,12 @@ type testClient struct {
 
 type testEpochReceiver uint64
 
+type testErasureSchemes map[string]*erasure.Scheme
+
+func (s testErasureSchemes) scheme(id *cid.ID) (*erasure.Scheme, error) {
+	return s[id.String()], nil
+}
+
 func (e testEpochReceiver) currentEpoch() (uint64, error) {
 	return uint64(e), nil
 }
@@ -103,7 +113,7 @@ func newTestClient() *testClient {
 	}
 }
 
-func (c *testClient) getObject(exec *execCtx, _ client.NodeInfo) (*objectSDK.Object, error) {
+func (c *testClient) getObject(_ context.Context, exec *execCtx, _ client.NodeInfo) (*objectSDK.Object, error) {
 	v, ok := c.results[exec.address().String()]
 	if !ok {
 		return nil, object.ErrNotFound
@@ -116,6 +126,36 @@ func (c *testClient) getObject(exec *execCtx, _ client.NodeInfo) (*objectSDK.Obj
 	return cutToRange(v.obj.Object(), exec.ctxRange()).SDK(), nil
 }
 
+func (c *testClient) searchParts(exec *execCtx, _ client.NodeInfo) ([]*oidSDK.ID, error) {
+	var res []*oidSDK.ID
+
+	for _, v := range c.results {
+		if v.obj == nil {
+			continue
+		}
+
+		info, err := erasure.ReadPartInfo(v.obj.Object())
+		if err == nil && info.Parent().Equal(exec.address().ObjectID()) {
+			res = append(res, v.obj.ID())
+		}
+	}
+
+	return res, nil
+}
+
+func (c *testClient) getPart(exec *execCtx, _ client.NodeInfo, id *oidSDK.ID) (*objectSDK.Object, error) {
+	addr := addressSDK.NewAddress()
+	addr.SetContainerID(exec.containerID())
+	addr.SetObjectID(id)
+
+	v, ok := c.results[addr.String()]
+	if !ok {
+		return nil, object.ErrNotFound
+	}
+
+	return v.obj.Object().SDK(), v.err
+}
+
 func (c *testClient) addResult(addr *addressSDK.Address, obj *object.RawObject, err error) {
 	c.results[addr.String()] = struct {
 		obj *object.RawObject
@@ -1232,3 +1272,201 @@ func TestGetFromPastEpoch(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, obj.CutPayload().Object(), w.Object())
 }
+
+func TestGetErasureCoded(t *testing.T) {
+	ctx := context.Background()
+
+	cnr := container.New(container.WithPolicy(new(netmap.PlacementPolicy)))
+	cid := container.CalculateID(cnr)
+
+	scheme, err := erasure.NewScheme(2, 1)
+	require.NoError(t, err)
+
+	key := testkey.DecodeKey(-1)
+
+	payload := make([]byte, 100)
+	_, _ = rand.Read(payload)
+
+	cs := checksum.New()
+	cs.SetSHA256(sha256.Sum256(payload))
+
+	raw := object.NewRaw()
+	raw.SetContainerID(cid)
+	raw.SetOwnerID(owner.NewIDFromPublicKey(&key.PublicKey))
+	raw.SetPayload(payload)
+	raw.SetPayloadSize(uint64(len(payload)))
+	raw.SetPayloadChecksum(cs)
+
+	require.NoError(t, objectSDK.SetIDWithSignature(key, raw.SDK()))
+
+	obj := raw.Object()
+
+	parts, err := erasure.Split(obj, scheme, key)
+	require.NoError(t, err)
+
+	addr := obj.Address()
+
+	ns, as := testNodeMatrix(t, []int{3})
+
+	clients := make(map[string]*testClient, len(parts))
+
+	for i := range parts {
+		c := newTestClient()
+		c.addResult(parts[i].Address(), object.NewRawFromObject(parts[i]), nil)
+
+		clients[as[0][i]] = c
+	}
+
+	// the first node is unavailable
+	delete(clients, as[0][0])
+
+	const curEpoch = 13
+
+	svc := &Service{cfg: new(cfg)}
+	svc.log = test.NewLogger(false)
+	svc.localStorage = newTestStorage()
+	svc.assembly = true
+	svc.traverserGenerator = &testTraverserGenerator{
+		c: cnr,
+		b: map[uint64]placement.Builder{
+			curEpoch: &testPlacementBuilder{
+				vectors: map[string][]netmap.Nodes{
+					addr.String(): ns,
+				},
+			},
+		},
+	}
+	svc.clientCache = &testClientCache{
+		clients: clients,
+	}
+	svc.currentEpochReceiver = testEpochReceiver(curEpoch)
+
+	t.Run("not erasure-coded", func(t *testing.T) {
+		p := Prm{}
+		p.SetObjectWriter(NewSimpleObjectWriter())
+		p.common = new(util.CommonPrm)
+		p.WithAddress(addr)
+
+		err := svc.Get(ctx, p)
+		require.True(t, errors.Is(err, object.ErrNotFound))
+	})
+
+	svc.erasureSchemes = testErasureSchemes{
+		cid.String(): scheme,
+	}
+
+	t.Run("GET", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+
+		p := Prm{}
+		p.SetObjectWriter(w)
+		p.common = new(util.CommonPrm)
+		p.WithAddress(addr)
+
+		err := svc.Get(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, obj.ID(), w.Object().ID())
+		require.Equal(t, payload, w.Object().Payload())
+	})
+
+	t.Run("HEAD", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+
+		p := HeadPrm{}
+		p.SetHeaderWriter(w)
+		p.common = new(util.CommonPrm)
+		p.WithAddress(addr)
+
+		err := svc.Head(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, obj.ID(), w.Object().ID())
+		require.Equal(t, obj.PayloadSize(), w.Object().PayloadSize())
+		require.Empty(t, w.Object().Payload())
+	})
+
+	t.Run("GETRANGE", func(t *testing.T) {
+		w := NewSimpleObjectWriter()
+
+		p := RangePrm{}
+		p.SetChunkWriter(w)
+		p.common = new(util.CommonPrm)
+		p.WithAddress(addr)
+
+		r := objectSDK.NewRange()
+		r.SetOffset(10)
+		r.SetLength(20)
+
+		p.SetRange(r)
+
+		err := svc.GetRange(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, payload[10:30], w.Object().Payload())
+
+		r.SetLength(uint64(len(payload)))
+
+		err = svc.GetRange(ctx, p)
+		require.True(t, errors.Is(err, object.ErrRangeOutOfBounds))
+	})
+
+	t.Run("corrupted parts", func(t *testing.T) {
+		copyPart := func(i int) *object.RawObject {
+			data, err := parts[i].Marshal()
+			require.NoError(t, err)
+
+			part := object.New()
+			require.NoError(t, part.Unmarshal(data))
+
+			return object.NewRawFromObject(part)
+		}
+
+		// payload does not match the part checksum
+		corrupted := copyPart(0)
+		corrupted.Payload()[0]++
+
+		// signed part with the wrong payload breaks the reconstruction
+		forgedPayload := make([]byte, len(parts[2].Payload()))
+		_, _ = rand.Read(forgedPayload)
+
+		cs := checksum.New()
+		cs.SetSHA256(sha256.Sum256(forgedPayload))
+
+		forged := copyPart(2)
+		forged.SetPayload(forgedPayload)
+		forged.SetPayloadChecksum(cs)
+
+		require.NoError(t, objectSDK.SetIDWithSignature(key, forged.SDK()))
+
+		c := newTestClient()
+		c.addResult(corrupted.Object().Address(), corrupted, nil)
+		c.addResult(forged.Object().Address(), forged, nil)
+		clients[as[0][0]] = c
+
+		// the valid part is stored on the last node
+		c = newTestClient()
+		c.addResult(parts[0].Address(), object.NewRawFromObject(parts[0]), nil)
+		clients[as[0][2]] = c
+
+		w := NewSimpleObjectWriter()
+
+		p := Prm{}
+		p.SetObjectWriter(w)
+		p.common = new(util.CommonPrm)
+		p.WithAddress(addr)
+
+		err := svc.Get(ctx, p)
+		require.NoError(t, err)
+		require.Equal(t, payload, w.Object().Payload())
+	})
+
+	t.Run("not enough parts", func(t *testing.T) {
+		delete(clients, as[0][1])
+
+		p := Prm{}
+		p.SetObjectWriter(NewSimpleObjectWriter())
+		p.common = new(util.CommonPrm)
+		p.WithAddress(addr)
+
+		err := svc.Get(ctx, p)
+		require.True(t, errors.Is(err, object.ErrNotFound))
+	})
+}
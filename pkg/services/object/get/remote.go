@@ -2,10 +2,12 @@ package getsvc
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	"go.uber.org/zap"
 )
@@ -18,7 +20,13 @@ func (exec *execCtx) processNode(ctx context.Context, info client.NodeInfo) bool
 		return true
 	}
 
-	obj, err := client.getObject(exec, info)
+	ctx, span := tracing.StartSpanWithKind(ctx, "getsvc.processNode", tracing.SpanKindClient,
+		tracing.Attribute{Key: "node", Value: hex.EncodeToString(info.PublicKey())},
+	)
+	defer span.End()
+
+	obj, err := client.getObject(ctx, exec, info)
+	span.SetError(err)
 
 	var errSplitInfo *objectSDK.SplitInfoError
 
@@ -1,17 +1,22 @@
 package getsvc
 
 import (
+	"context"
 	"crypto/ecdsa"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 	"github.com/nspcc-dev/neofs-sdk-go/session"
 	"go.uber.org/zap"
 )
@@ -25,7 +30,11 @@ type Service struct {
 type Option func(*cfg)
 
 type getClient interface {
-	getObject(*execCtx, client.NodeInfo) (*objectSDK.Object, error)
+	getObject(context.Context, *execCtx, client.NodeInfo) (*objectSDK.Object, error)
+
+	searchParts(*execCtx, client.NodeInfo) ([]*oidSDK.ID, error)
+
+	getPart(*execCtx, client.NodeInfo, *oidSDK.ID) (*objectSDK.Object, error)
 }
 
 type cfg struct {
@@ -52,6 +61,10 @@ type cfg struct {
 	keyStore interface {
 		GetKey(token *session.Token) (*ecdsa.PrivateKey, error)
 	}
+
+	erasureSchemes interface {
+		scheme(*cid.ID) (*erasure.Scheme, error)
+	}
 }
 
 func defaultCfg() *cfg {
@@ -135,3 +148,15 @@ func WithKeyStorage(store *util.KeyStorage) Option {
 		c.keyStore = store
 	}
 }
+
+// WithContainerSource returns option to set container source
+// to restore the objects of the erasure-coded containers.
+//
+// Erasure-coded objects are not restored if the source is not set.
+func WithContainerSource(src container.Source) Option {
+	return func(c *cfg) {
+		c.erasureSchemes = &cnrSrcWrapper{
+			cnrSrc: src,
+		}
+	}
+}
@@ -1,15 +1,21 @@
 package getsvc
 
 import (
+	"context"
 	"io"
 
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	internal "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	oidSDK "github.com/nspcc-dev/neofs-sdk-go/object/id"
 )
 
 type SimpleObjectWriter struct {
@@ -46,6 +52,10 @@ type nmSrcWrapper struct {
 	nmSrc netmap.Source
 }
 
+type cnrSrcWrapper struct {
+	cnrSrc container.Source
+}
+
 func NewSimpleObjectWriter() *SimpleObjectWriter {
 	return &SimpleObjectWriter{
 		obj: object.NewRaw(),
@@ -84,7 +94,7 @@ func (c *clientCacheWrapper) get(info coreclient.NodeInfo) (getClient, error) {
 	}, nil
 }
 
-func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*objectSDK.Object, error) {
+func (c *clientWrapper) getObject(ctx context.Context, exec *execCtx, info coreclient.NodeInfo) (*objectSDK.Object, error) {
 	if exec.isForwardingEnabled() {
 		return exec.prm.forwarder(info, c.client)
 	}
@@ -97,7 +107,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 	if exec.headOnly() {
 		var prm internalclient.HeadObjectPrm
 
-		prm.SetContext(exec.context())
+		prm.SetContext(ctx)
 		prm.SetClient(c.client)
 		prm.SetTTL(exec.prm.common.TTL())
 		prm.SetNetmapEpoch(exec.curProcEpoch)
@@ -123,7 +133,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 	if rng := exec.ctxRange(); rng != nil {
 		var prm internalclient.PayloadRangePrm
 
-		prm.SetContext(exec.context())
+		prm.SetContext(ctx)
 		prm.SetClient(c.client)
 		prm.SetTTL(exec.prm.common.TTL())
 		prm.SetNetmapEpoch(exec.curProcEpoch)
@@ -148,7 +158,7 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 
 	var prm internalclient.GetObjectPrm
 
-	prm.SetContext(exec.context())
+	prm.SetContext(ctx)
 	prm.SetClient(c.client)
 	prm.SetTTL(exec.prm.common.TTL())
 	prm.SetNetmapEpoch(exec.curProcEpoch)
@@ -170,9 +180,62 @@ func (c *clientWrapper) getObject(exec *execCtx, info coreclient.NodeInfo) (*obj
 	return res.Object(), nil
 }
 
+func (c *clientWrapper) searchParts(exec *execCtx, _ coreclient.NodeInfo) ([]*oidSDK.ID, error) {
+	key, err := exec.svc.keyStore.GetKey(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var prm internalclient.SearchObjectsPrm
+
+	prm.SetContext(exec.context())
+	prm.SetClient(c.client)
+	prm.SetTTL(1)
+	prm.SetNetmapEpoch(exec.curProcEpoch)
+	prm.SetPrivateKey(key)
+	prm.SetContainerID(exec.containerID())
+	prm.SetFilters(erasure.SearchFilters(exec.address().ObjectID(), -1))
+
+	res, err := internalclient.SearchObjects(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.IDList(), nil
+}
+
+func (c *clientWrapper) getPart(exec *execCtx, _ coreclient.NodeInfo, id *oidSDK.ID) (*objectSDK.Object, error) {
+	key, err := exec.svc.keyStore.GetKey(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addr := addressSDK.NewAddress()
+	addr.SetContainerID(exec.containerID())
+	addr.SetObjectID(id)
+
+	var prm internalclient.GetObjectPrm
+
+	prm.SetContext(exec.context())
+	prm.SetClient(c.client)
+	prm.SetTTL(1)
+	prm.SetNetmapEpoch(exec.curProcEpoch)
+	prm.SetAddress(addr)
+	prm.SetPrivateKey(key)
+	prm.SetRawFlag()
+
+	res, err := internalclient.GetObject(prm)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.Object(), nil
+}
+
 func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 	if exec.headOnly() {
 		r, err := e.engine.Head(new(engine.HeadPrm).
+			WithContext(exec.context()).
 			WithAddress(exec.address()).
 			WithRaw(exec.isRaw()),
 		)
@@ -183,6 +246,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		return r.Header(), nil
 	} else if rng := exec.ctxRange(); rng != nil {
 		r, err := e.engine.GetRange(new(engine.RngPrm).
+			WithContext(exec.context()).
 			WithAddress(exec.address()).
 			WithPayloadRange(rng),
 		)
@@ -193,6 +257,7 @@ func (e *storageEngineWrapper) get(exec *execCtx) (*object.Object, error) {
 		return r.Object(), nil
 	} else {
 		r, err := e.engine.Get(new(engine.GetPrm).
+			WithContext(exec.context()).
 			WithAddress(exec.address()),
 		)
 		if err != nil {
@@ -226,3 +291,12 @@ func (h *hasherWrapper) WriteChunk(p []byte) error {
 func (n *nmSrcWrapper) currentEpoch() (uint64, error) {
 	return n.nmSrc.Epoch()
 }
+
+func (c *cnrSrcWrapper) scheme(id *cid.ID) (*erasure.Scheme, error) {
+	cnr, err := c.cnrSrc.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return erasure.SchemeFromContainer(cnr)
+}
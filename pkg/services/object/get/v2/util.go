@@ -22,6 +22,7 @@ import (
 	getsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/get"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/internal"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
 	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
 	signature2 "github.com/nspcc-dev/neofs-sdk-go/util/signature"
@@ -78,7 +79,7 @@ func (s *Service) toPrm(req *objectV2.GetRequest, stream objectSvc.GetObjectStre
 			// perhaps it is worth highlighting the utility function in neofs-api-go
 
 			// open stream
-			stream, err := rpc.GetObject(c.RawForAddress(addr), req, rpcclient.WithContext(stream.Context()))
+			stream, err := rpc.GetObject(c.RawForAddress(addr), req, rpcclient.WithContext(tracing.InjectOutgoing(stream.Context())))
 			if err != nil {
 				return nil, fmt.Errorf("stream opening failed: %w", err)
 			}
@@ -203,7 +204,7 @@ func (s *Service) toRangePrm(req *objectV2.GetRangeRequest, stream objectSvc.Get
 			// perhaps it is worth highlighting the utility function in neofs-api-go
 
 			// open stream
-			stream, err := rpc.GetObjectRange(c.RawForAddress(addr), req, rpcclient.WithContext(stream.Context()))
+			stream, err := rpc.GetObjectRange(c.RawForAddress(addr), req, rpcclient.WithContext(tracing.InjectOutgoing(stream.Context())))
 			if err != nil {
 				return nil, fmt.Errorf("could not create Get payload range stream: %w", err)
 			}
@@ -363,7 +364,7 @@ func (s *Service) toHeadPrm(ctx context.Context, req *objectV2.HeadRequest, resp
 			// perhaps it is worth highlighting the utility function in neofs-api-go
 
 			// send Head request
-			resp, err := rpc.HeadObject(c.RawForAddress(addr), req, rpcclient.WithContext(ctx))
+			resp, err := rpc.HeadObject(c.RawForAddress(addr), req, rpcclient.WithContext(tracing.InjectOutgoing(ctx)))
 			if err != nil {
 				return nil, fmt.Errorf("sending the request failed: %w", err)
 			}
@@ -7,6 +7,7 @@ import (
 
 	session2 "github.com/nspcc-dev/neofs-api-go/v2/session"
 	coreclient "github.com/nspcc-dev/neofs-node/pkg/core/client"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/client"
 	apistatus "github.com/nspcc-dev/neofs-sdk-go/client/status"
 	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
@@ -33,10 +34,11 @@ func (x *commonPrm) SetClient(cli coreclient.Client) {
 }
 
 // SetContext sets context.Context for network communication.
+// Tracing span context from ctx is passed to the remote node.
 //
 // Required parameter.
 func (x *commonPrm) SetContext(ctx context.Context) {
-	x.ctx = ctx
+	x.ctx = tracing.InjectOutgoing(ctx)
 }
 
 // SetPrivateKey sets private key to sign the request(s).
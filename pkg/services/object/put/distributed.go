@@ -1,11 +1,13 @@
 package putsvc
 
 import (
+	"crypto/ecdsa"
 	"fmt"
 	"sync"
 	"sync/atomic"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
@@ -31,6 +33,10 @@ type distributedTarget struct {
 	fmt *object.FormatValidator
 
 	log *logger.Logger
+
+	erasure *erasure.Scheme
+
+	nodeKey func() (*ecdsa.PrivateKey, error)
 }
 
 type nodeDesc struct {
@@ -85,6 +91,10 @@ func (t *distributedTarget) Close() (*transformer.AccessIdentifiers, error) {
 		return nil, fmt.Errorf("(%T) could not validate payload content: %w", t, err)
 	}
 
+	if t.erasure != nil && erasure.Encodable(t.obj.Object()) {
+		return t.putErasureCoded()
+	}
+
 	return t.iteratePlacement(t.sendObject)
 }
 
@@ -93,9 +103,13 @@ func (t *distributedTarget) sendObject(node nodeDesc) error {
 		return t.relay(node)
 	}
 
+	return t.writeObject(node, t.obj)
+}
+
+func (t *distributedTarget) writeObject(node nodeDesc, obj *object.RawObject) error {
 	target := t.nodeTargetInitializer(node)
 
-	if err := target.WriteHeader(t.obj); err != nil {
+	if err := target.WriteHeader(obj); err != nil {
 		return fmt.Errorf("could not write header: %w", err)
 	} else if _, err := target.Close(); err != nil {
 		return fmt.Errorf("could not close object stream: %w", err)
@@ -0,0 +1,159 @@
+package putsvc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
+	svcutil "github.com/nspcc-dev/neofs-node/pkg/services/object/util"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+)
+
+// putErasureCoded splits the object into erasure code parts and saves
+// i-th part on the i-th container node of the object placement.
+//
+// If the object is signed by the client and the local node is not
+// a container node, the object is relayed as is to one of the container
+// nodes which encodes it by itself.
+func (t *distributedTarget) putErasureCoded() (*transformer.AccessIdentifiers, error) {
+	nodes, err := t.erasureNodes()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.relay != nil && !t.containsLocal(nodes) {
+		for i := range nodes {
+			if err = t.relay(nodeDesc{info: nodes[i]}); err == nil {
+				return new(transformer.AccessIdentifiers).
+					WithSelfID(t.obj.ID()), nil
+			}
+
+			svcutil.LogServiceError(t.log, "PUT", nodes[i].Addresses(), err)
+		}
+
+		return nil, errIncompletePut{singleErr: err}
+	}
+
+	key, err := t.nodeKey()
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not receive private key: %w", t, err)
+	}
+
+	parts, err := erasure.Split(t.obj.Object(), t.erasure, key)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not split object into erasure code parts: %w", t, err)
+	}
+
+	var (
+		resErr atomic.Value
+		wg     sync.WaitGroup
+	)
+
+	for i := range nodes {
+		wg.Add(1)
+
+		node := nodeDesc{
+			local: t.isLocalKey(nodes[i].PublicKey()),
+			info:  nodes[i],
+		}
+
+		part := object.NewRawFromObject(parts[i])
+
+		var workerPool util.WorkerPool
+
+		if node.local {
+			workerPool = t.localPool
+		} else {
+			workerPool = t.remotePool
+		}
+
+		if err := workerPool.Submit(func() {
+			defer wg.Done()
+
+			if err := t.writeObject(node, part); err != nil {
+				resErr.Store(err)
+				svcutil.LogServiceError(t.log, "PUT", node.info.Addresses(), err)
+			}
+		}); err != nil {
+			wg.Done()
+
+			resErr.Store(err)
+			svcutil.LogWorkerPoolError(t.log, "PUT", err)
+
+			break
+		}
+	}
+
+	wg.Wait()
+
+	// all parts must be saved, otherwise the object is not protected
+	// against the loss of the declared number of nodes
+	if err, ok := resErr.Load().(error); ok {
+		return nil, errIncompletePut{singleErr: err}
+	}
+
+	return new(transformer.AccessIdentifiers).
+		WithSelfID(t.obj.ID()), nil
+}
+
+// erasureNodes returns the nodes to store the parts of the object:
+// distinct nodes in the placement order.
+func (t *distributedTarget) erasureNodes() ([]placement.Node, error) {
+	traverser, err := placement.NewTraverser(
+		append(t.traverseOpts,
+			placement.ForObject(t.obj.ID()),
+			placement.WithoutSuccessTracking(),
+		)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("(%T) could not create object placement traverser: %w", t, err)
+	}
+
+	var (
+		total = t.erasure.Total()
+		nodes = make([]placement.Node, 0, total)
+		seen  = make(map[string]struct{}, total)
+	)
+
+	for len(nodes) < total {
+		addrs := traverser.Next()
+		if len(addrs) == 0 {
+			break
+		}
+
+		for i := range addrs {
+			if len(nodes) == total {
+				break
+			}
+
+			key := hex.EncodeToString(addrs[i].PublicKey())
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			nodes = append(nodes, addrs[i])
+		}
+	}
+
+	if len(nodes) < total {
+		return nil, fmt.Errorf("(%T) not enough container nodes to store %d parts: %d", t, total, len(nodes))
+	}
+
+	return nodes, nil
+}
+
+func (t *distributedTarget) containsLocal(nodes []placement.Node) bool {
+	for i := range nodes {
+		if t.isLocalKey(nodes[i].PublicKey()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,200 @@
+package putsvc
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"math/rand"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	"github.com/nspcc-dev/neofs-node/pkg/util"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger/test"
+	testkey "github.com/nspcc-dev/neofs-node/pkg/util/test"
+	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	"github.com/nspcc-dev/neofs-sdk-go/container"
+	cidtest "github.com/nspcc-dev/neofs-sdk-go/container/id/test"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	objectSDK "github.com/nspcc-dev/neofs-sdk-go/object"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"github.com/nspcc-dev/neofs-sdk-go/owner"
+	"github.com/stretchr/testify/require"
+)
+
+type testPlacementBuilder struct {
+	vectors []netmap.Nodes
+}
+
+func (b testPlacementBuilder) BuildPlacement(*addressSDK.Address, *netmap.PlacementPolicy) ([]netmap.Nodes, error) {
+	return b.vectors, nil
+}
+
+type testNodeTarget struct {
+	obj *object.RawObject
+
+	err error
+
+	store func(*object.RawObject)
+}
+
+func (t *testNodeTarget) WriteHeader(obj *object.RawObject) error {
+	t.obj = obj
+	return nil
+}
+
+func (t *testNodeTarget) Write(p []byte) (int, error) {
+	return len(p), nil
+}
+
+func (t *testNodeTarget) Close() (*transformer.AccessIdentifiers, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+
+	t.store(t.obj)
+
+	return new(transformer.AccessIdentifiers).WithSelfID(t.obj.ID()), nil
+}
+
+func TestDistributedTarget_ErasureCoded(t *testing.T) {
+	const nodeNum = 4
+
+	infos := make([]netmap.NodeInfo, nodeNum)
+
+	for i := range infos {
+		infos[i] = *netmap.NewNodeInfo()
+		infos[i].SetPublicKey([]byte{byte(i)})
+		infos[i].SetAddresses("/ip4/127.0.0.1/tcp/" + strconv.Itoa(8000+i))
+	}
+
+	r := netmap.NewReplica()
+	r.SetCount(nodeNum)
+
+	policy := netmap.NewPlacementPolicy()
+	policy.SetReplicas(r)
+
+	scheme, err := erasure.NewScheme(2, 1)
+	require.NoError(t, err)
+
+	key := testkey.DecodeKey(-1)
+
+	payload := make([]byte, 1000)
+	_, _ = rand.Read(payload)
+
+	cs := checksum.New()
+	cs.SetSHA256(sha256.Sum256(payload))
+
+	hdr := object.NewRaw()
+	hdr.SetContainerID(cidtest.ID())
+	hdr.SetOwnerID(owner.NewIDFromPublicKey(&key.PublicKey))
+	hdr.SetPayloadSize(uint64(len(payload)))
+	hdr.SetPayloadChecksum(cs)
+
+	require.NoError(t, objectSDK.SetIDWithSignature(key, hdr.SDK()))
+
+	newTarget := func(stored map[byte][]*object.Object, failed byte) *distributedTarget {
+		var mtx sync.Mutex
+
+		return &distributedTarget{
+			traverseOpts: []placement.Option{
+				placement.ForContainer(container.New(container.WithPolicy(policy))),
+				placement.UseBuilder(testPlacementBuilder{
+					vectors: []netmap.Nodes{netmap.NodesFromInfo(infos)},
+				}),
+			},
+			remotePool: util.NewPseudoWorkerPool(),
+			localPool:  util.NewPseudoWorkerPool(),
+			nodeTargetInitializer: func(node nodeDesc) transformer.ObjectTarget {
+				nodeKey := node.info.PublicKey()[0]
+
+				tt := &testNodeTarget{
+					store: func(obj *object.RawObject) {
+						mtx.Lock()
+						stored[nodeKey] = append(stored[nodeKey], obj.Object())
+						mtx.Unlock()
+					},
+				}
+
+				if nodeKey == failed {
+					tt.err = errors.New("node failure")
+				}
+
+				return tt
+			},
+			isLocalKey: func(key []byte) bool {
+				return key[0] == 0
+			},
+			fmt:     object.NewFormatValidator(),
+			log:     test.NewLogger(false),
+			erasure: scheme,
+			nodeKey: func() (*ecdsa.PrivateKey, error) {
+				return key, nil
+			},
+		}
+	}
+
+	put := func(dt *distributedTarget) (*transformer.AccessIdentifiers, error) {
+		require.NoError(t, dt.WriteHeader(object.NewRawFromObject(hdr.Object())))
+
+		_, err := dt.Write(payload)
+		require.NoError(t, err)
+
+		return dt.Close()
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		stored := make(map[byte][]*object.Object)
+
+		ids, err := put(newTarget(stored, nodeNum))
+		require.NoError(t, err)
+		require.Equal(t, hdr.ID(), ids.SelfID())
+
+		// each of the first nodes stores exactly one part
+		require.Len(t, stored, scheme.Total())
+
+		var parts []*object.Object
+
+		for i := 0; i < scheme.Total(); i++ {
+			require.Len(t, stored[byte(i)], 1)
+
+			info, err := erasure.ReadPartInfo(stored[byte(i)][0])
+			require.NoError(t, err)
+			require.Equal(t, i, info.Index())
+
+			parts = append(parts, stored[byte(i)][0])
+		}
+
+		for i := range parts {
+			// any data-many parts are enough
+			rest := append(append([]*object.Object{}, parts[:i]...), parts[i+1:]...)
+
+			obj, err := erasure.Join(rest)
+			require.NoError(t, err)
+			require.Equal(t, hdr.ID(), obj.ID())
+			require.Equal(t, payload, obj.Payload())
+		}
+	})
+
+	t.Run("node failure", func(t *testing.T) {
+		stored := make(map[byte][]*object.Object)
+
+		_, err := put(newTarget(stored, 1))
+		require.ErrorAs(t, err, new(errIncompletePut))
+	})
+
+	t.Run("not enough nodes", func(t *testing.T) {
+		many, err := erasure.NewScheme(nodeNum, 1)
+		require.NoError(t, err)
+
+		dt := newTarget(make(map[byte][]*object.Object), nodeNum)
+		dt.erasure = many
+
+		_, err = put(dt)
+		require.Error(t, err)
+	})
+}
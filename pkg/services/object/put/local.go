@@ -1,6 +1,7 @@
 package putsvc
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
@@ -9,6 +10,8 @@ import (
 )
 
 type localTarget struct {
+	ctx context.Context
+
 	storage *engine.StorageEngine
 
 	obj *object.RawObject
@@ -31,7 +34,11 @@ func (t *localTarget) Write(p []byte) (n int, err error) {
 }
 
 func (t *localTarget) Close() (*transformer.AccessIdentifiers, error) {
-	if err := engine.Put(t.storage, t.obj.Object()); err != nil {
+	_, err := t.storage.Put(new(engine.PutPrm).
+		WithContext(t.ctx).
+		WithObject(t.obj.Object()),
+	)
+	if err != nil {
 		return nil, fmt.Errorf("(%T) could not put object to local storage: %w", t, err)
 	}
 
@@ -3,6 +3,7 @@ package putsvc
 import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 )
@@ -15,6 +16,8 @@ type PutInitPrm struct {
 	traverseOpts []placement.Option
 
 	relay func(client.NodeInfo, client.MultiAddressClient) error
+
+	erasure *erasure.Scheme
 }
 
 type PutChunkPrm struct {
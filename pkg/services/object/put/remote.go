@@ -2,6 +2,7 @@ package putsvc
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 
 	clientcore "github.com/nspcc-dev/neofs-node/pkg/core/client"
@@ -9,6 +10,7 @@ import (
 	internalclient "github.com/nspcc-dev/neofs-node/pkg/services/object/internal/client"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
 )
 
@@ -60,9 +62,14 @@ func (t *remoteTarget) Close() (*transformer.AccessIdentifiers, error) {
 		return nil, fmt.Errorf("(%T) could not create SDK client %s: %w", t, t.nodeInfo, err)
 	}
 
+	ctx, span := tracing.StartSpanWithKind(t.ctx, "putsvc.remotePut", tracing.SpanKindClient,
+		tracing.Attribute{Key: "node", Value: hex.EncodeToString(t.nodeInfo.PublicKey())},
+	)
+	defer span.End()
+
 	var prm internalclient.PutObjectPrm
 
-	prm.SetContext(t.ctx)
+	prm.SetContext(ctx)
 	prm.SetClient(c)
 	prm.SetPrivateKey(key)
 	prm.SetSessionToken(t.commonPrm.SessionToken())
@@ -72,6 +79,7 @@ func (t *remoteTarget) Close() (*transformer.AccessIdentifiers, error) {
 
 	res, err := internalclient.PutObject(prm)
 	if err != nil {
+		span.SetError(err)
 		return nil, fmt.Errorf("(%T) could not put object to %s: %w", t, t.nodeInfo.AddressGroup(), err)
 	}
 
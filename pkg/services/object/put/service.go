@@ -73,6 +73,11 @@ func NewService(opts ...Option) *Service {
 		opts[i](c)
 	}
 
+	c.fmtValidatorOpts = append(c.fmtValidatorOpts, object.WithContainerNodes(&containerNodes{
+		cnrSrc:    c.cnrSrc,
+		netMapSrc: c.netMapSrc,
+	}))
+
 	c.fmtValidator = object.NewFormatValidator(c.fmtValidatorOpts...)
 
 	return &Service{
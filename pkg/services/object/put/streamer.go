@@ -2,11 +2,14 @@ package putsvc
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"errors"
 	"fmt"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/client"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
@@ -28,6 +31,8 @@ var errNotInit = errors.New("stream not initialized")
 
 var errInitRecall = errors.New("init recall")
 
+var errReservedAttribute = errors.New("erasure code attributes are reserved")
+
 func (p *Streamer) Init(prm *PutInitPrm) error {
 	// initialize destination target
 	if err := p.initTarget(prm); err != nil {
@@ -77,6 +82,13 @@ func (p *Streamer) initTarget(prm *PutInitPrm) error {
 		return nil
 	}
 
+	// erasure code parts are produced by the container nodes only
+	for _, a := range prm.hdr.Attributes() {
+		if object.IsErasureCodeAttribute(a.Key()) {
+			return fmt.Errorf("(%T) %w: %s", p, errReservedAttribute, a.Key())
+		}
+	}
+
 	sToken := prm.common.SessionToken()
 
 	// prepare trusted-Put object target
@@ -135,6 +147,12 @@ func (p *Streamer) preparePrm(prm *PutInitPrm) error {
 
 		// use local-only placement builder
 		builder = util.NewLocalPlacement(builder, p.netmapKeys)
+	} else {
+		// objects saved locally are encoded by the policer
+		prm.erasure, err = erasure.SchemeFromContainer(cnr)
+		if err != nil {
+			return fmt.Errorf("(%T) could not read erasure code scheme of the container: %w", p, err)
+		}
 	}
 
 	// set placement builder
@@ -167,6 +185,7 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 		nodeTargetInitializer: func(node nodeDesc) transformer.ObjectTarget {
 			if node.local {
 				return &localTarget{
+					ctx:     p.ctx,
 					storage: p.localStore,
 				}
 			}
@@ -182,9 +201,13 @@ func (p *Streamer) newCommonTarget(prm *PutInitPrm) transformer.ObjectTarget {
 
 			return rt
 		},
-		relay: relay,
-		fmt:   p.fmtValidator,
-		log:   p.log,
+		relay:   relay,
+		fmt:     p.fmtValidator,
+		log:     p.log,
+		erasure: prm.erasure,
+		nodeKey: func() (*ecdsa.PrivateKey, error) {
+			return p.keyStorage.GetKey(nil)
+		},
 
 		isLocalKey: p.netmapKeys.IsLocalKey,
 	}
@@ -7,9 +7,14 @@ import (
 	"fmt"
 	"hash"
 
+	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/core/object"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/transformer"
 	"github.com/nspcc-dev/neofs-sdk-go/checksum"
+	containerSDK "github.com/nspcc-dev/neofs-sdk-go/container"
+	cid "github.com/nspcc-dev/neofs-sdk-go/container/id"
+	netmapSDK "github.com/nspcc-dev/neofs-sdk-go/netmap"
 	"github.com/nspcc-dev/tzhash/tz"
 )
 
@@ -112,3 +117,53 @@ func (t *validatingTarget) Close() (*transformer.AccessIdentifiers, error) {
 
 	return t.nextTarget.Close()
 }
+
+// containerNodes checks the signers of the erasure code parts.
+type containerNodes struct {
+	cnrSrc container.Source
+
+	netMapSrc netmap.Source
+}
+
+// IsContainerNode checks if the node with the key is a container node
+// in the current or the previous epoch: parts can be restored in-between
+// epoch change.
+func (x *containerNodes) IsContainerNode(key []byte, id *cid.ID) (bool, error) {
+	cnr, err := x.cnrSrc.Get(id)
+	if err != nil {
+		return false, fmt.Errorf("could not get container: %w", err)
+	}
+
+	nm, err := netmap.GetLatestNetworkMap(x.netMapSrc)
+	if err != nil {
+		return false, fmt.Errorf("could not get latest network map: %w", err)
+	}
+
+	if ok, err := isContainerNode(nm, cnr, id, key); err != nil || ok {
+		return ok, err
+	}
+
+	nm, err = netmap.GetPreviousNetworkMap(x.netMapSrc)
+	if err != nil {
+		return false, fmt.Errorf("could not get previous network map: %w", err)
+	}
+
+	return isContainerNode(nm, cnr, id, key)
+}
+
+func isContainerNode(nm *netmapSDK.Netmap, cnr *containerSDK.Container, id *cid.ID, key []byte) (bool, error) {
+	nodes, err := nm.GetContainerNodes(cnr.PlacementPolicy(), id.ToV2().GetValue())
+	if err != nil {
+		return false, fmt.Errorf("could not build container nodes: %w", err)
+	}
+
+	flat := nodes.Flatten()
+
+	for i := range flat {
+		if bytes.Equal(flat[i].PublicKey(), key) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -3,6 +3,7 @@ package searchsvc
 import (
 	"context"
 
+	"github.com/nspcc-dev/neofs-node/pkg/util/tracing"
 	"go.uber.org/zap"
 )
 
@@ -18,8 +19,18 @@ func (s *Service) Search(ctx context.Context, prm Prm) error {
 
 	exec.setLogger(s.log)
 
+	var span *tracing.Span
+
+	exec.ctx, span = tracing.StartSpan(exec.ctx, "searchsvc.Search",
+		tracing.Attribute{Key: "container", Value: exec.containerID()},
+		tracing.Attribute{Key: "local", Value: exec.isLocal()},
+	)
+	defer span.End()
+
 	exec.execute()
 
+	span.SetError(exec.statusError.err)
+
 	return exec.statusError.err
 }
 
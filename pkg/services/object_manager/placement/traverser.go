@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
@@ -82,6 +83,8 @@ func NewTraverser(opts ...Option) (*Traverser, error) {
 		return nil, fmt.Errorf("could not build placement: %w", err)
 	}
 
+	ns = skipMaintenance(ns)
+
 	var rem []int
 	if cfg.flatSuccess != nil {
 		ns = flatNodes(ns)
@@ -106,6 +109,24 @@ func NewTraverser(opts ...Option) (*Traverser, error) {
 	}, nil
 }
 
+// skipMaintenance returns placement vectors without the nodes
+// in maintenance mode. Source vectors are not changed.
+func skipMaintenance(ns []netmap.Nodes) []netmap.Nodes {
+	res := make([]netmap.Nodes, len(ns))
+
+	for i := range ns {
+		res[i] = make(netmap.Nodes, 0, len(ns[i]))
+
+		for j := range ns[i] {
+			if !netmapcore.IsMaintenance(ns[i][j].NodeInfo) {
+				res[i] = append(res[i], ns[i][j])
+			}
+		}
+	}
+
+	return res
+}
+
 func flatNodes(ns []netmap.Nodes) []netmap.Nodes {
 	sz := 0
 	for i := range ns {
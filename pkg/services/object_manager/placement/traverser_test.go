@@ -4,6 +4,7 @@ import (
 	"strconv"
 	"testing"
 
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/network"
 	"github.com/nspcc-dev/neofs-sdk-go/container"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
@@ -202,4 +203,28 @@ func TestTraverserObjectScenarios(t *testing.T) {
 		// common success
 		require.True(t, tr.Success())
 	})
+
+	t.Run("maintenance scenario", func(t *testing.T) {
+		selectors := []int{3}
+		replicas := []int{2}
+
+		nodes, cnr := testPlacement(t, selectors, replicas)
+
+		netmapcore.SetMaintenance(nodes[0][0].NodeInfo, 1)
+
+		tr, err := NewTraverser(
+			ForContainer(cnr),
+			UseBuilder(&testBuilder{vectors: copyVectors(nodes)}),
+		)
+		require.NoError(t, err)
+
+		addrs := tr.Next()
+		require.Len(t, addrs, replicas[0])
+
+		for j := range addrs {
+			assertSameAddress(t, nodes[0][j+1].NodeInfo, addrs[j].Addresses())
+		}
+
+		require.Empty(t, tr.Next())
+	})
 }
@@ -6,7 +6,9 @@ import (
 	"strings"
 
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
+	netmapcore "github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	"github.com/nspcc-dev/neofs-sdk-go/netmap"
@@ -36,6 +38,22 @@ func (p *Policer) processObject(ctx context.Context, addr *addressSDK.Address) {
 		return
 	}
 
+	if p.erasureStorage != nil {
+		scheme, err := erasure.SchemeFromContainer(cnr)
+		if err != nil {
+			p.log.Error("could not read erasure code scheme of the container",
+				zap.Stringer("cid", addr.ContainerID()),
+				zap.String("error", err.Error()),
+			)
+
+			return
+		}
+
+		if scheme != nil && p.processErasureCoded(ctx, addr, cnr.PlacementPolicy(), scheme) {
+			return
+		}
+	}
+
 	policy := cnr.PlacementPolicy()
 
 	nn, err := p.placementBuilder.BuildPlacement(addr, policy)
@@ -85,6 +103,13 @@ func (p *Policer) processNodes(ctx context.Context, addr *addressSDK.Address, no
 			} else {
 				shortage--
 			}
+		} else if netmapcore.IsMaintenance(nodes[i].NodeInfo) {
+			// replicas on the nodes in maintenance are considered
+			// present, Inner Ring removes the node from the network
+			// map if maintenance lasts too long
+			if shortage > 0 {
+				shortage--
+			}
 		} else if shortage > 0 {
 			callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
 
@@ -0,0 +1,340 @@
+package policer
+
+import (
+	"context"
+	"errors"
+
+	"github.com/nspcc-dev/neofs-node/pkg/core/object"
+	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"github.com/nspcc-dev/neofs-sdk-go/netmap"
+	addressSDK "github.com/nspcc-dev/neofs-sdk-go/object/address"
+	"go.uber.org/zap"
+)
+
+// processErasureCoded checks the storage policy of the object
+// from the erasure-coded container: full objects are split into
+// parts, parts are moved to their holders and the lost parts are
+// restored.
+//
+// Returns false if the object is not erasure-coded and must be
+// processed as a regular one.
+func (p *Policer) processErasureCoded(ctx context.Context, addr *addressSDK.Address, policy *netmap.PlacementPolicy, scheme *erasure.Scheme) bool {
+	hdr, err := engine.Head(p.jobQueue.localStorage, addr)
+	if err != nil {
+		p.log.Error("could not get object header from local storage",
+			zap.Stringer("object", addr),
+			zap.String("error", err.Error()),
+		)
+
+		return true
+	}
+
+	if erasure.Encodable(hdr) {
+		p.encodeObject(ctx, addr, policy, scheme)
+		return true
+	}
+
+	info, err := erasure.ReadPartInfo(hdr)
+	if err != nil {
+		// not a part, so erasure coding is not applied
+		return false
+	}
+
+	p.checkPart(ctx, hdr, info, policy)
+
+	return true
+}
+
+// encodeObject splits locally stored object and saves the parts
+// on their holders. Local copy of the object is removed if all parts
+// are saved.
+func (p *Policer) encodeObject(ctx context.Context, addr *addressSDK.Address, policy *netmap.PlacementPolicy, scheme *erasure.Scheme) {
+	log := p.log.With(
+		zap.Stringer("object", addr),
+	)
+
+	nodes, err := p.erasureNodes(addr, policy, scheme)
+	if err != nil {
+		log.Error("could not select erasure code part holders",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	obj, err := engine.Get(p.jobQueue.localStorage, addr)
+	if err != nil {
+		log.Error("could not get object from local storage",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	key, err := p.keyStorage.GetKey(nil)
+	if err != nil {
+		log.Error("could not receive private key",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	parts, err := erasure.Split(obj, scheme, key)
+	if err != nil {
+		log.Error("could not split object into erasure code parts",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	saved := true
+
+	for i := range parts {
+		if !p.savePart(ctx, log, nodes[i], parts[i]) {
+			saved = false
+		}
+	}
+
+	if saved {
+		log.Info("object is split into erasure code parts")
+
+		p.cbRedundantCopy(addr)
+	}
+}
+
+// checkPart moves locally stored part to its holder and restores
+// the lost parts of the object.
+func (p *Policer) checkPart(ctx context.Context, part *object.Object, info *erasure.PartInfo, policy *netmap.PlacementPolicy) {
+	parent := addressSDK.NewAddress()
+	parent.SetContainerID(part.ContainerID())
+	parent.SetObjectID(info.Parent())
+
+	log := p.log.With(
+		zap.Stringer("object", parent),
+		zap.Int("part", info.Index()),
+	)
+
+	// parts of the removed object are removed by its tombstone,
+	// they must not be moved or restored
+	if _, err := engine.Head(p.jobQueue.localStorage, parent); errors.Is(err, object.ErrAlreadyRemoved) {
+		log.Debug("erasure-coded object is removed, skip the part")
+
+		return
+	}
+
+	nodes, err := p.erasureNodes(parent, policy, info.Scheme())
+	if err != nil {
+		log.Error("could not select erasure code part holders",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	holder := nodes[info.Index()]
+
+	if !p.netmapKeys.IsLocalKey(holder.PublicKey()) {
+		if p.hasPart(ctx, log, holder, parent, info.Index()) ||
+			p.savePart(ctx, log, holder, p.localObject(log, part.Address())) {
+			log.Info("misplaced erasure code part detected")
+
+			p.cbRedundantCopy(part.Address())
+		}
+
+		return
+	}
+
+	// the parts are restored by the holder of the first available part,
+	// so the other holders do not repeat the work
+	missing := make([]int, 0, len(nodes))
+
+	for i := range nodes {
+		if i == info.Index() {
+			continue
+		}
+
+		if p.hasPart(ctx, log, nodes[i], parent, i) {
+			if i < info.Index() {
+				return
+			}
+
+			continue
+		}
+
+		missing = append(missing, i)
+	}
+
+	if len(missing) == 0 {
+		return
+	}
+
+	log.Debug("shortage of erasure code parts detected",
+		zap.Int("shortage", len(missing)),
+	)
+
+	p.restoreParts(ctx, log, part, info, nodes, missing)
+}
+
+// restoreParts collects enough parts to reconstruct the object
+// and saves the missing parts on their holders.
+func (p *Policer) restoreParts(ctx context.Context, log *logger.Logger, part *object.Object, info *erasure.PartInfo, nodes netmap.Nodes, missing []int) {
+	local := p.localObject(log, part.Address())
+	if local == nil {
+		return
+	}
+
+	var (
+		parts     = []*object.Object{local}
+		isMissing = make(map[int]struct{}, len(missing))
+	)
+
+	for _, i := range missing {
+		isMissing[i] = struct{}{}
+	}
+
+	parent := addressSDK.NewAddress()
+	parent.SetContainerID(part.ContainerID())
+	parent.SetObjectID(info.Parent())
+
+	for i := 0; i < len(nodes) && len(parts) < info.Scheme().Data(); i++ {
+		if _, ok := isMissing[i]; ok || i == info.Index() {
+			continue
+		}
+
+		if obj := p.remotePart(ctx, log, nodes[i], parent, i); obj != nil {
+			parts = append(parts, obj)
+		}
+	}
+
+	obj, err := erasure.Join(parts)
+	if err != nil {
+		log.Error("could not restore the object from erasure code parts",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	key, err := p.keyStorage.GetKey(nil)
+	if err != nil {
+		log.Error("could not receive private key",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	restored, err := erasure.Split(obj, info.Scheme(), key)
+	if err != nil {
+		log.Error("could not split object into erasure code parts",
+			zap.String("error", err.Error()),
+		)
+
+		return
+	}
+
+	for _, i := range missing {
+		if p.savePart(ctx, log, nodes[i], restored[i]) {
+			log.Debug("erasure code part successfully restored",
+				zap.Int("restored", i),
+			)
+		}
+	}
+}
+
+func (p *Policer) erasureNodes(addr *addressSDK.Address, policy *netmap.PlacementPolicy, scheme *erasure.Scheme) (netmap.Nodes, error) {
+	vectors, err := p.placementBuilder.BuildPlacement(addr, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return erasure.Nodes(vectors, scheme)
+}
+
+func (p *Policer) localObject(log *logger.Logger, addr *addressSDK.Address) *object.Object {
+	obj, err := engine.Get(p.jobQueue.localStorage, addr)
+	if err != nil {
+		log.Error("could not get object from local storage",
+			zap.String("error", err.Error()),
+		)
+
+		return nil
+	}
+
+	return obj
+}
+
+// hasPart checks if the node stores the part of the object with the index.
+func (p *Policer) hasPart(ctx context.Context, log *logger.Logger, node netmap.Node, parent *addressSDK.Address, index int) bool {
+	callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
+	defer cancel()
+
+	ids, err := p.erasureStorage.SearchParts(callCtx, node.NodeInfo, parent, index)
+	if err != nil {
+		log.Debug("could not search erasure code parts",
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+
+	return len(ids) > 0
+}
+
+// remotePart reads the part of the object with the index from the node.
+func (p *Policer) remotePart(ctx context.Context, log *logger.Logger, node netmap.Node, parent *addressSDK.Address, index int) *object.Object {
+	callCtx, cancel := context.WithTimeout(ctx, p.headTimeout)
+	defer cancel()
+
+	ids, err := p.erasureStorage.SearchParts(callCtx, node.NodeInfo, parent, index)
+	if err != nil || len(ids) == 0 {
+		return nil
+	}
+
+	addr := addressSDK.NewAddress()
+	addr.SetContainerID(parent.ContainerID())
+	addr.SetObjectID(ids[0])
+
+	obj, err := p.erasureStorage.GetObject(callCtx, node.NodeInfo, addr)
+	if err != nil {
+		log.Debug("could not get erasure code part",
+			zap.String("error", err.Error()),
+		)
+
+		return nil
+	}
+
+	return obj
+}
+
+// savePart saves the part on the node.
+func (p *Policer) savePart(ctx context.Context, log *logger.Logger, node netmap.Node, part *object.Object) bool {
+	if part == nil {
+		return false
+	}
+
+	var err error
+
+	if p.netmapKeys.IsLocalKey(node.PublicKey()) {
+		err = engine.Put(p.jobQueue.localStorage, part)
+	} else {
+		callCtx, cancel := context.WithTimeout(ctx, p.putTimeout)
+		err = p.erasureStorage.PutObject(callCtx, node.NodeInfo, part)
+		cancel()
+	}
+
+	if err != nil {
+		log.Error("could not save erasure code part",
+			zap.Stringer("part", part.Address()),
+			zap.String("error", err.Error()),
+		)
+
+		return false
+	}
+
+	return true
+}
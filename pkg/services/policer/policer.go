@@ -7,7 +7,9 @@ import (
 	"github.com/nspcc-dev/neofs-node/pkg/core/container"
 	"github.com/nspcc-dev/neofs-node/pkg/core/netmap"
 	"github.com/nspcc-dev/neofs-node/pkg/local_object_storage/engine"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/erasure"
 	headsvc "github.com/nspcc-dev/neofs-node/pkg/services/object/head"
+	"github.com/nspcc-dev/neofs-node/pkg/services/object/util"
 	"github.com/nspcc-dev/neofs-node/pkg/services/object_manager/placement"
 	"github.com/nspcc-dev/neofs-node/pkg/services/replicator"
 	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
@@ -38,7 +40,7 @@ type Option func(*cfg)
 type RedundantCopyCallback func(*addressSDK.Address)
 
 type cfg struct {
-	headTimeout time.Duration
+	headTimeout, putTimeout time.Duration
 
 	log *logger.Logger
 
@@ -50,6 +52,10 @@ type cfg struct {
 
 	remoteHeader *headsvc.RemoteHeader
 
+	erasureStorage *erasure.RemoteStorage
+
+	keyStorage *util.KeyStorage
+
 	netmapKeys netmap.AnnouncedKeys
 
 	replicator *replicator.Replicator
@@ -105,6 +111,14 @@ func WithHeadTimeout(v time.Duration) Option {
 	}
 }
 
+// WithPutTimeout returns option to set Put timeout of Policer
+// used to save erasure code parts on the remote nodes.
+func WithPutTimeout(v time.Duration) Option {
+	return func(c *cfg) {
+		c.putTimeout = v
+	}
+}
+
 // WithLogger returns option to set Logger of Policer.
 func WithLogger(v *logger.Logger) Option {
 	return func(c *cfg) {
@@ -140,6 +154,25 @@ func WithRemoteHeader(v *headsvc.RemoteHeader) Option {
 	}
 }
 
+// WithErasureStorage returns option to set storage of the
+// erasure code parts on the remote nodes.
+//
+// Objects of the erasure-coded containers are replicated
+// as regular ones if the storage is not set.
+func WithErasureStorage(v *erasure.RemoteStorage) Option {
+	return func(c *cfg) {
+		c.erasureStorage = v
+	}
+}
+
+// WithKeyStorage returns option to set private key storage
+// to sign the erasure code parts.
+func WithKeyStorage(v *util.KeyStorage) Option {
+	return func(c *cfg) {
+		c.keyStorage = v
+	}
+}
+
 // WithNetmapKeys returns option to set tool to work with announced public keys.
 func WithNetmapKeys(v netmap.AnnouncedKeys) Option {
 	return func(c *cfg) {
@@ -0,0 +1,294 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Exporter is an interface of the storage of finished spans.
+type Exporter interface {
+	// ExportSpans must save or send the spans.
+	//
+	// Must not retain the slice.
+	ExportSpans(context.Context, []*SpanData) error
+
+	// Shutdown must release all resources of the Exporter.
+	Shutdown(context.Context) error
+}
+
+// scope name of the spans in OTLP format.
+const instrumentationScope = "github.com/nspcc-dev/neofs-node"
+
+// OTLP JSON representation of the exported data, see
+// https://github.com/open-telemetry/opentelemetry-proto/blob/main/opentelemetry/proto/trace/v1/trace.proto
+type (
+	otlpExportRequest struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpKeyValue `json:"attributes"`
+	}
+
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+
+	otlpScope struct {
+		Name string `json:"name"`
+	}
+
+	otlpSpan struct {
+		TraceID           string         `json:"traceId"`
+		SpanID            string         `json:"spanId"`
+		ParentSpanID      string         `json:"parentSpanId,omitempty"`
+		Name              string         `json:"name"`
+		Kind              SpanKind       `json:"kind"`
+		StartTimeUnixNano string         `json:"startTimeUnixNano"`
+		EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+		Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+		Status            *otlpStatus    `json:"status,omitempty"`
+	}
+
+	otlpStatus struct {
+		Code    int    `json:"code"`
+		Message string `json:"message,omitempty"`
+	}
+
+	otlpKeyValue struct {
+		Key   string       `json:"key"`
+		Value otlpAnyValue `json:"value"`
+	}
+
+	otlpAnyValue struct {
+		StringValue *string  `json:"stringValue,omitempty"`
+		BoolValue   *bool    `json:"boolValue,omitempty"`
+		IntValue    *string  `json:"intValue,omitempty"`
+		DoubleValue *float64 `json:"doubleValue,omitempty"`
+	}
+)
+
+// status code of the failed span in OTLP.
+const otlpStatusError = 2
+
+func otlpValue(v interface{}) otlpAnyValue {
+	var res otlpAnyValue
+
+	switch val := v.(type) {
+	case string:
+		res.StringValue = &val
+	case bool:
+		res.BoolValue = &val
+	case int:
+		s := strconv.FormatInt(int64(val), 10)
+		res.IntValue = &s
+	case int64:
+		s := strconv.FormatInt(val, 10)
+		res.IntValue = &s
+	case uint32:
+		s := strconv.FormatUint(uint64(val), 10)
+		res.IntValue = &s
+	case uint64:
+		s := strconv.FormatUint(val, 10)
+		res.IntValue = &s
+	case float64:
+		res.DoubleValue = &val
+	case fmt.Stringer:
+		s := val.String()
+		res.StringValue = &s
+	default:
+		s := fmt.Sprint(val)
+		res.StringValue = &s
+	}
+
+	return res
+}
+
+func otlpAttributes(attrs []Attribute) []otlpKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+
+	res := make([]otlpKeyValue, 0, len(attrs))
+
+	for i := range attrs {
+		res = append(res, otlpKeyValue{
+			Key:   attrs[i].Key,
+			Value: otlpValue(attrs[i].Value),
+		})
+	}
+
+	return res
+}
+
+func toOTLPSpan(s *SpanData) otlpSpan {
+	res := otlpSpan{
+		TraceID:           s.Context.TraceID.String(),
+		SpanID:            s.Context.SpanID.String(),
+		Name:              s.Name,
+		Kind:              s.Kind,
+		StartTimeUnixNano: strconv.FormatInt(s.Start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.End.UnixNano(), 10),
+		Attributes:        otlpAttributes(s.Attributes),
+	}
+
+	if s.Parent.IsValid() {
+		res.ParentSpanID = s.Parent.String()
+	}
+
+	if s.Error != "" {
+		res.Status = &otlpStatus{
+			Code:    otlpStatusError,
+			Message: s.Error,
+		}
+	}
+
+	return res
+}
+
+func toOTLPRequest(service string, spans []*SpanData) *otlpExportRequest {
+	otlpSpans := make([]otlpSpan, 0, len(spans))
+
+	for i := range spans {
+		otlpSpans = append(otlpSpans, toOTLPSpan(spans[i]))
+	}
+
+	return &otlpExportRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: otlpAttributes([]Attribute{
+					{Key: "service.name", Value: service},
+				}),
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: instrumentationScope},
+				Spans: otlpSpans,
+			}},
+		}},
+	}
+}
+
+// OTLPExporter is an Exporter which sends spans to the
+// OpenTelemetry collector using OTLP/HTTP protocol with JSON encoding.
+type OTLPExporter struct {
+	endpoint string
+
+	service string
+
+	cli *http.Client
+}
+
+// NewOTLPExporter creates new OTLPExporter which sends spans
+// to the OTLP/HTTP traces endpoint (e.g. http://localhost:4318/v1/traces)
+// under the provided service name.
+func NewOTLPExporter(endpoint, service string, timeout time.Duration) *OTLPExporter {
+	return &OTLPExporter{
+		endpoint: endpoint,
+		service:  service,
+		cli: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+// ExportSpans sends the spans to the collector in a single request.
+func (x *OTLPExporter) ExportSpans(ctx context.Context, spans []*SpanData) error {
+	data, err := json.Marshal(toOTLPRequest(x.service, spans))
+	if err != nil {
+		return fmt.Errorf("could not encode spans: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, x.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("could not create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := x.cli.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not send spans: %w", err)
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("collector responded with status %s", resp.Status)
+	}
+
+	return nil
+}
+
+// Shutdown closes idle connections to the collector.
+func (x *OTLPExporter) Shutdown(context.Context) error {
+	x.cli.CloseIdleConnections()
+	return nil
+}
+
+// FileExporter is an Exporter which appends spans to the local file.
+// Each ExportSpans call writes single line with OTLP JSON
+// export request, so the file can be replayed to the collector.
+type FileExporter struct {
+	mtx sync.Mutex
+
+	service string
+
+	f *os.File
+}
+
+// NewFileExporter opens (creates if necessary) the file by path
+// and returns FileExporter appending spans to it.
+func NewFileExporter(path, service string) (*FileExporter, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+	if err != nil {
+		return nil, fmt.Errorf("could not open trace file: %w", err)
+	}
+
+	return &FileExporter{
+		service: service,
+		f:       f,
+	}, nil
+}
+
+// ExportSpans writes spans to the file.
+func (x *FileExporter) ExportSpans(_ context.Context, spans []*SpanData) error {
+	data, err := json.Marshal(toOTLPRequest(x.service, spans))
+	if err != nil {
+		return fmt.Errorf("could not encode spans: %w", err)
+	}
+
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	_, err = x.f.Write(append(data, '\n'))
+
+	return err
+}
+
+// Shutdown syncs and closes the file.
+func (x *FileExporter) Shutdown(context.Context) error {
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if err := x.f.Sync(); err != nil {
+		_ = x.f.Close()
+		return err
+	}
+
+	return x.f.Close()
+}
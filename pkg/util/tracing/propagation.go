@@ -0,0 +1,175 @@
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// TraceParentHeader is a name of the W3C Trace Context header
+// which carries the span context between the nodes.
+const TraceParentHeader = "traceparent"
+
+const (
+	traceParentVersion = "00"
+	flagSampled        = 0x01
+)
+
+var errInvalidTraceParent = errors.New("invalid traceparent value")
+
+// EncodeTraceParent returns W3C traceparent representation of sc.
+func EncodeTraceParent(sc SpanContext) string {
+	var flags byte
+	if sc.Sampled {
+		flags |= flagSampled
+	}
+
+	return fmt.Sprintf("%s-%s-%s-%02x", traceParentVersion, sc.TraceID, sc.SpanID, flags)
+}
+
+// DecodeTraceParent parses W3C traceparent value.
+func DecodeTraceParent(s string) (SpanContext, error) {
+	var sc SpanContext
+
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return sc, errInvalidTraceParent
+	}
+
+	if parts[0] == traceParentVersion && len(parts) != 4 {
+		return sc, errInvalidTraceParent
+	}
+
+	if err := decodeHexFixed(sc.TraceID[:], parts[1]); err != nil {
+		return sc, fmt.Errorf("%w: trace ID: %v", errInvalidTraceParent, err)
+	}
+
+	if err := decodeHexFixed(sc.SpanID[:], parts[2]); err != nil {
+		return sc, fmt.Errorf("%w: span ID: %v", errInvalidTraceParent, err)
+	}
+
+	var flags [1]byte
+	if err := decodeHexFixed(flags[:], parts[3]); err != nil {
+		return sc, fmt.Errorf("%w: flags: %v", errInvalidTraceParent, err)
+	}
+
+	if !sc.IsValid() {
+		return sc, errInvalidTraceParent
+	}
+
+	sc.Sampled = flags[0]&flagSampled != 0
+
+	return sc, nil
+}
+
+func decodeHexFixed(dst []byte, s string) error {
+	if len(s) != 2*len(dst) {
+		return fmt.Errorf("wrong length %d", len(s))
+	}
+
+	_, err := hex.Decode(dst, []byte(s))
+
+	return err
+}
+
+// InjectOutgoing returns a copy of ctx with the current span context
+// attached to the outgoing gRPC metadata, so the remote node
+// continues the trace.
+//
+// Returns ctx unchanged if it has no span context.
+func InjectOutgoing(ctx context.Context) context.Context {
+	sc, ok := SpanContextFromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, TraceParentHeader, EncodeTraceParent(sc))
+}
+
+// ExtractIncoming reads the span context of the remote caller
+// from the incoming gRPC metadata.
+//
+// The second return value is false if metadata does not contain
+// valid span context.
+func ExtractIncoming(ctx context.Context) (SpanContext, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return SpanContext{}, false
+	}
+
+	vals := md.Get(TraceParentHeader)
+	if len(vals) == 0 {
+		return SpanContext{}, false
+	}
+
+	sc, err := DecodeTraceParent(vals[0])
+
+	return sc, err == nil
+}
+
+func startServerSpan(ctx context.Context, method string) (context.Context, *Span) {
+	if GlobalTracer() == nil {
+		return ctx, nil
+	}
+
+	if sc, ok := ExtractIncoming(ctx); ok {
+		ctx = ContextWithSpanContext(ctx, sc)
+	}
+
+	return StartSpanWithKind(ctx, method, SpanKindServer,
+		Attribute{Key: "rpc.system", Value: "grpc"},
+		Attribute{Key: "rpc.method", Value: method},
+	)
+}
+
+// UnaryServerInterceptor returns gRPC interceptor that starts server span
+// for each unary call, continuing the trace of the caller if its span context
+// is passed in metadata.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, span := startServerSpan(ctx, info.FullMethod)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+		span.SetError(err)
+
+		return resp, err
+	}
+}
+
+type tracedServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *tracedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor is the same as UnaryServerInterceptor but
+// for streaming calls. Span context is available through the
+// Context method of the stream passed to the handler.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, span := startServerSpan(ss.Context(), info.FullMethod)
+		if span == nil {
+			return handler(srv, ss)
+		}
+
+		defer span.End()
+
+		err := handler(srv, &tracedServerStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		})
+		span.SetError(err)
+
+		return err
+	}
+}
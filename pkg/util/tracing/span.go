@@ -0,0 +1,193 @@
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// TraceID is a 16-byte identifier of the trace.
+type TraceID [16]byte
+
+// SpanID is an 8-byte identifier of the span inside the trace.
+type SpanID [8]byte
+
+// IsValid returns true if identifier contains at least one non-zero byte.
+func (x TraceID) IsValid() bool {
+	return x != TraceID{}
+}
+
+// String returns hex-encoded identifier.
+func (x TraceID) String() string {
+	return hex.EncodeToString(x[:])
+}
+
+// IsValid returns true if identifier contains at least one non-zero byte.
+func (x SpanID) IsValid() bool {
+	return x != SpanID{}
+}
+
+// String returns hex-encoded identifier.
+func (x SpanID) String() string {
+	return hex.EncodeToString(x[:])
+}
+
+// SpanContext groups the span information that is propagated
+// between the processes (see W3C Trace Context).
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+// IsValid returns true if both trace and span identifiers are set.
+func (x SpanContext) IsValid() bool {
+	return x.TraceID.IsValid() && x.SpanID.IsValid()
+}
+
+// SpanKind is an enumeration of span kinds
+// according to OpenTelemetry specification.
+type SpanKind uint8
+
+const (
+	// SpanKindInternal is a kind of span of local operation.
+	SpanKindInternal SpanKind = iota + 1
+	// SpanKindServer is a kind of span of the remote request processing.
+	SpanKindServer
+	// SpanKindClient is a kind of span of the request to the remote server.
+	SpanKindClient
+)
+
+// Attribute is a key-value pair describing the span.
+//
+// Value can be of string, bool, int64, uint64 or float64 type,
+// other types are converted to string during export.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// SpanData is a read-only representation of the finished span
+// which is passed to Exporter.
+type SpanData struct {
+	Context SpanContext
+	Parent  SpanID
+
+	Name string
+	Kind SpanKind
+
+	Start, End time.Time
+
+	Attributes []Attribute
+
+	// Error is a description of the error that the span
+	// operation failed with. Empty if operation succeeded.
+	Error string
+}
+
+// Span represents single operation within a trace.
+//
+// Nil Span is a valid no-op span, so the callers
+// must not check the result of StartSpan.
+type Span struct {
+	tracer *Tracer
+
+	mtx   sync.Mutex
+	ended bool
+
+	data SpanData
+}
+
+type ctxKey struct{}
+
+// ContextWithSpanContext returns a copy of parent with sc
+// set as the current span context.
+func ContextWithSpanContext(parent context.Context, sc SpanContext) context.Context {
+	return context.WithValue(parent, ctxKey{}, sc)
+}
+
+// SpanContextFromContext returns span context stored in ctx.
+//
+// The second return value is false if span context is missing.
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	if ctx == nil {
+		return SpanContext{}, false
+	}
+
+	sc, ok := ctx.Value(ctxKey{}).(SpanContext)
+
+	return sc, ok && sc.IsValid()
+}
+
+// SetAttributes adds attributes to the span.
+func (s *Span) SetAttributes(attrs ...Attribute) {
+	if s == nil {
+		return
+	}
+
+	s.mtx.Lock()
+	if !s.ended {
+		s.data.Attributes = append(s.data.Attributes, attrs...)
+	}
+	s.mtx.Unlock()
+}
+
+// SetError marks the span as failed if err is not nil.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+
+	s.mtx.Lock()
+	if !s.ended {
+		s.data.Error = err.Error()
+	}
+	s.mtx.Unlock()
+}
+
+// Context returns span context of the span.
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+
+	return s.data.Context
+}
+
+// End finishes the span and passes it to the exporter
+// of the tracer if span is sampled.
+//
+// Subsequent calls are no-op.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+
+	s.mtx.Lock()
+
+	if s.ended {
+		s.mtx.Unlock()
+		return
+	}
+
+	s.ended = true
+	s.data.End = time.Now()
+
+	s.mtx.Unlock()
+
+	if s.data.Context.Sampled {
+		s.tracer.enqueue(&s.data)
+	}
+}
+
+func randomTraceID() (id TraceID) {
+	_, _ = rand.Read(id[:])
+	return
+}
+
+func randomSpanID() (id SpanID) {
+	_, _ = rand.Read(id[:])
+	return
+}
@@ -0,0 +1,281 @@
+package tracing
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/nspcc-dev/neofs-node/pkg/util/logger"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// Tracer is a component that creates spans and
+// exports them in batches through the Exporter.
+//
+// For correct operation, Tracer must be created
+// using the constructor (NewTracer).
+type Tracer struct {
+	*cfg
+
+	exporter Exporter
+
+	queue chan *SpanData
+
+	stop chan struct{}
+
+	wg sync.WaitGroup
+}
+
+// Option is a Tracer's constructor option.
+type Option func(*cfg)
+
+type cfg struct {
+	log *logger.Logger
+
+	ratio float64
+
+	batchSize int
+
+	queueSize int
+
+	flushInterval time.Duration
+}
+
+func defaultCfg() *cfg {
+	return &cfg{
+		log:           zap.L(),
+		ratio:         1,
+		batchSize:     512,
+		queueSize:     2048,
+		flushInterval: 5 * time.Second,
+	}
+}
+
+// NewTracer creates, initializes and returns new Tracer instance.
+//
+// Exporter must not be nil.
+func NewTracer(exp Exporter, opts ...Option) *Tracer {
+	c := defaultCfg()
+
+	for i := range opts {
+		opts[i](c)
+	}
+
+	return &Tracer{
+		cfg:      c,
+		exporter: exp,
+		queue:    make(chan *SpanData, c.queueSize),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Start runs the routine which exports finished spans
+// until the Tracer is stopped.
+func (t *Tracer) Start() {
+	t.wg.Add(1)
+
+	go t.exportRoutine()
+}
+
+// Stop flushes the queued spans, stops the export
+// routine and shuts down the exporter.
+func (t *Tracer) Stop(ctx context.Context) error {
+	close(t.stop)
+
+	t.wg.Wait()
+
+	return t.exporter.Shutdown(ctx)
+}
+
+func (t *Tracer) exportRoutine() {
+	defer t.wg.Done()
+
+	ticker := time.NewTicker(t.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*SpanData, 0, t.batchSize)
+
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+
+		if err := t.exporter.ExportSpans(context.Background(), batch); err != nil {
+			t.log.Debug("could not export tracing spans",
+				zap.Int("amount", len(batch)),
+				zap.String("error", err.Error()),
+			)
+		}
+
+		batch = make([]*SpanData, 0, t.batchSize)
+	}
+
+	for {
+		select {
+		case <-t.stop:
+			for {
+				select {
+				case s := <-t.queue:
+					batch = append(batch, s)
+				default:
+					flush()
+					return
+				}
+			}
+		case <-ticker.C:
+			flush()
+		case s := <-t.queue:
+			batch = append(batch, s)
+
+			if len(batch) >= t.batchSize {
+				flush()
+			}
+		}
+	}
+}
+
+func (t *Tracer) enqueue(s *SpanData) {
+	select {
+	case t.queue <- s:
+	default:
+		t.log.Debug("tracing span queue is full, span dropped",
+			zap.String("name", s.Name),
+		)
+	}
+}
+
+// StartSpan creates new span with the given name which is a child of
+// the span stored in ctx (if any). The returned context carries
+// the created span and should be passed to the nested operations.
+//
+// Span must be finished with End.
+func (t *Tracer) StartSpan(ctx context.Context, name string, kind SpanKind, attrs ...Attribute) (context.Context, *Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	s := &Span{
+		tracer: t,
+		data: SpanData{
+			Name:       name,
+			Kind:       kind,
+			Start:      time.Now(),
+			Attributes: attrs,
+		},
+	}
+
+	s.data.Context.SpanID = randomSpanID()
+
+	if parent, ok := SpanContextFromContext(ctx); ok {
+		s.data.Parent = parent.SpanID
+		s.data.Context.TraceID = parent.TraceID
+		s.data.Context.Sampled = parent.Sampled
+	} else {
+		s.data.Context.TraceID = randomTraceID()
+		s.data.Context.Sampled = t.sample(s.data.Context.TraceID)
+	}
+
+	return ContextWithSpanContext(ctx, s.data.Context), s
+}
+
+// sample makes sampling decision for the root span
+// based on trace identifier, so it is consistent
+// for the same trace.
+func (t *Tracer) sample(id TraceID) bool {
+	switch {
+	case t.ratio >= 1:
+		return true
+	case t.ratio <= 0:
+		return false
+	default:
+		return float64(binary.BigEndian.Uint64(id[8:])>>1) < t.ratio*(1<<63)
+	}
+}
+
+var global atomic.Value
+
+type tracerHolder struct {
+	t *Tracer
+}
+
+// SetGlobalTracer sets the Tracer used by package-level StartSpan.
+//
+// Nil Tracer disables tracing.
+func SetGlobalTracer(t *Tracer) {
+	global.Store(tracerHolder{t: t})
+}
+
+// GlobalTracer returns the Tracer set by SetGlobalTracer.
+//
+// Returns nil if tracing is disabled.
+func GlobalTracer() *Tracer {
+	h, _ := global.Load().(tracerHolder)
+	return h.t
+}
+
+// StartSpan starts a span of internal kind using the global Tracer.
+//
+// If tracing is disabled, ctx is returned unchanged together with nil Span.
+func StartSpan(ctx context.Context, name string, attrs ...Attribute) (context.Context, *Span) {
+	return StartSpanWithKind(ctx, name, SpanKindInternal, attrs...)
+}
+
+// StartSpanWithKind is the same as StartSpan but allows to set the span kind.
+func StartSpanWithKind(ctx context.Context, name string, kind SpanKind, attrs ...Attribute) (context.Context, *Span) {
+	t := GlobalTracer()
+	if t == nil {
+		return ctx, nil
+	}
+
+	return t.StartSpan(ctx, name, kind, attrs...)
+}
+
+// WithLogger returns option to specify Tracer's logger.
+func WithLogger(l *logger.Logger) Option {
+	return func(c *cfg) {
+		if l != nil {
+			c.log = l
+		}
+	}
+}
+
+// WithSamplingRatio returns option to specify the fraction of
+// the root spans to be exported. Values out of (0; 1) range mean
+// "never" (non-positive) and "always" (greater or equal to 1).
+func WithSamplingRatio(r float64) Option {
+	return func(c *cfg) {
+		c.ratio = r
+	}
+}
+
+// WithBatchSize returns option to specify max number of
+// spans exported at once.
+func WithBatchSize(sz int) Option {
+	return func(c *cfg) {
+		if sz > 0 {
+			c.batchSize = sz
+		}
+	}
+}
+
+// WithQueueSize returns option to specify the capacity of
+// the finished span queue. Spans are dropped if queue is full.
+func WithQueueSize(sz int) Option {
+	return func(c *cfg) {
+		if sz > 0 {
+			c.queueSize = sz
+		}
+	}
+}
+
+// WithFlushInterval returns option to specify the max time
+// between the span is finished and exported.
+func WithFlushInterval(d time.Duration) Option {
+	return func(c *cfg) {
+		if d > 0 {
+			c.flushInterval = d
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestTraceParent(t *testing.T) {
+	sc := SpanContext{
+		TraceID: randomTraceID(),
+		SpanID:  randomSpanID(),
+		Sampled: true,
+	}
+
+	res, err := DecodeTraceParent(EncodeTraceParent(sc))
+	require.NoError(t, err)
+	require.Equal(t, sc, res)
+
+	sc.Sampled = false
+
+	res, err = DecodeTraceParent(EncodeTraceParent(sc))
+	require.NoError(t, err)
+	require.Equal(t, sc, res)
+
+	for _, s := range []string{
+		"",
+		"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331",
+		"ff-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01",
+		"00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01-00",
+		"00-00000000000000000000000000000000-b7ad6b7169203331-01",
+		"00-0af7651916cd43dd8448eb211c80319c-0000000000000000-01",
+		"00-0af7651916cd43dd8448eb211c8031-b7ad6b7169203331-01",
+		"00-0af7651916cd43dd8448eb211c80319c-b7ad6b71692033zz-01",
+	} {
+		_, err := DecodeTraceParent(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestPropagation(t *testing.T) {
+	tr := NewTracer(new(nopExporter))
+
+	ctx, span := tr.StartSpan(context.Background(), "client", SpanKindClient)
+
+	md, ok := metadata.FromOutgoingContext(InjectOutgoing(ctx))
+	require.True(t, ok)
+
+	remote, ok := ExtractIncoming(metadata.NewIncomingContext(context.Background(), md))
+	require.True(t, ok)
+	require.Equal(t, span.Context(), remote)
+
+	_, ok = ExtractIncoming(context.Background())
+	require.False(t, ok)
+}
+
+func TestFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "trace.json")
+
+	exp, err := NewFileExporter(path, "test")
+	require.NoError(t, err)
+
+	tr := NewTracer(exp)
+	tr.Start()
+
+	ctx, parent := tr.StartSpan(context.Background(), "parent", SpanKindServer)
+	_, child := tr.StartSpan(ctx, "child", SpanKindInternal, Attribute{Key: "shard", Value: "1"})
+	child.End()
+	parent.End()
+
+	require.NoError(t, tr.Stop(context.Background()))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = f.Close() })
+
+	var spans []otlpSpan
+
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var req otlpExportRequest
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &req))
+
+		for _, rs := range req.ResourceSpans {
+			require.Equal(t, "test", *rs.Resource.Attributes[0].Value.StringValue)
+
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}
+
+	require.NoError(t, sc.Err())
+	require.Len(t, spans, 2)
+
+	require.Equal(t, "child", spans[0].Name)
+	require.Equal(t, "parent", spans[1].Name)
+	require.Equal(t, spans[1].TraceID, spans[0].TraceID)
+	require.Equal(t, spans[1].SpanID, spans[0].ParentSpanID)
+	require.Empty(t, spans[1].ParentSpanID)
+}
+
+func TestSampling(t *testing.T) {
+	tr := NewTracer(new(nopExporter), WithSamplingRatio(0))
+
+	ctx, span := tr.StartSpan(context.Background(), "root", SpanKindInternal)
+	require.False(t, span.Context().Sampled)
+
+	_, child := tr.StartSpan(ctx, "child", SpanKindInternal)
+	require.False(t, child.Context().Sampled)
+	require.Equal(t, span.Context().TraceID, child.Context().TraceID)
+}
+
+func TestNilSpan(t *testing.T) {
+	SetGlobalTracer(nil)
+
+	ctx := context.Background()
+
+	ctxSpan, span := StartSpan(ctx, "noop")
+	require.Nil(t, span)
+	require.Equal(t, ctx, ctxSpan)
+
+	require.NotPanics(t, func() {
+		span.SetAttributes(Attribute{Key: "k", Value: "v"})
+		span.SetError(os.ErrNotExist)
+		span.End()
+	})
+}
+
+type nopExporter struct{}
+
+func (nopExporter) ExportSpans(context.Context, []*SpanData) error { return nil }
+
+func (nopExporter) Shutdown(context.Context) error { return nil }